@@ -0,0 +1,142 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package apis_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestConditioned checks that every kind implements Getter, and that every
+// kind whose status has conditions implements Conditioned and round trips
+// them through SetConditions and GetConditions.
+func TestConditioned(t *testing.T) {
+	scheme := newScheme(t)
+	conditions := []metav1.Condition{
+		{Type: "Ready", Status: metav1.ConditionTrue, LastTransitionTime: metav1.Now().Rfc3339Copy(), Reason: "Realized", Message: "ok"},
+	}
+
+	for gvk, typ := range scheme.AllKnownTypes() {
+		pkgPath := typ.PkgPath()
+		if pkgPath != reflect.TypeOf(v1alpha1.Network{}).PkgPath() && pkgPath != reflect.TypeOf(v1alpha2.Network{}).PkgPath() {
+			continue
+		}
+		obj := newObject(t, scheme, gvk)
+		if _, ok := obj.(metav1.Object); !ok {
+			continue
+		}
+
+		t.Run(gvk.Version+"."+gvk.Kind, func(t *testing.T) {
+			getter, ok := obj.(interface{ GetConditions() []metav1.Condition })
+			if !ok {
+				t.Fatal("expected the kind to implement Getter")
+			}
+			if !hasConditions(typ) {
+				if _, ok := obj.(interface{ SetConditions([]metav1.Condition) }); ok {
+					t.Error("expected a kind without conditions not to implement Setter")
+				}
+				if got := getter.GetConditions(); got != nil {
+					t.Errorf("expected no conditions, got %v", got)
+				}
+				return
+			}
+
+			var setter interface {
+				SetConditions([]metav1.Condition)
+				GetConditions() []metav1.Condition
+			}
+			switch gvk.Version {
+			case v1alpha1.SchemeGroupVersion.Version:
+				setter, ok = obj.(v1alpha1.Conditioned)
+			case v1alpha2.SchemeGroupVersion.Version:
+				setter, ok = obj.(v1alpha2.Conditioned)
+			}
+			if !ok {
+				t.Fatal("expected the kind to implement Conditioned")
+			}
+			if got := setter.GetConditions(); got != nil {
+				t.Errorf("expected no conditions on a new object, got %v", got)
+			}
+			setter.SetConditions(conditions)
+			want := conditions
+			if !hasTransitionTime(typ) {
+				// GetConditions defaults the LastTransitionTime that the kind
+				// cannot hold to the creation time of the object.
+				created := metav1.NewTime(conditions[0].LastTransitionTime.Add(-time.Hour))
+				obj.(metav1.Object).SetCreationTimestamp(created)
+				want = []metav1.Condition{conditions[0]}
+				want[0].LastTransitionTime = created
+			}
+			if got := setter.GetConditions(); !apiequality.Semantic.DeepEqual(got, want) {
+				t.Errorf("expected conditions %v, got %v", want, got)
+			}
+		})
+	}
+}
+
+// TestConditioned_V1alpha1Defaults checks that the v1alpha1 kinds with their
+// own condition type return valid metav1.Conditions from GetConditions.
+func TestConditioned_V1alpha1Defaults(t *testing.T) {
+	created := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	pool := &v1alpha1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created},
+		Status: v1alpha1.IPPoolStatus{Conditions: []v1alpha1.IPPoolCondition{
+			{Type: v1alpha1.IPPoolReady, Status: "True"},
+		}},
+	}
+	got := pool.GetConditions()
+	if len(got) != 1 || got[0].Reason != string(v1alpha1.IPPoolReady) || !got[0].LastTransitionTime.Equal(&created) {
+		t.Errorf("expected the Reason to default to the Type and the LastTransitionTime to the creation time, got %v", got)
+	}
+
+	nif := &v1alpha1.NetworkInterface{
+		Status: v1alpha1.NetworkInterfaceStatus{Conditions: []v1alpha1.NetworkInterfaceCondition{
+			{Type: "not a reason", Status: "True"},
+		}},
+	}
+	got = nif.GetConditions()
+	if len(got) != 1 || got[0].Reason != "Unknown" || got[0].LastTransitionTime.IsZero() {
+		t.Errorf("expected the Reason to default to Unknown and a non-zero LastTransitionTime, got %v", got)
+	}
+}
+
+// hasConditions reports whether the status of the kind of type typ has
+// conditions.
+func hasConditions(typ reflect.Type) bool {
+	_, ok := conditionType(typ)
+	return ok
+}
+
+// hasTransitionTime reports whether the conditions of the kind of type typ
+// have a LastTransitionTime, which IPPoolCondition lacks.
+func hasTransitionTime(typ reflect.Type) bool {
+	condition, _ := conditionType(typ)
+	_, ok := condition.FieldByName("LastTransitionTime")
+	return ok
+}
+
+// conditionType returns the type of the status conditions of the kind of type
+// typ.
+func conditionType(typ reflect.Type) (reflect.Type, bool) {
+	status, ok := typ.FieldByName("Status")
+	if !ok {
+		return nil, false
+	}
+	statusType := status.Type
+	if statusType.Kind() == reflect.Pointer {
+		statusType = statusType.Elem()
+	}
+	conditions, ok := statusType.FieldByName("Conditions")
+	if !ok {
+		return nil, false
+	}
+	return conditions.Type.Elem(), true
+}
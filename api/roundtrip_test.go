@@ -0,0 +1,143 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package apis_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	fuzz "github.com/google/gofuzz"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/apitesting/roundtrip"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+func newScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{v1alpha1.AddToScheme, v1alpha2.AddToScheme} {
+		if err := add(scheme); err != nil {
+			t.Fatalf("add to scheme: %v", err)
+		}
+	}
+	return scheme
+}
+
+func newFuzzer(t *testing.T, codecs runtimeserializer.CodecFactory) *fuzz.Fuzzer {
+	seed := rand.Int63()
+	t.Logf("fuzzer seed: %d", seed)
+	return fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(seed), codecs)
+}
+
+// TestRoundTrip checks that every kind in the scheme survives a deep copy and a
+// JSON encode/decode unchanged. A field whose omitempty or omitzero tag drops
+// a non-zero value on the wire fails this test.
+func TestRoundTrip(t *testing.T) {
+	scheme := newScheme(t)
+	codecs := runtimeserializer.NewCodecFactory(scheme)
+	roundtrip.RoundTripExternalTypesWithoutProtobuf(t, scheme, codecs, newFuzzer(t, codecs), nil)
+}
+
+// TestConversionRoundTrip checks that every kind converts from each spoke
+// version to the hub and back, and from the hub to each spoke and back,
+// without losing data.
+func TestConversionRoundTrip(t *testing.T) {
+	scheme := newScheme(t)
+	f := newFuzzer(t, runtimeserializer.NewCodecFactory(scheme))
+
+	kinds := 0
+	for gvk := range scheme.AllKnownTypes() {
+		spoke, ok := newObject(t, scheme, gvk).(ctrlconversion.Convertible)
+		if !ok {
+			continue
+		}
+		hubGVK := v1alpha2.SchemeGroupVersion.WithKind(gvk.Kind)
+		if _, ok := newObject(t, scheme, hubGVK).(ctrlconversion.Hub); !ok {
+			t.Errorf("%s has no hub", gvk)
+			continue
+		}
+		kinds++
+
+		t.Run(gvk.Version+"."+gvk.Kind, func(t *testing.T) {
+			for i := 0; i < *roundtrip.FuzzIters; i++ {
+				spokeToHubToSpoke(t, f, spoke, newObject(t, scheme, hubGVK).(ctrlconversion.Hub))
+				hubToSpokeToHub(t, f, newObject(t, scheme, hubGVK).(ctrlconversion.Hub), newObject(t, scheme, gvk).(ctrlconversion.Convertible))
+				if t.Failed() {
+					return
+				}
+			}
+		})
+	}
+	if kinds == 0 {
+		t.Fatal("expected at least one convertible kind")
+	}
+}
+
+func spokeToHubToSpoke(t *testing.T, f *fuzz.Fuzzer, spoke ctrlconversion.Convertible, hub ctrlconversion.Hub) {
+	t.Helper()
+	src := newFuzzed(f, spoke).(ctrlconversion.Convertible)
+	original := src.DeepCopyObject()
+
+	if err := src.ConvertTo(hub); err != nil {
+		t.Fatalf("convert to hub: %v", err)
+	}
+	dst := reflect.New(reflect.TypeOf(spoke).Elem()).Interface().(ctrlconversion.Convertible)
+	if err := dst.ConvertFrom(hub); err != nil {
+		t.Fatalf("convert from hub: %v", err)
+	}
+	if !apiequality.Semantic.DeepEqual(original, src) {
+		t.Errorf("conversion to the hub altered the source, diff: %s", cmp.Diff(original, src))
+	}
+	if !apiequality.Semantic.DeepEqual(original, dst) {
+		t.Errorf("spoke -> hub -> spoke round trip mismatch, diff: %s", cmp.Diff(original, dst))
+	}
+}
+
+func hubToSpokeToHub(t *testing.T, f *fuzz.Fuzzer, hub ctrlconversion.Hub, spoke ctrlconversion.Convertible) {
+	t.Helper()
+	src := newFuzzed(f, hub).(ctrlconversion.Hub)
+	original := src.DeepCopyObject()
+
+	if err := spoke.ConvertFrom(src); err != nil {
+		t.Fatalf("convert from hub: %v", err)
+	}
+	dst := reflect.New(reflect.TypeOf(hub).Elem()).Interface().(ctrlconversion.Hub)
+	if err := spoke.ConvertTo(dst); err != nil {
+		t.Fatalf("convert to hub: %v", err)
+	}
+	if !apiequality.Semantic.DeepEqual(original, src) {
+		t.Errorf("conversion from the hub altered the source, diff: %s", cmp.Diff(original, src))
+	}
+	if !apiequality.Semantic.DeepEqual(original, dst) {
+		t.Errorf("hub -> spoke -> hub round trip mismatch, diff: %s", cmp.Diff(original, dst))
+	}
+}
+
+// newFuzzed returns a fuzzed copy of obj with its TypeMeta cleared, since
+// conversion does not carry apiVersion and kind across versions.
+func newFuzzed(f *fuzz.Fuzzer, obj runtime.Object) runtime.Object {
+	obj = reflect.New(reflect.TypeOf(obj).Elem()).Interface().(runtime.Object)
+	f.Fuzz(obj)
+	obj.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{})
+	return obj
+}
+
+func newObject(t *testing.T, scheme *runtime.Scheme, gvk schema.GroupVersionKind) runtime.Object {
+	t.Helper()
+	obj, err := scheme.New(gvk)
+	if err != nil {
+		t.Fatalf("new %s: %v", gvk, err)
+	}
+	return obj
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this AviLoadBalancerConfig to the Hub version.
+func (src *AviLoadBalancerConfig) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.AviLoadBalancerConfig)
+	return Convert_v1alpha1_AviLoadBalancerConfig_To_v1alpha2_AviLoadBalancerConfig(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this AviLoadBalancerConfig.
+func (dst *AviLoadBalancerConfig) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.AviLoadBalancerConfig)
+	return Convert_v1alpha2_AviLoadBalancerConfig_To_v1alpha1_AviLoadBalancerConfig(src, dst, nil)
+}
+
+// ConvertTo converts this AviLoadBalancerConfigList to the Hub version.
+func (src *AviLoadBalancerConfigList) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.AviLoadBalancerConfigList)
+	return Convert_v1alpha1_AviLoadBalancerConfigList_To_v1alpha2_AviLoadBalancerConfigList(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this AviLoadBalancerConfigList.
+func (dst *AviLoadBalancerConfigList) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.AviLoadBalancerConfigList)
+	return Convert_v1alpha2_AviLoadBalancerConfigList_To_v1alpha1_AviLoadBalancerConfigList(src, dst, nil)
+}
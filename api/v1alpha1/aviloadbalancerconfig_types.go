@@ -99,7 +99,12 @@ type AviLoadBalancerConfigStatus struct {
 // +genclient
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:resource:scope=Cluster,shortName=avilbc,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Server",type="string",JSONPath=".spec.server"
+// +kubebuilder:printcolumn:name="Cloud",type="string",JSONPath=".spec.cloudName"
+// +kubebuilder:printcolumn:name="IPAM",type="string",JSONPath=".spec.ipamType",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // AviLoadBalancerConfig is the Schema for the AviLoadBalancerConfigs API
 type AviLoadBalancerConfig struct {
@@ -0,0 +1,189 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Getter is implemented by every netoperator.vmware.com kind. It returns the
+// conditions of the status of the object as metav1.Condition, which are nil for
+// the kinds that have none.
+// +kubebuilder:object:generate=false
+type Getter interface {
+	GetConditions() []metav1.Condition
+}
+
+// Setter is implemented by the netoperator.vmware.com kinds whose status has
+// conditions.
+// +kubebuilder:object:generate=false
+type Setter interface {
+	Getter
+	SetConditions(conditions []metav1.Condition)
+}
+
+// Conditioned is an object of a netoperator.vmware.com kind whose status has
+// conditions, for reconcilers and status helpers that work with any of them.
+//
+// Most kinds of this version have their own condition type, such as
+// NetworkInterfaceCondition. For those, GetConditions returns a converted copy
+// of the conditions, so changes to it must be written back with SetConditions,
+// and SetConditions drops the ObservedGeneration of the conditions. The
+// converted conditions are valid metav1.Conditions: an empty Reason is
+// defaulted like the conversion to v1alpha2 does, from the Type of the
+// condition or else "Unknown", and a zero LastTransitionTime is defaulted to
+// the creation time of the object or else the current time.
+// +kubebuilder:object:generate=false
+type Conditioned interface {
+	metav1.Object
+	runtime.Object
+	Setter
+}
+
+var (
+	_ Conditioned = &FoundationLoadBalancerConfig{}
+	_ Conditioned = &IPAddressAllocation{}
+	_ Conditioned = &IPPool{}
+	_ Conditioned = &LoadBalancerConfig{}
+	_ Conditioned = &NamespaceNetworkConfiguration{}
+	_ Conditioned = &Network{}
+	_ Conditioned = &NetworkInterface{}
+	_ Conditioned = &VSphereDistributedNetwork{}
+	_ Conditioned = &WorkloadNetworkConfiguration{}
+	_ Getter      = &AviLoadBalancerConfig{}
+	_ Getter      = &HAProxyLoadBalancerConfig{}
+	_ Getter      = &NetworkSettings{}
+	_ Getter      = &VMXNET3NetworkInterface{}
+)
+
+// GetConditions returns nil, since the AviLoadBalancerConfig has no conditions.
+func (*AviLoadBalancerConfig) GetConditions() []metav1.Condition {
+	return nil
+}
+
+// GetConditions returns nil, since the HAProxyLoadBalancerConfig has no conditions.
+func (*HAProxyLoadBalancerConfig) GetConditions() []metav1.Condition {
+	return nil
+}
+
+// GetConditions returns a copy of the status conditions of the IPAddressAllocation.
+func (in *IPAddressAllocation) GetConditions() []metav1.Condition {
+	return toConditions(in, in.Status.Conditions, Convert_v1alpha1_IPAddressAllocationCondition_To_v1_Condition)
+}
+
+// SetConditions sets the status conditions of the IPAddressAllocation.
+func (in *IPAddressAllocation) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = fromConditions(conditions, Convert_v1_Condition_To_v1alpha1_IPAddressAllocationCondition)
+}
+
+// GetConditions returns a copy of the status conditions of the IPPool.
+func (in *IPPool) GetConditions() []metav1.Condition {
+	return toConditions(in, in.Status.Conditions, Convert_v1alpha1_IPPoolCondition_To_v1_Condition)
+}
+
+// SetConditions sets the status conditions of the IPPool.
+func (in *IPPool) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = fromConditions(conditions, Convert_v1_Condition_To_v1alpha1_IPPoolCondition)
+}
+
+// GetConditions returns a copy of the status conditions of the LoadBalancerConfig.
+func (in *LoadBalancerConfig) GetConditions() []metav1.Condition {
+	return toConditions(in, in.Status.Conditions, Convert_v1alpha1_LoadBalancerConfigCondition_To_v1_Condition)
+}
+
+// SetConditions sets the status conditions of the LoadBalancerConfig.
+func (in *LoadBalancerConfig) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = fromConditions(conditions, Convert_v1_Condition_To_v1alpha1_LoadBalancerConfigCondition)
+}
+
+// GetConditions returns a copy of the status conditions of the Network.
+func (in *Network) GetConditions() []metav1.Condition {
+	return toConditions(in, in.Status.Conditions, Convert_v1alpha1_NetworkCondition_To_v1_Condition)
+}
+
+// SetConditions sets the status conditions of the Network.
+func (in *Network) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = fromConditions(conditions, Convert_v1_Condition_To_v1alpha1_NetworkCondition)
+}
+
+// GetConditions returns a copy of the status conditions of the NetworkInterface.
+func (in *NetworkInterface) GetConditions() []metav1.Condition {
+	return toConditions(in, in.Status.Conditions, Convert_v1alpha1_NetworkInterfaceCondition_To_v1_Condition)
+}
+
+// SetConditions sets the status conditions of the NetworkInterface.
+func (in *NetworkInterface) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = fromConditions(conditions, Convert_v1_Condition_To_v1alpha1_NetworkInterfaceCondition)
+}
+
+// GetConditions returns nil, since the NetworkSettings has no conditions.
+func (*NetworkSettings) GetConditions() []metav1.Condition {
+	return nil
+}
+
+// GetConditions returns nil, since the VMXNET3NetworkInterface has no conditions.
+func (*VMXNET3NetworkInterface) GetConditions() []metav1.Condition {
+	return nil
+}
+
+// GetConditions returns a copy of the status conditions of the VSphereDistributedNetwork.
+func (in *VSphereDistributedNetwork) GetConditions() []metav1.Condition {
+	return toConditions(in, in.Status.Conditions, Convert_v1alpha1_VSphereDistributedNetworkCondition_To_v1_Condition)
+}
+
+// SetConditions sets the status conditions of the VSphereDistributedNetwork.
+func (in *VSphereDistributedNetwork) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = fromConditions(conditions, Convert_v1_Condition_To_v1alpha1_VSphereDistributedNetworkCondition)
+}
+
+// GetConditions returns the status conditions of the WorkloadNetworkConfiguration.
+func (in *WorkloadNetworkConfiguration) GetConditions() []metav1.Condition {
+	if in.Status == nil {
+		return nil
+	}
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the WorkloadNetworkConfiguration.
+func (in *WorkloadNetworkConfiguration) SetConditions(conditions []metav1.Condition) {
+	if in.Status == nil {
+		in.Status = &WorkloadNetworkConfigurationStatus{}
+	}
+	in.Status.Conditions = conditions
+}
+
+// toConditions converts the conditions of a kind of obj to metav1.Condition,
+// defaulting the Reason and LastTransitionTime that metav1.Condition requires.
+// The condition conversions of this package never fail.
+func toConditions[T any](obj metav1.Object, in []T, convert func(*T, *metav1.Condition, apiconversion.Scope) error) []metav1.Condition {
+	if in == nil {
+		return nil
+	}
+	out := make([]metav1.Condition, len(in))
+	for i := range in {
+		_ = convert(&in[i], &out[i], nil)
+		if out[i].Reason == "" {
+			out[i].Reason = defaultConditionReason(out[i].Type)
+		}
+		if out[i].LastTransitionTime.IsZero() {
+			out[i].LastTransitionTime = defaultConditionTransitionTime(obj)
+		}
+	}
+	return out
+}
+
+// fromConditions converts metav1.Condition to the conditions of a kind.
+func fromConditions[T any](in []metav1.Condition, convert func(*metav1.Condition, *T, apiconversion.Scope) error) []T {
+	if in == nil {
+		return nil
+	}
+	out := make([]T, len(in))
+	for i := range in {
+		_ = convert(&in[i], &out[i], nil)
+	}
+	return out
+}
@@ -0,0 +1,266 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConversionDataAnnotation is the annotation used to preserve condition fields
+// across conversions between v1alpha1 and the Hub version.
+//
+// On a v1alpha1 object it records the fields of the Hub version that cannot be
+// represented in v1alpha1, such as the ObservedGeneration of a condition. It is
+// set when an object is converted from the Hub and consumed when the object is
+// converted back, so that a round trip through v1alpha1 does not lose data.
+//
+// On a Hub object it records the condition fields that were defaulted when the
+// object was converted from v1alpha1, since the Hub version requires a Reason
+// and a LastTransitionTime that v1alpha1 conditions may lack. The defaulted
+// fields are cleared again when the object is converted back to v1alpha1.
+//
+// Clients should not set it.
+const ConversionDataAnnotation = "netoperator.vmware.com/conversion-data"
+
+// unknownConditionReason is the Reason given to a condition converted from
+// v1alpha1 without a Reason when its Type is not a valid Reason either.
+const unknownConditionReason = "Unknown"
+
+// conditionReasonRegexp matches the values the Hub version accepts for the
+// Reason of a condition.
+var conditionReasonRegexp = regexp.MustCompile(`^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$`)
+
+// conversionData is the value of the ConversionDataAnnotation.
+type conversionData struct {
+	// Conditions are the conditions of the Hub object, without the fields
+	// listed in Defaults.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Defaults are the condition fields that were defaulted when the Hub
+	// object was converted from v1alpha1.
+	Defaults []conditionDefaults `json:"defaults,omitempty"`
+}
+
+// conditionDefaults are the fields of a condition that were defaulted when it
+// was converted from v1alpha1.
+type conditionDefaults struct {
+	// Type is the type of the condition.
+	Type string `json:"type"`
+
+	// Reason is the defaulted Reason, if any.
+	Reason string `json:"reason,omitempty"`
+
+	// LastTransitionTime is the defaulted LastTransitionTime, if any.
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// marshalConversionData records the Hub conditions on obj, the v1alpha1 object
+// converted from the Hub, if converting them to v1alpha1 loses information.
+// keepsTransitionTime reports whether the v1alpha1 condition type has a
+// LastTransitionTime field.
+//
+// It returns, for every condition, the fields that were defaulted when the Hub
+// object was converted from v1alpha1 and that still hold their defaulted
+// value. The caller must clear these fields on the v1alpha1 conditions.
+func marshalConversionData(obj metav1.Object, conditions []metav1.Condition, keepsTransitionTime bool) ([]conditionDefaults, error) {
+	data, err := unmarshalConversionData(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	defaults := make([]conditionDefaults, len(conditions))
+	saved := make([]metav1.Condition, len(conditions))
+	lossy := false
+	for i, c := range conditions {
+		defaults[i] = takeDefaults(&data.Defaults, c.Type)
+		if defaults[i].Reason != c.Reason {
+			defaults[i].Reason = ""
+		}
+		if t := defaults[i].LastTransitionTime; t != nil && !t.Equal(&c.LastTransitionTime) {
+			defaults[i].LastTransitionTime = nil
+		}
+		if defaults[i].Reason != "" {
+			c.Reason = ""
+		}
+		if defaults[i].LastTransitionTime != nil {
+			c.LastTransitionTime = metav1.Time{}
+		}
+		saved[i] = c
+
+		// An empty Reason or LastTransitionTime that was not defaulted would
+		// be defaulted when converting back to the Hub.
+		if c.ObservedGeneration != 0 ||
+			(defaults[i].Reason == "" && c.Reason == "") ||
+			(defaults[i].LastTransitionTime == nil && (c.LastTransitionTime.IsZero() || !keepsTransitionTime)) {
+			lossy = true
+		}
+	}
+	if _, ok := obj.GetAnnotations()[ConversionDataAnnotation]; !ok && !lossy {
+		return defaults, nil
+	}
+
+	annotations := copyAnnotations(obj)
+	delete(annotations, ConversionDataAnnotation)
+	if lossy {
+		data := conversionData{Conditions: saved}
+		for _, d := range defaults {
+			if d.Reason != "" || d.LastTransitionTime != nil {
+				data.Defaults = append(data.Defaults, d)
+			}
+		}
+		value, err := json.Marshal(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal conversion data: %w", err)
+		}
+		annotations[ConversionDataAnnotation] = string(value)
+	}
+
+	setAnnotations(obj, annotations)
+	return defaults, nil
+}
+
+// restoreConversionData removes the ConversionDataAnnotation from obj, which
+// is the Hub object converted from v1alpha1, and restores the Hub-only fields
+// of every condition that has not changed since the data was recorded.
+//
+// The Reason and LastTransitionTime of the other conditions are defaulted if
+// empty, to the condition Type and the creation time of obj respectively, and
+// the defaults are recorded on obj so that converting back to v1alpha1 does
+// not lose data.
+func restoreConversionData(obj metav1.Object, conditions []metav1.Condition) error {
+	data, err := unmarshalConversionData(obj)
+	if err != nil {
+		return err
+	}
+
+	var defaults []conditionDefaults
+	for i := range conditions {
+		c := &conditions[i]
+		d := conditionDefaults{Type: c.Type}
+		if restoreCondition(c, data.Conditions) {
+			// Reapply the defaults that were cleared when the condition was
+			// recorded.
+			saved := takeDefaults(&data.Defaults, c.Type)
+			if c.Reason == "" && saved.Reason != "" {
+				c.Reason, d.Reason = saved.Reason, saved.Reason
+			}
+			if c.LastTransitionTime.IsZero() && saved.LastTransitionTime != nil {
+				c.LastTransitionTime, d.LastTransitionTime = *saved.LastTransitionTime, saved.LastTransitionTime
+			}
+		} else {
+			if c.Reason == "" {
+				c.Reason = defaultConditionReason(c.Type)
+				d.Reason = c.Reason
+			}
+			if c.LastTransitionTime.IsZero() {
+				c.LastTransitionTime = defaultConditionTransitionTime(obj)
+				d.LastTransitionTime = c.LastTransitionTime.DeepCopy()
+			}
+		}
+		if d.Reason != "" || d.LastTransitionTime != nil {
+			defaults = append(defaults, d)
+		}
+	}
+
+	if _, ok := obj.GetAnnotations()[ConversionDataAnnotation]; !ok && len(defaults) == 0 {
+		return nil
+	}
+
+	annotations := copyAnnotations(obj)
+	delete(annotations, ConversionDataAnnotation)
+	if len(defaults) > 0 {
+		value, err := json.Marshal(conversionData{Defaults: defaults})
+		if err != nil {
+			return fmt.Errorf("failed to marshal conversion data: %w", err)
+		}
+		annotations[ConversionDataAnnotation] = string(value)
+	}
+	setAnnotations(obj, annotations)
+	return nil
+}
+
+// unmarshalConversionData returns the ConversionDataAnnotation of obj.
+func unmarshalConversionData(obj metav1.Object) (conversionData, error) {
+	data := conversionData{}
+	value, ok := obj.GetAnnotations()[ConversionDataAnnotation]
+	if !ok {
+		return data, nil
+	}
+	if err := json.Unmarshal([]byte(value), &data); err != nil {
+		return data, fmt.Errorf("failed to unmarshal annotation %s: %w", ConversionDataAnnotation, err)
+	}
+	return data, nil
+}
+
+// restoreCondition restores the Hub-only fields of c from the first of the
+// saved conditions it matches, and reports whether there was one.
+func restoreCondition(c *metav1.Condition, saved []metav1.Condition) bool {
+	for _, s := range saved {
+		if s.Type != c.Type || s.Status != c.Status ||
+			s.Reason != c.Reason || s.Message != c.Message {
+			continue
+		}
+		if !c.LastTransitionTime.IsZero() && !c.LastTransitionTime.Equal(&s.LastTransitionTime) {
+			continue
+		}
+		c.LastTransitionTime = s.LastTransitionTime
+		c.ObservedGeneration = s.ObservedGeneration
+		return true
+	}
+	return false
+}
+
+// takeDefaults removes the first of the defaults recorded for conditionType
+// from defaults and returns it.
+func takeDefaults(defaults *[]conditionDefaults, conditionType string) conditionDefaults {
+	for i, d := range *defaults {
+		if d.Type == conditionType {
+			*defaults = append((*defaults)[:i:i], (*defaults)[i+1:]...)
+			return d
+		}
+	}
+	return conditionDefaults{Type: conditionType}
+}
+
+// defaultConditionReason returns the Reason of a condition of the given Type
+// that has none.
+func defaultConditionReason(conditionType string) string {
+	if conditionReasonRegexp.MatchString(conditionType) {
+		return conditionType
+	}
+	return unknownConditionReason
+}
+
+// defaultConditionTransitionTime returns the LastTransitionTime of a condition
+// of obj that has none.
+func defaultConditionTransitionTime(obj metav1.Object) metav1.Time {
+	if t := obj.GetCreationTimestamp(); !t.IsZero() {
+		return t
+	}
+	return metav1.Now().Rfc3339Copy()
+}
+
+// copyAnnotations returns a copy of the annotations of obj. Generated
+// conversions share the ObjectMeta maps between the source and destination, so
+// they must be copied before they are modified.
+func copyAnnotations(obj metav1.Object) map[string]string {
+	annotations := make(map[string]string, len(obj.GetAnnotations()))
+	for k, v := range obj.GetAnnotations() {
+		annotations[k] = v
+	}
+	return annotations
+}
+
+// setAnnotations sets the annotations of obj, leaving them nil if empty.
+func setAnnotations(obj metav1.Object, annotations map[string]string) {
+	if len(annotations) == 0 {
+		annotations = nil
+	}
+	obj.SetAnnotations(annotations)
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+var testTransitionTime = metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+
+func hubConditions() []metav1.Condition {
+	return []metav1.Condition{
+		{
+			Type:               "Ready",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 3,
+			LastTransitionTime: testTransitionTime,
+			Reason:             "Realized",
+			Message:            "ok",
+		},
+		{
+			Type:               "Failure",
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: 2,
+			LastTransitionTime: testTransitionTime,
+			Reason:             "NoFailure",
+		},
+	}
+}
+
+type conditionedKind struct {
+	name  string
+	hub   func() ctrlconversion.Hub
+	spoke func() ctrlconversion.Convertible
+	// conditions returns a pointer to the conditions of a hub object.
+	conditions func(ctrlconversion.Hub) *[]metav1.Condition
+}
+
+var conditionedKinds = []conditionedKind{
+	{
+		name:       "Network",
+		hub:        func() ctrlconversion.Hub { return &v1alpha2.Network{} },
+		spoke:      func() ctrlconversion.Convertible { return &v1alpha1.Network{} },
+		conditions: func(h ctrlconversion.Hub) *[]metav1.Condition { return &h.(*v1alpha2.Network).Status.Conditions },
+	},
+	{
+		name:  "NetworkInterface",
+		hub:   func() ctrlconversion.Hub { return &v1alpha2.NetworkInterface{} },
+		spoke: func() ctrlconversion.Convertible { return &v1alpha1.NetworkInterface{} },
+		conditions: func(h ctrlconversion.Hub) *[]metav1.Condition {
+			return &h.(*v1alpha2.NetworkInterface).Status.Conditions
+		},
+	},
+	{
+		name:       "IPPool",
+		hub:        func() ctrlconversion.Hub { return &v1alpha2.IPPool{} },
+		spoke:      func() ctrlconversion.Convertible { return &v1alpha1.IPPool{} },
+		conditions: func(h ctrlconversion.Hub) *[]metav1.Condition { return &h.(*v1alpha2.IPPool).Status.Conditions },
+	},
+	{
+		name:  "IPAddressAllocation",
+		hub:   func() ctrlconversion.Hub { return &v1alpha2.IPAddressAllocation{} },
+		spoke: func() ctrlconversion.Convertible { return &v1alpha1.IPAddressAllocation{} },
+		conditions: func(h ctrlconversion.Hub) *[]metav1.Condition {
+			return &h.(*v1alpha2.IPAddressAllocation).Status.Conditions
+		},
+	},
+	{
+		name:  "VSphereDistributedNetwork",
+		hub:   func() ctrlconversion.Hub { return &v1alpha2.VSphereDistributedNetwork{} },
+		spoke: func() ctrlconversion.Convertible { return &v1alpha1.VSphereDistributedNetwork{} },
+		conditions: func(h ctrlconversion.Hub) *[]metav1.Condition {
+			return &h.(*v1alpha2.VSphereDistributedNetwork).Status.Conditions
+		},
+	},
+	{
+		name:  "LoadBalancerConfig",
+		hub:   func() ctrlconversion.Hub { return &v1alpha2.LoadBalancerConfig{} },
+		spoke: func() ctrlconversion.Convertible { return &v1alpha1.LoadBalancerConfig{} },
+		conditions: func(h ctrlconversion.Hub) *[]metav1.Condition {
+			return &h.(*v1alpha2.LoadBalancerConfig).Status.Conditions
+		},
+	},
+}
+
+func TestConditions_HubRoundTripIsLossless(t *testing.T) {
+	for _, kind := range conditionedKinds {
+		t.Run(kind.name, func(t *testing.T) {
+			src := kind.hub()
+			*kind.conditions(src) = hubConditions()
+			src.(metav1.Object).SetAnnotations(map[string]string{"foo": "bar"})
+
+			spoke := kind.spoke()
+			if err := spoke.ConvertFrom(src); err != nil {
+				t.Fatalf("convert from hub: %v", err)
+			}
+			if _, ok := spoke.(metav1.Object).GetAnnotations()[v1alpha1.ConversionDataAnnotation]; !ok {
+				t.Errorf("expected %s annotation on the v1alpha1 object", v1alpha1.ConversionDataAnnotation)
+			}
+			if _, ok := src.(metav1.Object).GetAnnotations()[v1alpha1.ConversionDataAnnotation]; ok {
+				t.Errorf("expected the hub object annotations to be left untouched")
+			}
+
+			dst := kind.hub()
+			if err := spoke.ConvertTo(dst); err != nil {
+				t.Fatalf("convert to hub: %v", err)
+			}
+			if !apiequality.Semantic.DeepEqual(src, dst) {
+				t.Errorf("round trip mismatch:\nwant: %+v\ngot:  %+v", src, dst)
+			}
+		})
+	}
+}
+
+func TestConditions_ChangedConditionIsNotRestored(t *testing.T) {
+	for _, kind := range conditionedKinds {
+		t.Run(kind.name, func(t *testing.T) {
+			src := kind.hub()
+			*kind.conditions(src) = hubConditions()
+
+			spoke := kind.spoke()
+			if err := spoke.ConvertFrom(src); err != nil {
+				t.Fatalf("convert from hub: %v", err)
+			}
+
+			annotation := spoke.(metav1.Object).GetAnnotations()[v1alpha1.ConversionDataAnnotation]
+
+			// Simulate a v1alpha1 client flipping the Ready condition while
+			// keeping the data recorded by the original conversion.
+			changed := kind.hub()
+			*kind.conditions(changed) = hubConditions()
+			(*kind.conditions(changed))[0].Status = metav1.ConditionFalse
+			(*kind.conditions(changed))[0].ObservedGeneration = 0
+			spoke = kind.spoke()
+			if err := spoke.ConvertFrom(changed); err != nil {
+				t.Fatalf("convert from hub: %v", err)
+			}
+			spoke.(metav1.Object).SetAnnotations(map[string]string{v1alpha1.ConversionDataAnnotation: annotation})
+
+			dst := kind.hub()
+			if err := spoke.ConvertTo(dst); err != nil {
+				t.Fatalf("convert to hub: %v", err)
+			}
+			conditions := *kind.conditions(dst)
+			if got := conditions[0].ObservedGeneration; got != 0 {
+				t.Errorf("expected changed condition to have no observedGeneration, got %d", got)
+			}
+			if got := conditions[0].LastTransitionTime; got.IsZero() {
+				t.Errorf("expected changed condition to have a lastTransitionTime")
+			}
+			if got := conditions[1].ObservedGeneration; got != 2 {
+				t.Errorf("expected unchanged condition to keep observedGeneration 2, got %d", got)
+			}
+			if got := dst.(metav1.Object).GetAnnotations()[v1alpha1.ConversionDataAnnotation]; got == annotation {
+				t.Errorf("expected the recorded conversion data to be removed from the hub object")
+			}
+		})
+	}
+}
+
+func TestConditions_SpokeRoundTripDefaultsRequiredFields(t *testing.T) {
+	creationTimestamp := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name       string
+		src        ctrlconversion.Convertible
+		wantReason string
+		wantLTT    metav1.Time
+	}{
+		{
+			name: "IPPool",
+			src: &v1alpha1.IPPool{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: creationTimestamp},
+				Status: v1alpha1.IPPoolStatus{Conditions: []v1alpha1.IPPoolCondition{
+					{Type: v1alpha1.IPPoolReady, Status: "True"},
+				}},
+			},
+			wantReason: "ready",
+			wantLTT:    creationTimestamp,
+		},
+		{
+			name: "Network",
+			src: &v1alpha1.Network{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: creationTimestamp},
+				Status: v1alpha1.NetworkStatus{Conditions: []v1alpha1.NetworkCondition{
+					{Type: "Ready", Status: "True", LastTransitionTime: testTransitionTime},
+				}},
+			},
+			wantReason: "Ready",
+			wantLTT:    testTransitionTime,
+		},
+		{
+			name: "NetworkInterface",
+			src: &v1alpha1.NetworkInterface{
+				Status: v1alpha1.NetworkInterfaceStatus{Conditions: []v1alpha1.NetworkInterfaceCondition{
+					{Type: "Not a reason", Status: "False", Reason: "Failed"},
+				}},
+			},
+			wantReason: "Failed",
+		},
+		{
+			name: "VSphereDistributedNetwork",
+			src: &v1alpha1.VSphereDistributedNetwork{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: creationTimestamp},
+				Status: v1alpha1.VSphereDistributedNetworkStatus{Conditions: []v1alpha1.VSphereDistributedNetworkCondition{
+					{Type: "Not a reason", Status: "False"},
+				}},
+			},
+			wantReason: "Unknown",
+			wantLTT:    creationTimestamp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hub := kindNamed(t, tt.name).hub()
+			if err := tt.src.ConvertTo(hub); err != nil {
+				t.Fatalf("convert to hub: %v", err)
+			}
+			c := (*kindNamed(t, tt.name).conditions(hub))[0]
+			if c.Reason != tt.wantReason {
+				t.Errorf("expected reason %q, got %q", tt.wantReason, c.Reason)
+			}
+			if tt.wantLTT.IsZero() {
+				if c.LastTransitionTime.IsZero() {
+					t.Errorf("expected lastTransitionTime to be defaulted")
+				}
+			} else if !c.LastTransitionTime.Equal(&tt.wantLTT) {
+				t.Errorf("expected lastTransitionTime %v, got %v", tt.wantLTT, c.LastTransitionTime)
+			}
+
+			dst := kindNamed(t, tt.name).spoke()
+			if err := dst.ConvertFrom(hub); err != nil {
+				t.Fatalf("convert from hub: %v", err)
+			}
+			if !apiequality.Semantic.DeepEqual(tt.src, dst) {
+				t.Errorf("round trip mismatch:\nwant: %+v\ngot:  %+v", tt.src, dst)
+			}
+		})
+	}
+}
+
+func TestConditions_ChangedDefaultIsKept(t *testing.T) {
+	src := &v1alpha1.IPPool{
+		Status: v1alpha1.IPPoolStatus{Conditions: []v1alpha1.IPPoolCondition{
+			{Type: v1alpha1.IPPoolReady, Status: "True"},
+		}},
+	}
+	hub := &v1alpha2.IPPool{}
+	if err := src.ConvertTo(hub); err != nil {
+		t.Fatalf("convert to hub: %v", err)
+	}
+
+	// Simulate a v1alpha2 client setting the reason that was defaulted.
+	hub.Status.Conditions[0].Reason = "Realized"
+	dst := &v1alpha1.IPPool{}
+	if err := dst.ConvertFrom(hub); err != nil {
+		t.Fatalf("convert from hub: %v", err)
+	}
+	if got := dst.Status.Conditions[0].Reason; got != "Realized" {
+		t.Errorf("expected reason %q, got %q", "Realized", got)
+	}
+}
+
+func kindNamed(t *testing.T, name string) conditionedKind {
+	t.Helper()
+	for _, kind := range conditionedKinds {
+		if kind.name == name {
+			return kind
+		}
+	}
+	t.Fatalf("unknown kind %s", name)
+	return conditionedKind{}
+}
+
+func TestConditions_NoAnnotationWhenLossless(t *testing.T) {
+	src := &v1alpha2.NetworkInterface{}
+	src.Status.Conditions = []metav1.Condition{
+		{Type: "Ready", Status: metav1.ConditionTrue, LastTransitionTime: testTransitionTime, Reason: "Realized"},
+	}
+	dst := &v1alpha1.NetworkInterface{}
+	if err := dst.ConvertFrom(src); err != nil {
+		t.Fatalf("convert from hub: %v", err)
+	}
+	if dst.Annotations != nil {
+		t.Errorf("expected no annotations, got %v", dst.Annotations)
+	}
+	if got := dst.Status.Conditions[0].Type; got != v1alpha1.NetworkInterfaceReady {
+		t.Errorf("expected condition type %q, got %q", v1alpha1.NetworkInterfaceReady, got)
+	}
+}
+
+func TestConditions_MalformedAnnotation(t *testing.T) {
+	src := &v1alpha1.Network{}
+	src.Annotations = map[string]string{v1alpha1.ConversionDataAnnotation: "{"}
+	if err := src.ConvertTo(&v1alpha2.Network{}); err == nil {
+		t.Error("expected an error for a malformed conversion data annotation")
+	}
+}
@@ -2,6 +2,7 @@
 // SPDX-License-Identifier: Apache-2.0
 
 // +k8s:openapi-gen=true
+// +k8s:conversion-gen=github.com/vmware-tanzu/net-operator-api/api/v1alpha2
 // +kubebuilder:object:generate=true
 // +groupName=netoperator.vmware.com
 package v1alpha1
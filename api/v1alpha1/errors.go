@@ -0,0 +1,63 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// Errors for the reasons of the Failure condition of a NetworkInterface or an
+// IPAddressAllocation. They are the errors of v1alpha2, so errors.Is matches
+// the errors returned by either version of ErrorFromConditions.
+var (
+	// ErrCannotAllocIP is the error for the CannotAllocIP reason, which is used
+	// when the IPPool of the network has no free address left. Growing the
+	// pool and retrying may succeed.
+	ErrCannotAllocIP = v1alpha2.ErrCannotAllocIP
+	// ErrCannotAllocPort is the error for the CannotAllocPort reason.
+	ErrCannotAllocPort = v1alpha2.ErrCannotAllocPort
+	// ErrNetworkDeleted is the error for the NetworkDeleted reason.
+	ErrNetworkDeleted = v1alpha2.ErrNetworkDeleted
+	// ErrUnsupportedIPFamilyPolicy is the error for the
+	// UnsupportedIPFamilyPolicy reason. The NetworkInterface must be changed
+	// for it to succeed.
+	ErrUnsupportedIPFamilyPolicy = v1alpha2.ErrUnsupportedIPFamilyPolicy
+	// ErrInvalidRequestedIP is the error for the InvalidRequestedIP reason. The
+	// IPAddressAllocation must be changed for it to succeed.
+	ErrInvalidRequestedIP = v1alpha2.ErrInvalidRequestedIP
+	// ErrIPPoolRefRetrievalFailed is the error for the IPPoolRefRetrievalFailed
+	// reason.
+	ErrIPPoolRefRetrievalFailed = v1alpha2.ErrIPPoolRefRetrievalFailed
+)
+
+// ConditionError is the error for a Failure condition.
+//
+// +kubebuilder:object:generate=false
+type ConditionError = v1alpha2.ConditionError
+
+// ErrorFromConditions returns a *ConditionError if status has a Failure
+// condition with status True, and nil otherwise. See
+// v1alpha2.ErrorFromConditions.
+func ErrorFromConditions[S *NetworkInterfaceStatus | *IPAddressAllocationStatus](status S) error {
+	switch s := any(status).(type) {
+	case *NetworkInterfaceStatus:
+		if s == nil {
+			return nil
+		}
+		hub := &v1alpha2.NetworkInterfaceStatus{}
+		// The conversion of the status never fails.
+		_ = Convert_v1alpha1_NetworkInterfaceStatus_To_v1alpha2_NetworkInterfaceStatus(s, hub, nil)
+		return v1alpha2.ErrorFromConditions(hub)
+	case *IPAddressAllocationStatus:
+		if s == nil {
+			return nil
+		}
+		hub := &v1alpha2.IPAddressAllocationStatus{}
+		// The conversion of the status never fails.
+		_ = Convert_v1alpha1_IPAddressAllocationStatus_To_v1alpha2_IPAddressAllocationStatus(s, hub, nil)
+		return v1alpha2.ErrorFromConditions(hub)
+	}
+	return nil
+}
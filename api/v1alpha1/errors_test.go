@@ -0,0 +1,47 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// The reasons are tested in v1alpha2. These tests only check that
+// ErrorFromConditions converts the status and delegates to it.
+
+func TestErrorFromConditions_NetworkInterface(t *testing.T) {
+	status := &v1alpha1.NetworkInterfaceStatus{Conditions: []v1alpha1.NetworkInterfaceCondition{
+		{Type: v1alpha1.NetworkInterfaceReady, Status: corev1.ConditionFalse},
+		{Type: v1alpha1.NetworkInterfaceFailure, Status: corev1.ConditionTrue, Reason: v1alpha1.NetworkInterfaceFailureReasonCannotAllocIP, Message: "details"},
+	}}
+	err := v1alpha1.ErrorFromConditions(status)
+	if !errors.Is(err, v1alpha1.ErrCannotAllocIP) {
+		t.Errorf("expected %v, got %v", v1alpha1.ErrCannotAllocIP, err)
+	}
+	var conditionErr *v1alpha1.ConditionError
+	if !errors.As(err, &conditionErr) || conditionErr.Message != "details" {
+		t.Errorf("expected a ConditionError with the message, got %v", err)
+	}
+	if err := v1alpha1.ErrorFromConditions((*v1alpha1.NetworkInterfaceStatus)(nil)); err != nil {
+		t.Errorf("expected no error for a nil status, got %v", err)
+	}
+}
+
+func TestErrorFromConditions_IPAddressAllocation(t *testing.T) {
+	status := &v1alpha1.IPAddressAllocationStatus{Conditions: []v1alpha1.IPAddressAllocationCondition{
+		{Type: v1alpha1.IPAddressAllocationFail, Status: corev1.ConditionTrue, Reason: v1alpha1.IPAddressAllocationConditionInvalidRequestedIP},
+	}}
+	if err := v1alpha1.ErrorFromConditions(status); !errors.Is(err, v1alpha1.ErrInvalidRequestedIP) {
+		t.Errorf("expected %v, got %v", v1alpha1.ErrInvalidRequestedIP, err)
+	}
+	status.Conditions[0].Status = corev1.ConditionFalse
+	if err := v1alpha1.ErrorFromConditions(status); err != nil {
+		t.Errorf("expected no error for a False Failure condition, got %v", err)
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this FoundationLoadBalancerConfig to the Hub version.
+func (src *FoundationLoadBalancerConfig) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.FoundationLoadBalancerConfig)
+	return Convert_v1alpha1_FoundationLoadBalancerConfig_To_v1alpha2_FoundationLoadBalancerConfig(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this FoundationLoadBalancerConfig.
+func (dst *FoundationLoadBalancerConfig) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.FoundationLoadBalancerConfig)
+	return Convert_v1alpha2_FoundationLoadBalancerConfig_To_v1alpha1_FoundationLoadBalancerConfig(src, dst, nil)
+}
+
+// ConvertTo converts this FoundationLoadBalancerConfigList to the Hub version.
+func (src *FoundationLoadBalancerConfigList) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.FoundationLoadBalancerConfigList)
+	return Convert_v1alpha1_FoundationLoadBalancerConfigList_To_v1alpha2_FoundationLoadBalancerConfigList(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this FoundationLoadBalancerConfigList.
+func (dst *FoundationLoadBalancerConfigList) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.FoundationLoadBalancerConfigList)
+	return Convert_v1alpha2_FoundationLoadBalancerConfigList_To_v1alpha1_FoundationLoadBalancerConfigList(src, dst, nil)
+}
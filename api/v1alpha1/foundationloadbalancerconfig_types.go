@@ -128,6 +128,7 @@ type FoundationLoadBalancerNodeStatus struct {
 	// WorkloadNetworkInterface defines the workload NetworkInterfaces if they exist.
 	//
 	// +optional
+	// +listType=atomic
 	WorkloadNetworkInterfaces []NetworkInterfaceReference `json:"workloadNetworkInterfaces,omitempty"`
 
 	// VIPNetworkInterface is the interface bound to the Virtual IP Network.
@@ -144,6 +145,8 @@ type FoundationLoadBalancerConfigStatus struct {
 	// Nodes list specific information about each deployed node.
 	//
 	// +optional
+	// +listType=map
+	// +listMapKey=nodeID
 	Nodes []FoundationLoadBalancerNodeStatus `json:"nodes,omitempty"`
 
 	// VirtualServerIPPoolsUtilization describes the current states of virtual server IP addresses utilization.
@@ -160,6 +163,8 @@ type FoundationLoadBalancerConfigStatus struct {
 	// Conditions describes states of the load balancer at specific points in time.
 	//
 	// +optional
+	// +listType=map
+	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
 	// effectiveVirtualServerIPPools is the union of explicitly referenced pools
@@ -208,6 +213,7 @@ type FoundationLoadBalancerConfigSpec struct {
 	//
 	// +kubebuilder:validation:MaxItems:=1
 	// +optional
+	// +listType=atomic
 	WorkloadNetworks []NetworkReference `json:"workloadNetworks,omitempty"`
 
 	// VirtualIPNetwork points to the Network used to program node VIP network interfaces.
@@ -270,12 +276,14 @@ type FoundationLoadBalancerNetworkConfigSpec struct {
 	//
 	// +kubebuilder:default:={}
 	// +optional
+	// +listType=atomic
 	DNSServers []string `json:"dnsServers"`
 
 	// DNSSearchDomains are the domains resolvable on the specified DNSServers.
 	//
 	// +kubebuilder:default:={}
 	// +optional
+	// +listType=atomic
 	DNSSearchDomains []string `json:"dnsSearchDomains"`
 
 	// NTPServers are the servers used to sync time across nodes.
@@ -284,6 +292,7 @@ type FoundationLoadBalancerNetworkConfigSpec struct {
 	//
 	// +kubebuilder:default:={}
 	// +optional
+	// +listType=atomic
 	NTPServers []string `json:"ntpServers"`
 
 	// SyslogEndpoint configures the syslog server. It accepts a protocol, host and port.
@@ -307,7 +316,11 @@ type FoundationLoadBalancerNetworkConfigSpec struct {
 // +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:resource:shortName=flb
+// +kubebuilder:resource:shortName=flb,categories=netop
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.version"
+// +kubebuilder:printcolumn:name="Size",type="string",JSONPath=".spec.deploymentSpec.size",priority=1
+// +kubebuilder:printcolumn:name="Healthy",type="string",JSONPath=`.status.conditions[?(@.type=="Healthy")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // FoundationLoadBalancerConfig is the Schema for the FoundationLoadBalancerConfig API
 type FoundationLoadBalancerConfig struct {
@@ -21,6 +21,10 @@ var (
 
 	// AddToScheme adds the types in this group-version to the given scheme.
 	AddToScheme = SchemeBuilder.AddToScheme
+
+	// localSchemeBuilder is used by the generated conversion functions to
+	// register themselves with the SchemeBuilder.
+	localSchemeBuilder = SchemeBuilder
 )
 
 // Resource takes an unqualified resource and returns a Group qualified GroupResource
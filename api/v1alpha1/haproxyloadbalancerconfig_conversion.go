@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this HAProxyLoadBalancerConfig to the Hub version.
+func (src *HAProxyLoadBalancerConfig) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.HAProxyLoadBalancerConfig)
+	return Convert_v1alpha1_HAProxyLoadBalancerConfig_To_v1alpha2_HAProxyLoadBalancerConfig(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this HAProxyLoadBalancerConfig.
+func (dst *HAProxyLoadBalancerConfig) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.HAProxyLoadBalancerConfig)
+	return Convert_v1alpha2_HAProxyLoadBalancerConfig_To_v1alpha1_HAProxyLoadBalancerConfig(src, dst, nil)
+}
+
+// ConvertTo converts this HAProxyLoadBalancerConfigList to the Hub version.
+func (src *HAProxyLoadBalancerConfigList) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.HAProxyLoadBalancerConfigList)
+	return Convert_v1alpha1_HAProxyLoadBalancerConfigList_To_v1alpha2_HAProxyLoadBalancerConfigList(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this HAProxyLoadBalancerConfigList.
+func (dst *HAProxyLoadBalancerConfigList) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.HAProxyLoadBalancerConfigList)
+	return Convert_v1alpha2_HAProxyLoadBalancerConfigList_To_v1alpha1_HAProxyLoadBalancerConfigList(src, dst, nil)
+}
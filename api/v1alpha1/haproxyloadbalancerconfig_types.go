@@ -19,6 +19,7 @@ type HAProxyLoadBalancerConfigSpec struct {
 	// The strings should include the host, port, and API version, ex.:
 	// https://hostname:port/v1
 	// +kubebuilder:validation:MinItems=1
+	// +listType=atomic
 	EndPointURLs []string `json:"endPointURLs"`
 
 	// ServerName is used to verify the hostname on the returned
@@ -67,7 +68,11 @@ type HAProxyLoadBalancerConfigStatus struct {
 // +genclient
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:resource:scope=Cluster,shortName=haproxylbc,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Endpoints",type="string",JSONPath=".spec.endPointURLs[*]"
+// +kubebuilder:printcolumn:name="Server Name",type="string",JSONPath=".spec.serverName",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // HAProxyLoadBalancerConfig is the Schema for the HAProxyLoadBalancerConfigs API
 type HAProxyLoadBalancerConfig struct {
@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this IPAddressAllocation to the Hub version.
+func (src *IPAddressAllocation) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.IPAddressAllocation)
+	return Convert_v1alpha1_IPAddressAllocation_To_v1alpha2_IPAddressAllocation(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this IPAddressAllocation.
+func (dst *IPAddressAllocation) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.IPAddressAllocation)
+	return Convert_v1alpha2_IPAddressAllocation_To_v1alpha1_IPAddressAllocation(src, dst, nil)
+}
+
+// ConvertTo converts this IPAddressAllocationList to the Hub version.
+func (src *IPAddressAllocationList) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.IPAddressAllocationList)
+	return Convert_v1alpha1_IPAddressAllocationList_To_v1alpha2_IPAddressAllocationList(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this IPAddressAllocationList.
+func (dst *IPAddressAllocationList) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.IPAddressAllocationList)
+	return Convert_v1alpha2_IPAddressAllocationList_To_v1alpha1_IPAddressAllocationList(src, dst, nil)
+}
+
+// Convert_v1alpha1_IPAddressAllocation_To_v1alpha2_IPAddressAllocation converts an IPAddressAllocation to the Hub
+// version, restoring the condition fields recorded when it was converted from
+// the Hub and defaulting the ones the Hub version requires.
+func Convert_v1alpha1_IPAddressAllocation_To_v1alpha2_IPAddressAllocation(in *IPAddressAllocation, out *v1alpha2.IPAddressAllocation, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha1_IPAddressAllocation_To_v1alpha2_IPAddressAllocation(in, out, s); err != nil {
+		return err
+	}
+	return restoreConversionData(out, out.Status.Conditions)
+}
+
+// Convert_v1alpha2_IPAddressAllocation_To_v1alpha1_IPAddressAllocation converts the Hub version to an
+// IPAddressAllocation, recording the condition fields v1alpha1 cannot represent
+// and clearing the ones defaulted when it was converted from v1alpha1.
+func Convert_v1alpha2_IPAddressAllocation_To_v1alpha1_IPAddressAllocation(in *v1alpha2.IPAddressAllocation, out *IPAddressAllocation, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha2_IPAddressAllocation_To_v1alpha1_IPAddressAllocation(in, out, s); err != nil {
+		return err
+	}
+	defaults, err := marshalConversionData(out, in.Status.Conditions, true)
+	if err != nil {
+		return err
+	}
+	for i, d := range defaults {
+		if d.Reason != "" {
+			out.Status.Conditions[i].Reason = ""
+		}
+		if d.LastTransitionTime != nil {
+			out.Status.Conditions[i].LastTransitionTime = metav1.Time{}
+		}
+	}
+	return nil
+}
+
+// Convert_v1alpha1_IPAddressAllocationCondition_To_v1_Condition converts an IPAddressAllocationCondition
+// to a metav1.Condition.
+func Convert_v1alpha1_IPAddressAllocationCondition_To_v1_Condition(in *IPAddressAllocationCondition, out *metav1.Condition, _ apiconversion.Scope) error {
+	out.Type = string(in.Type)
+	out.Status = metav1.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = string(in.Reason)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_Condition_To_v1alpha1_IPAddressAllocationCondition converts a metav1.Condition
+// to an IPAddressAllocationCondition. The ObservedGeneration of the condition
+// cannot be represented and is recorded in the ConversionDataAnnotation instead.
+func Convert_v1_Condition_To_v1alpha1_IPAddressAllocationCondition(in *metav1.Condition, out *IPAddressAllocationCondition, _ apiconversion.Scope) error {
+	out.Type = IPAddressAllocationConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = IPAddressAllocationConditionReason(in.Reason)
+	out.Message = in.Message
+	return nil
+}
@@ -64,11 +64,19 @@ type IPAddressAllocationStatus struct {
 	// IPAddress is the actually allocated IP address.
 	IPAddress string `json:"ipaddress,omitempty"`
 	// Conditions provide detailed information about the status of the allocation.
+	// +listType=map
+	// +listMapKey=type
 	Conditions []IPAddressAllocationCondition `json:"conditions,omitempty"`
 }
 
 // +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=ipalloc,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Pool",type="string",JSONPath=".spec.poolRef.name"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.ipaddress"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // IPAddressAllocation represents a request for IP address allocation, including the desired state and current status.
 type IPAddressAllocation struct {
@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"net/netip"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// Addr parses IP. See v1alpha2.IPConfig.Addr.
+func (in *IPConfig) Addr() (netip.Addr, error) {
+	return in.hub().Addr()
+}
+
+// IPPrefix returns IP together with its prefix length, resolving Prefix and the
+// deprecated SubnetMask. See v1alpha2.IPConfig.IPPrefix.
+func (in *IPConfig) IPPrefix() (netip.Prefix, error) {
+	return in.hub().IPPrefix()
+}
+
+// GatewayAddr parses Gateway. See v1alpha2.IPConfig.GatewayAddr.
+func (in *IPConfig) GatewayAddr() (netip.Addr, error) {
+	return in.hub().GatewayAddr()
+}
+
+func (in *IPConfig) hub() *v1alpha2.IPConfig {
+	hub := &v1alpha2.IPConfig{}
+	// The conversion of an IPConfig never fails.
+	_ = Convert_v1alpha1_IPConfig_To_v1alpha2_IPConfig(in, hub, nil)
+	return hub
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1_test
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func prefixLength(n int32) *int32 {
+	return &n
+}
+
+func TestIPConfigIPPrefix(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  v1alpha1.IPConfig
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "IPv4 subnet mask",
+			config: v1alpha1.IPConfig{IP: "192.168.1.10", SubnetMask: "255.255.255.0"},
+			want:   "192.168.1.10/24",
+		},
+		{
+			name:   "IPv4 prefix takes precedence over subnet mask",
+			config: v1alpha1.IPConfig{IP: "192.168.1.10", SubnetMask: "255.255.255.0", Prefix: prefixLength(20)},
+			want:   "192.168.1.10/20",
+		},
+		{
+			name:   "IPv4 prefix ignores an invalid subnet mask",
+			config: v1alpha1.IPConfig{IP: "10.0.0.1", SubnetMask: "bogus", Prefix: prefixLength(8)},
+			want:   "10.0.0.1/8",
+		},
+		{
+			name:   "IPv4 zero mask",
+			config: v1alpha1.IPConfig{IP: "10.0.0.1", SubnetMask: "0.0.0.0"},
+			want:   "10.0.0.1/0",
+		},
+		{
+			name:   "IPv6 prefix",
+			config: v1alpha1.IPConfig{IP: "fd00::10", IPFamily: corev1.IPv6Protocol, Prefix: prefixLength(64)},
+			want:   "fd00::10/64",
+		},
+		{
+			name:   "IPv6 prefix of 128",
+			config: v1alpha1.IPConfig{IP: "fd00::10", Prefix: prefixLength(128)},
+			want:   "fd00::10/128",
+		},
+		{
+			name:   "IPv6 subnet mask",
+			config: v1alpha1.IPConfig{IP: "fd00::10", SubnetMask: "ffff:ffff:ffff:ff80::"},
+			want:   "fd00::10/57",
+		},
+		{
+			name:    "IPv4 prefix too long",
+			config:  v1alpha1.IPConfig{IP: "10.0.0.1", Prefix: prefixLength(64)},
+			wantErr: true,
+		},
+		{
+			name:    "negative prefix",
+			config:  v1alpha1.IPConfig{IP: "fd00::1", Prefix: prefixLength(-1)},
+			wantErr: true,
+		},
+		{
+			name:    "IPv4 mask for an IPv6 address",
+			config:  v1alpha1.IPConfig{IP: "fd00::1", SubnetMask: "255.255.255.0"},
+			wantErr: true,
+		},
+		{
+			name:    "non-contiguous mask",
+			config:  v1alpha1.IPConfig{IP: "10.0.0.1", SubnetMask: "255.0.255.0"},
+			wantErr: true,
+		},
+		{
+			name:    "neither prefix nor mask",
+			config:  v1alpha1.IPConfig{IP: "10.0.0.1"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid ip",
+			config:  v1alpha1.IPConfig{IP: "10.0.0.256", Prefix: prefixLength(24)},
+			wantErr: true,
+		},
+		{
+			name:    "ip family mismatch",
+			config:  v1alpha1.IPConfig{IP: "10.0.0.1", IPFamily: corev1.IPv6Protocol, Prefix: prefixLength(24)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.config.IPPrefix()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != netip.MustParsePrefix(tt.want) {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestIPConfigAddr(t *testing.T) {
+	config := v1alpha1.IPConfig{IP: "fd00::10", IPFamily: corev1.IPv6Protocol, Gateway: "fd00::1"}
+	addr, err := config.Addr()
+	if err != nil || addr != netip.MustParseAddr("fd00::10") {
+		t.Errorf("expected fd00::10, got %s (%v)", addr, err)
+	}
+	gateway, err := config.GatewayAddr()
+	if err != nil || gateway != netip.MustParseAddr("fd00::1") {
+		t.Errorf("expected fd00::1, got %s (%v)", gateway, err)
+	}
+}
+
+func TestIPConfigGatewayAddr(t *testing.T) {
+	config := v1alpha1.IPConfig{IP: "10.0.0.10"}
+	if gateway, err := config.GatewayAddr(); err != nil || gateway.IsValid() {
+		t.Errorf("expected no gateway, got %s (%v)", gateway, err)
+	}
+	config.Gateway = "fd00::1"
+	if _, err := config.GatewayAddr(); err == nil {
+		t.Error("expected an error for a gateway of another family")
+	}
+	config.Gateway = "gateway"
+	if _, err := config.GatewayAddr(); err == nil {
+		t.Error("expected an error for an invalid gateway")
+	}
+}
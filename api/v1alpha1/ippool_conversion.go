@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this IPPool to the Hub version.
+func (src *IPPool) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.IPPool)
+	return Convert_v1alpha1_IPPool_To_v1alpha2_IPPool(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this IPPool.
+func (dst *IPPool) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.IPPool)
+	return Convert_v1alpha2_IPPool_To_v1alpha1_IPPool(src, dst, nil)
+}
+
+// ConvertTo converts this IPPoolList to the Hub version.
+func (src *IPPoolList) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.IPPoolList)
+	return Convert_v1alpha1_IPPoolList_To_v1alpha2_IPPoolList(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this IPPoolList.
+func (dst *IPPoolList) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.IPPoolList)
+	return Convert_v1alpha2_IPPoolList_To_v1alpha1_IPPoolList(src, dst, nil)
+}
+
+// Convert_v1alpha1_IPPool_To_v1alpha2_IPPool converts an IPPool to the Hub
+// version, restoring the condition fields recorded when it was converted from
+// the Hub and defaulting the ones the Hub version requires.
+func Convert_v1alpha1_IPPool_To_v1alpha2_IPPool(in *IPPool, out *v1alpha2.IPPool, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha1_IPPool_To_v1alpha2_IPPool(in, out, s); err != nil {
+		return err
+	}
+	return restoreConversionData(out, out.Status.Conditions)
+}
+
+// Convert_v1alpha2_IPPool_To_v1alpha1_IPPool converts the Hub version to an
+// IPPool, recording the condition fields v1alpha1 cannot represent
+// and clearing the ones defaulted when it was converted from v1alpha1.
+func Convert_v1alpha2_IPPool_To_v1alpha1_IPPool(in *v1alpha2.IPPool, out *IPPool, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha2_IPPool_To_v1alpha1_IPPool(in, out, s); err != nil {
+		return err
+	}
+	defaults, err := marshalConversionData(out, in.Status.Conditions, false)
+	if err != nil {
+		return err
+	}
+	for i, d := range defaults {
+		if d.Reason != "" {
+			out.Status.Conditions[i].Reason = ""
+		}
+	}
+	return nil
+}
+
+// Convert_v1alpha1_IPPoolCondition_To_v1_Condition converts an IPPoolCondition
+// to a metav1.Condition.
+func Convert_v1alpha1_IPPoolCondition_To_v1_Condition(in *IPPoolCondition, out *metav1.Condition, _ apiconversion.Scope) error {
+	out.Type = string(in.Type)
+	out.Status = metav1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_Condition_To_v1alpha1_IPPoolCondition converts a metav1.Condition
+// to an IPPoolCondition. The LastTransitionTime and ObservedGeneration of the condition
+// cannot be represented and are recorded in the ConversionDataAnnotation instead.
+func Convert_v1_Condition_To_v1alpha1_IPPoolCondition(in *metav1.Condition, out *IPPoolCondition, _ apiconversion.Scope) error {
+	out.Type = IPPoolConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
@@ -77,13 +77,21 @@ type IPPoolStatus struct {
 	// Allocated represents the number of IP addresses currently allocated to services.
 	Allocated int64 `json:"allocated,omitempty"`
 	// Conditions is an array of current observed IPPool conditions.
+	// +listType=map
+	// +listMapKey=type
 	Conditions []IPPoolCondition `json:"conditions,omitempty"`
 }
 
 // +genclient
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:resource:scope=Cluster,shortName=ippool,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Start",type="string",JSONPath=".spec.startingAddress"
+// +kubebuilder:printcolumn:name="Count",type="integer",JSONPath=".spec.addressCount"
+// +kubebuilder:printcolumn:name="Allocated",type="integer",JSONPath=".status.allocated"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="ready")].status`,priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // IPPool is the Schema for the ippools API.
 // It represents a pool of IP addresses that are owned and managed by the IPPool controller.
@@ -0,0 +1,27 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/pkg/iprange"
+)
+
+// Range returns the range of AddressCount addresses that starts at
+// StartingAddress. See iprange.New.
+func (in *IPRange) Range() (iprange.Range, error) {
+	return iprange.Parse(in.StartingAddress, in.AddressCount)
+}
+
+// Range returns the range of AddressCount addresses that starts at
+// StartingAddress. See iprange.New.
+func (in *IPPoolSpec) Range() (iprange.Range, error) {
+	return iprange.Parse(in.StartingAddress, in.AddressCount)
+}
+
+// Range returns the range of Count addresses that starts at Address. See
+// iprange.New.
+func (in *VSphereDistributedNetworkIPRange) Range() (iprange.Range, error) {
+	return iprange.Parse(in.Address, in.Count)
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/iprange"
+)
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		rng     func() (iprange.Range, error)
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "IPRange",
+			rng:  (&v1alpha1.IPRange{StartingAddress: "10.0.0.10", AddressCount: 10}).Range,
+			want: "10.0.0.10-10.0.0.19",
+		},
+		{
+			name: "IPPoolSpec",
+			rng:  (&v1alpha1.IPPoolSpec{StartingAddress: "fd00::ff", AddressCount: 2}).Range,
+			want: "fd00::ff-fd00::100",
+		},
+		{
+			name: "VSphereDistributedNetworkIPRange",
+			rng:  (&v1alpha1.VSphereDistributedNetworkIPRange{Address: "192.168.0.0", Count: 256}).Range,
+			want: "192.168.0.0-192.168.0.255",
+		},
+		{
+			name:    "no address count",
+			rng:     (&v1alpha1.IPPoolSpec{StartingAddress: "10.0.0.10"}).Range,
+			wantErr: true,
+		},
+		{
+			name:    "invalid address",
+			rng:     (&v1alpha1.VSphereDistributedNetworkIPRange{Address: "bogus", Count: 1}).Range,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := tt.rng()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got %s", r)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if r.String() != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, r)
+			}
+		})
+	}
+}
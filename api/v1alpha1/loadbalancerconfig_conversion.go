@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this LoadBalancerConfig to the Hub version.
+func (src *LoadBalancerConfig) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.LoadBalancerConfig)
+	return Convert_v1alpha1_LoadBalancerConfig_To_v1alpha2_LoadBalancerConfig(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this LoadBalancerConfig.
+func (dst *LoadBalancerConfig) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.LoadBalancerConfig)
+	return Convert_v1alpha2_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(src, dst, nil)
+}
+
+// ConvertTo converts this LoadBalancerConfigList to the Hub version.
+func (src *LoadBalancerConfigList) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.LoadBalancerConfigList)
+	return Convert_v1alpha1_LoadBalancerConfigList_To_v1alpha2_LoadBalancerConfigList(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this LoadBalancerConfigList.
+func (dst *LoadBalancerConfigList) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.LoadBalancerConfigList)
+	return Convert_v1alpha2_LoadBalancerConfigList_To_v1alpha1_LoadBalancerConfigList(src, dst, nil)
+}
+
+// Convert_v1alpha1_LoadBalancerConfig_To_v1alpha2_LoadBalancerConfig converts a LoadBalancerConfig to the Hub
+// version, restoring the condition fields recorded when it was converted from
+// the Hub and defaulting the ones the Hub version requires.
+func Convert_v1alpha1_LoadBalancerConfig_To_v1alpha2_LoadBalancerConfig(in *LoadBalancerConfig, out *v1alpha2.LoadBalancerConfig, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha1_LoadBalancerConfig_To_v1alpha2_LoadBalancerConfig(in, out, s); err != nil {
+		return err
+	}
+	return restoreConversionData(out, out.Status.Conditions)
+}
+
+// Convert_v1alpha2_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig converts the Hub version to a
+// LoadBalancerConfig, recording the condition fields v1alpha1 cannot represent
+// and clearing the ones defaulted when it was converted from v1alpha1.
+func Convert_v1alpha2_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *v1alpha2.LoadBalancerConfig, out *LoadBalancerConfig, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha2_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in, out, s); err != nil {
+		return err
+	}
+	defaults, err := marshalConversionData(out, in.Status.Conditions, true)
+	if err != nil {
+		return err
+	}
+	for i, d := range defaults {
+		if d.Reason != "" {
+			out.Status.Conditions[i].Reason = ""
+		}
+		if d.LastTransitionTime != nil {
+			out.Status.Conditions[i].LastTransitionTime = metav1.Time{}
+		}
+	}
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerConfigCondition_To_v1_Condition converts a LoadBalancerConfigCondition
+// to a metav1.Condition.
+func Convert_v1alpha1_LoadBalancerConfigCondition_To_v1_Condition(in *LoadBalancerConfigCondition, out *metav1.Condition, _ apiconversion.Scope) error {
+	out.Type = string(in.Type)
+	out.Status = metav1.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_Condition_To_v1alpha1_LoadBalancerConfigCondition converts a metav1.Condition
+// to a LoadBalancerConfigCondition. The ObservedGeneration of the condition
+// cannot be represented and is recorded in the ConversionDataAnnotation instead.
+func Convert_v1_Condition_To_v1alpha1_LoadBalancerConfigCondition(in *metav1.Condition, out *LoadBalancerConfigCondition, _ apiconversion.Scope) error {
+	out.Type = LoadBalancerConfigConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
@@ -87,13 +87,20 @@ type LoadBalancerConfigSpec struct {
 // LoadBalancerConfigStatus defines the observed state of LoadBalancerConfig
 type LoadBalancerConfigStatus struct {
 	// Conditions is an array of current observed load balancer conditions
+	// +listType=map
+	// +listMapKey=type
 	Conditions []LoadBalancerConfigCondition `json:"conditions,omitempty"`
 }
 
 // +genclient
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:resource:scope=Cluster,shortName=lbc,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.providerRef.name",priority=1
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // LoadBalancerConfig is the Schema for the LoadBalancerConfigs API
 type LoadBalancerConfig struct {
@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this NamespaceNetworkConfiguration to the Hub version.
+func (src *NamespaceNetworkConfiguration) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.NamespaceNetworkConfiguration)
+	return Convert_v1alpha1_NamespaceNetworkConfiguration_To_v1alpha2_NamespaceNetworkConfiguration(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this NamespaceNetworkConfiguration.
+func (dst *NamespaceNetworkConfiguration) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.NamespaceNetworkConfiguration)
+	return Convert_v1alpha2_NamespaceNetworkConfiguration_To_v1alpha1_NamespaceNetworkConfiguration(src, dst, nil)
+}
+
+// ConvertTo converts this NamespaceNetworkConfigurationList to the Hub version.
+func (src *NamespaceNetworkConfigurationList) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.NamespaceNetworkConfigurationList)
+	return Convert_v1alpha1_NamespaceNetworkConfigurationList_To_v1alpha2_NamespaceNetworkConfigurationList(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this NamespaceNetworkConfigurationList.
+func (dst *NamespaceNetworkConfigurationList) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.NamespaceNetworkConfigurationList)
+	return Convert_v1alpha2_NamespaceNetworkConfigurationList_To_v1alpha1_NamespaceNetworkConfigurationList(src, dst, nil)
+}
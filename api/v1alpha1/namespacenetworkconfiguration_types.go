@@ -26,6 +26,18 @@ const (
 	// readiness reflects whether all cluster-scoped resources were created
 	// successfully.
 	NamespaceNetworkConditionReady = "Ready"
+
+	// NamespaceNetworkReasonPending is set on a condition with status False
+	// when reconciliation has not yet completed.
+	NamespaceNetworkReasonPending = "Pending"
+
+	// NamespaceNetworkReasonFailed is set on a condition with status False when
+	// the controller encountered an error during reconciliation.
+	NamespaceNetworkReasonFailed = "Failed"
+
+	// NamespaceNetworkReasonReconciled is set on the Ready condition when it is
+	// True.
+	NamespaceNetworkReasonReconciled = "Reconciled"
 )
 
 // NamespaceNetworkReconciliationStatus is the reconciliation state of a single
@@ -192,7 +204,8 @@ type AutoCreateVPCConfig struct {
 	// +optional
 	// +kubebuilder:validation:MaxItems=16
 	// +kubebuilder:validation:items:MaxLength=64
-	// +kubebuilder:validation:items:Pattern=`^([0-9]{1,3}\.){3}[0-9]{1,3}/[0-9]{1,2}$`
+	// +kubebuilder:validation:XValidation:rule="oldSelf.hasValue() || self.all(c, isCIDR(c) && cidr(c).ip().family() == 4)",message="privateCIDRs must contain only valid IPv4 CIDRs",optionalOldSelf=true
+	// +kubebuilder:validation:XValidation:rule="self.all(c, (isCIDR(c) && cidr(c).ip().family() == 4) || c in oldSelf)",message="privateCIDRs must contain only valid IPv4 CIDRs"
 	// +listType=atomic
 	PrivateCIDRs []string `json:"privateCIDRs,omitempty"`
 }
@@ -320,7 +333,8 @@ type NSXTier1Config struct {
 	// +optional
 	// +kubebuilder:validation:MaxItems=16
 	// +kubebuilder:validation:items:MaxLength=64
-	// +kubebuilder:validation:items:Pattern=`^([0-9]{1,3}\.){3}[0-9]{1,3}/[0-9]{1,2}$`
+	// +kubebuilder:validation:XValidation:rule="oldSelf.hasValue() || self.all(c, isCIDR(c) && cidr(c).ip().family() == 4)",message="namespaceCIDRs must contain only valid IPv4 CIDRs",optionalOldSelf=true
+	// +kubebuilder:validation:XValidation:rule="self.all(c, (isCIDR(c) && cidr(c).ip().family() == 4) || c in oldSelf)",message="namespaceCIDRs must contain only valid IPv4 CIDRs"
 	// +listType=atomic
 	NamespaceCIDRs []string `json:"namespaceCIDRs,omitempty"`
 
@@ -333,7 +347,8 @@ type NSXTier1Config struct {
 	// +optional
 	// +kubebuilder:validation:MaxItems=16
 	// +kubebuilder:validation:items:MaxLength=64
-	// +kubebuilder:validation:items:Pattern=`^([0-9]{1,3}\.){3}[0-9]{1,3}/[0-9]{1,2}$`
+	// +kubebuilder:validation:XValidation:rule="oldSelf.hasValue() || self.all(c, isCIDR(c) && cidr(c).ip().family() == 4)",message="ingressCIDRs must contain only valid IPv4 CIDRs",optionalOldSelf=true
+	// +kubebuilder:validation:XValidation:rule="self.all(c, (isCIDR(c) && cidr(c).ip().family() == 4) || c in oldSelf)",message="ingressCIDRs must contain only valid IPv4 CIDRs"
 	// +listType=atomic
 	IngressCIDRs []string `json:"ingressCIDRs,omitempty"`
 
@@ -345,7 +360,8 @@ type NSXTier1Config struct {
 	// +optional
 	// +kubebuilder:validation:MaxItems=16
 	// +kubebuilder:validation:items:MaxLength=64
-	// +kubebuilder:validation:items:Pattern=`^([0-9]{1,3}\.){3}[0-9]{1,3}/[0-9]{1,2}$`
+	// +kubebuilder:validation:XValidation:rule="oldSelf.hasValue() || self.all(c, isCIDR(c) && cidr(c).ip().family() == 4)",message="egressCIDRs must contain only valid IPv4 CIDRs",optionalOldSelf=true
+	// +kubebuilder:validation:XValidation:rule="self.all(c, (isCIDR(c) && cidr(c).ip().family() == 4) || c in oldSelf)",message="egressCIDRs must contain only valid IPv4 CIDRs"
 	// +listType=atomic
 	EgressCIDRs []string `json:"egressCIDRs,omitempty"`
 
@@ -498,8 +514,11 @@ type NamespaceNetworkStatus struct {
 // +genclient
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:scope=Cluster,shortName=nnc
+// +kubebuilder:resource:scope=Cluster,shortName=nnc,categories=netop
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // NamespaceNetworkConfiguration is a cluster-scoped resource that declares the
 // network configuration to be applied to one or more Namespaces.
@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this Network to the Hub version.
+func (src *Network) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.Network)
+	return Convert_v1alpha1_Network_To_v1alpha2_Network(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this Network.
+func (dst *Network) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.Network)
+	return Convert_v1alpha2_Network_To_v1alpha1_Network(src, dst, nil)
+}
+
+// ConvertTo converts this NetworkList to the Hub version.
+func (src *NetworkList) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.NetworkList)
+	return Convert_v1alpha1_NetworkList_To_v1alpha2_NetworkList(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this NetworkList.
+func (dst *NetworkList) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.NetworkList)
+	return Convert_v1alpha2_NetworkList_To_v1alpha1_NetworkList(src, dst, nil)
+}
+
+// Convert_v1alpha1_Network_To_v1alpha2_Network converts a Network to the Hub
+// version, restoring the condition fields recorded when it was converted from
+// the Hub and defaulting the ones the Hub version requires.
+func Convert_v1alpha1_Network_To_v1alpha2_Network(in *Network, out *v1alpha2.Network, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha1_Network_To_v1alpha2_Network(in, out, s); err != nil {
+		return err
+	}
+	return restoreConversionData(out, out.Status.Conditions)
+}
+
+// Convert_v1alpha2_Network_To_v1alpha1_Network converts the Hub version to a
+// Network, recording the condition fields v1alpha1 cannot represent
+// and clearing the ones defaulted when it was converted from v1alpha1.
+func Convert_v1alpha2_Network_To_v1alpha1_Network(in *v1alpha2.Network, out *Network, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha2_Network_To_v1alpha1_Network(in, out, s); err != nil {
+		return err
+	}
+	defaults, err := marshalConversionData(out, in.Status.Conditions, true)
+	if err != nil {
+		return err
+	}
+	for i, d := range defaults {
+		if d.Reason != "" {
+			out.Status.Conditions[i].Reason = ""
+		}
+		if d.LastTransitionTime != nil {
+			out.Status.Conditions[i].LastTransitionTime = metav1.Time{}
+		}
+	}
+	return nil
+}
+
+// Convert_v1alpha1_NetworkCondition_To_v1_Condition converts a NetworkCondition
+// to a metav1.Condition.
+func Convert_v1alpha1_NetworkCondition_To_v1_Condition(in *NetworkCondition, out *metav1.Condition, _ apiconversion.Scope) error {
+	out.Type = string(in.Type)
+	out.Status = metav1.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = string(in.Reason)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_Condition_To_v1alpha1_NetworkCondition converts a metav1.Condition
+// to a NetworkCondition. The ObservedGeneration of the condition
+// cannot be represented and is recorded in the ConversionDataAnnotation instead.
+func Convert_v1_Condition_To_v1alpha1_NetworkCondition(in *metav1.Condition, out *NetworkCondition, _ apiconversion.Scope) error {
+	out.Type = NetworkConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = NetworkConditionReason(in.Reason)
+	out.Message = in.Message
+	return nil
+}
@@ -66,10 +66,13 @@ type NetworkSpec struct {
 	// ProviderRef is reference to a network provider object that provides this type of network.
 	ProviderRef NetworkProviderReference `json:"providerRef"`
 	// DNS is a list of DNS server IPs to associate with network interfaces on this network.
+	// +listType=atomic
 	DNS []string `json:"dns,omitempty"`
 	// DNSSearchDomains is a list of DNS search domains to associate with network interfaces on this network.
+	// +listType=atomic
 	DNSSearchDomains []string `json:"dnsSearchDomains,omitempty"`
 	// NTP is a list of NTP server DNS names or IP addresses to use on this network.
+	// +listType=atomic
 	NTP []string `json:"ntp,omitempty"`
 }
 
@@ -93,6 +96,8 @@ type NetworkCondition struct {
 type NetworkStatus struct {
 	// Conditions is an array of current observed network conditions.
 	// +optional
+	// +listType=map
+	// +listMapKey=type
 	Conditions []NetworkCondition `json:"conditions,omitempty"`
 	// SupportedIPFamilies lists the IP families that are available on this network,
 	// as determined by the backing network provider (e.g. the IP families of the
@@ -100,6 +105,7 @@ type NetworkStatus struct {
 	// to understand which IPFamilyPolicy values are valid when creating a NetworkInterface
 	// on this network.
 	// +optional
+	// +listType=atomic
 	SupportedIPFamilies []corev1.IPFamily `json:"supportedIPFamilies,omitempty"`
 }
 
@@ -117,6 +123,9 @@ type NetworkReference struct {
 
 // +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=net,categories=netop
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // Network is the Schema for the networks API.
 // A Network describes type, class and common attributes of a network available
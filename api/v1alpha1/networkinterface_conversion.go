@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this NetworkInterface to the Hub version.
+func (src *NetworkInterface) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.NetworkInterface)
+	return Convert_v1alpha1_NetworkInterface_To_v1alpha2_NetworkInterface(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this NetworkInterface.
+func (dst *NetworkInterface) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.NetworkInterface)
+	return Convert_v1alpha2_NetworkInterface_To_v1alpha1_NetworkInterface(src, dst, nil)
+}
+
+// ConvertTo converts this NetworkInterfaceList to the Hub version.
+func (src *NetworkInterfaceList) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.NetworkInterfaceList)
+	return Convert_v1alpha1_NetworkInterfaceList_To_v1alpha2_NetworkInterfaceList(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this NetworkInterfaceList.
+func (dst *NetworkInterfaceList) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.NetworkInterfaceList)
+	return Convert_v1alpha2_NetworkInterfaceList_To_v1alpha1_NetworkInterfaceList(src, dst, nil)
+}
+
+// Convert_v1alpha1_NetworkInterface_To_v1alpha2_NetworkInterface converts a NetworkInterface to the Hub
+// version, restoring the condition fields recorded when it was converted from
+// the Hub and defaulting the ones the Hub version requires.
+func Convert_v1alpha1_NetworkInterface_To_v1alpha2_NetworkInterface(in *NetworkInterface, out *v1alpha2.NetworkInterface, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha1_NetworkInterface_To_v1alpha2_NetworkInterface(in, out, s); err != nil {
+		return err
+	}
+	return restoreConversionData(out, out.Status.Conditions)
+}
+
+// Convert_v1alpha2_NetworkInterface_To_v1alpha1_NetworkInterface converts the Hub version to a
+// NetworkInterface, recording the condition fields v1alpha1 cannot represent
+// and clearing the ones defaulted when it was converted from v1alpha1.
+func Convert_v1alpha2_NetworkInterface_To_v1alpha1_NetworkInterface(in *v1alpha2.NetworkInterface, out *NetworkInterface, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha2_NetworkInterface_To_v1alpha1_NetworkInterface(in, out, s); err != nil {
+		return err
+	}
+	defaults, err := marshalConversionData(out, in.Status.Conditions, true)
+	if err != nil {
+		return err
+	}
+	for i, d := range defaults {
+		if d.Reason != "" {
+			out.Status.Conditions[i].Reason = ""
+		}
+		if d.LastTransitionTime != nil {
+			out.Status.Conditions[i].LastTransitionTime = metav1.Time{}
+		}
+	}
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterfaceCondition_To_v1_Condition converts a NetworkInterfaceCondition
+// to a metav1.Condition.
+func Convert_v1alpha1_NetworkInterfaceCondition_To_v1_Condition(in *NetworkInterfaceCondition, out *metav1.Condition, _ apiconversion.Scope) error {
+	out.Type = string(in.Type)
+	out.Status = metav1.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = string(in.Reason)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_Condition_To_v1alpha1_NetworkInterfaceCondition converts a metav1.Condition
+// to a NetworkInterfaceCondition. The ObservedGeneration of the condition
+// cannot be represented and is recorded in the ConversionDataAnnotation instead.
+func Convert_v1_Condition_To_v1alpha1_NetworkInterfaceCondition(in *metav1.Condition, out *NetworkInterfaceCondition, _ apiconversion.Scope) error {
+	out.Type = NetworkInterfaceConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = NetworkInterfaceConditionReason(in.Reason)
+	out.Message = in.Message
+	return nil
+}
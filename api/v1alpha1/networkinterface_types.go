@@ -100,8 +100,11 @@ type NetworkInterfaceCondition struct {
 // a VM/Pod/Container's nic on the specified network.
 type NetworkInterfaceStatus struct {
 	// Conditions is an array of current observed network interface conditions.
+	// +listType=map
+	// +listMapKey=type
 	Conditions []NetworkInterfaceCondition `json:"conditions,omitempty"`
 	// IPConfigs is an array of IP configurations for the network interface.
+	// +listType=atomic
 	IPConfigs []IPConfig `json:"ipConfigs,omitempty"`
 	// MacAddress setting for the network interface.
 	MacAddress string `json:"macAddress,omitempty"`
@@ -229,6 +232,13 @@ type NetworkInterfaceReference struct {
 
 // +genclient
 // +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=netif,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Network",type="string",JSONPath=".spec.networkName"
+// +kubebuilder:printcolumn:name="IPs",type="string",JSONPath=".status.ipConfigs[*].ip"
+// +kubebuilder:printcolumn:name="MAC",type="string",JSONPath=".status.macAddress"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // NetworkInterface is the Schema for the networkinterfaces API.
 // A NetworkInterface represents a user's request for network configuration to use to place a
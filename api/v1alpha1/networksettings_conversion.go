@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this NetworkSettings to the Hub version.
+func (src *NetworkSettings) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.NetworkSettings)
+	return Convert_v1alpha1_NetworkSettings_To_v1alpha2_NetworkSettings(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this NetworkSettings.
+func (dst *NetworkSettings) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.NetworkSettings)
+	return Convert_v1alpha2_NetworkSettings_To_v1alpha1_NetworkSettings(src, dst, nil)
+}
+
+// ConvertTo converts this NetworkSettingsList to the Hub version.
+func (src *NetworkSettingsList) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.NetworkSettingsList)
+	return Convert_v1alpha1_NetworkSettingsList_To_v1alpha2_NetworkSettingsList(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this NetworkSettingsList.
+func (dst *NetworkSettingsList) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.NetworkSettingsList)
+	return Convert_v1alpha2_NetworkSettingsList_To_v1alpha1_NetworkSettingsList(src, dst, nil)
+}
@@ -10,8 +10,11 @@ import (
 
 // +genclient
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:scope=Namespaced,shortName=netsettings,categories=netop
 // +kubebuilder:validation:XValidation:rule="!has(self.legacyProvider) || self.legacyProvider != self.provider",message="legacyProvider must differ from provider"
+// +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".provider"
+// +kubebuilder:printcolumn:name="Legacy Provider",type="string",JSONPath=".legacyProvider"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 //
 // NetworkSettings exposes information about the effective network configuration for a namespace.
 // This is observed, realized state, and its contents may be updated by further network configuration
@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// Reasons of the IPPoolFull and IPPoolPressure conditions.
+const (
+	// IPPoolReasonExhausted is used when no IP is free.
+	IPPoolReasonExhausted = v1alpha2.IPPoolReasonExhausted
+	// IPPoolReasonLowOnFreeIPs is used when fewer IPs are free than the
+	// IPPoolPressurePolicy requires.
+	IPPoolReasonLowOnFreeIPs = v1alpha2.IPPoolReasonLowOnFreeIPs
+	// IPPoolReasonSufficientFreeIPs is used when enough IPs are free.
+	IPPoolReasonSufficientFreeIPs = v1alpha2.IPPoolReasonSufficientFreeIPs
+)
+
+// IPPoolPressurePolicy defines when IPPools are low on free IPs. The IPPools
+// are under pressure if either threshold is crossed. A threshold of zero is
+// disabled.
+type IPPoolPressurePolicy struct {
+	// MinFreeIPs is the number of free IPs below which the IPPools are under
+	// pressure.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinFreeIPs int64 `json:"minFreeIPs,omitempty"`
+	// MinFreePercent is the percentage of free IPs below which the IPPools are
+	// under pressure.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MinFreePercent int32 `json:"minFreePercent,omitempty"`
+}
+
+// DefaultIPPoolPressurePolicy is the policy used when none is configured: the
+// IPPools are under pressure when less than 10% of their IPs are free.
+var DefaultIPPoolPressurePolicy = IPPoolPressurePolicy{MinFreePercent: 10}
+
+// IPPoolFullCondition computes the IPPoolFull condition of pool. See
+// v1alpha2.IPPoolFullCondition.
+func IPPoolFullCondition(pool *IPPool) IPPoolCondition {
+	hub := hubIPPools([]IPPool{*pool})
+	c := v1alpha2.IPPoolFullCondition(&hub[0])
+	out := IPPoolCondition{}
+	// The conversion of a condition never fails.
+	_ = Convert_v1_Condition_To_v1alpha1_IPPoolCondition(&c, &out, nil)
+	return out
+}
+
+// VSphereDistributedNetworkIPPoolPressureCondition computes the
+// VsphereDistributedNetworkIPPoolPressure condition from pools, the IPPools
+// referenced by the VSphereDistributedNetwork. See
+// v1alpha2.IPPoolPressureCondition.
+func VSphereDistributedNetworkIPPoolPressureCondition(policy IPPoolPressurePolicy, pools []IPPool) VSphereDistributedNetworkCondition {
+	c := v1alpha2.VSphereDistributedNetworkIPPoolPressureCondition(v1alpha2.IPPoolPressurePolicy(policy), hubIPPools(pools))
+	out := VSphereDistributedNetworkCondition{}
+	// The conversion of a condition never fails.
+	_ = Convert_v1_Condition_To_v1alpha1_VSphereDistributedNetworkCondition(&c, &out, nil)
+	return out
+}
+
+// LoadBalancerConfigIPPoolPressureCondition computes the
+// LoadBalancerConfigIPPoolPressure condition from pools, the IPPools
+// referenced by the LoadBalancerConfig. See v1alpha2.IPPoolPressureCondition.
+func LoadBalancerConfigIPPoolPressureCondition(policy IPPoolPressurePolicy, pools []IPPool) LoadBalancerConfigCondition {
+	c := v1alpha2.LoadBalancerConfigIPPoolPressureCondition(v1alpha2.IPPoolPressurePolicy(policy), hubIPPools(pools))
+	out := LoadBalancerConfigCondition{}
+	// The conversion of a condition never fails.
+	_ = Convert_v1_Condition_To_v1alpha1_LoadBalancerConfigCondition(&c, &out, nil)
+	return out
+}
+
+// hubIPPools converts the spec and status of pools, which is all the pressure
+// computations need.
+func hubIPPools(pools []IPPool) []v1alpha2.IPPool {
+	hub := make([]v1alpha2.IPPool, len(pools))
+	for i := range pools {
+		hub[i].Spec = v1alpha2.IPPoolSpec(pools[i].Spec)
+		hub[i].Status.Allocated = pools[i].Status.Allocated
+	}
+	return hub
+}
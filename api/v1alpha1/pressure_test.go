@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// The computation is tested in v1alpha2. This test only checks that the
+// wrappers convert the IPPools and the conditions and delegate to it.
+func TestIPPoolPressureConditions(t *testing.T) {
+	pools := []v1alpha1.IPPool{
+		{
+			Spec:   v1alpha1.IPPoolSpec{StartingAddress: "10.0.0.0", AddressCount: 16},
+			Status: v1alpha1.IPPoolStatus{Allocated: 15},
+		},
+		{
+			Spec:   v1alpha1.IPPoolSpec{StartingAddress: "fd00::", AddressCount: 16},
+			Status: v1alpha1.IPPoolStatus{Allocated: 16},
+		},
+	}
+
+	full := v1alpha1.IPPoolFullCondition(&pools[0])
+	wantFull := v1alpha1.IPPoolCondition{
+		Type: v1alpha1.IPPoolFull, Status: corev1.ConditionFalse, Reason: v1alpha1.IPPoolReasonSufficientFreeIPs, Message: "1 of 16 IPs are free",
+	}
+	if full != wantFull {
+		t.Errorf("expected %+v, got %+v", wantFull, full)
+	}
+
+	msg := "IPv4: 1 of 16 IPs free; IPv6: 0 of 16 IPs free"
+	vds := v1alpha1.VSphereDistributedNetworkIPPoolPressureCondition(v1alpha1.DefaultIPPoolPressurePolicy, pools)
+	wantVDS := v1alpha1.VSphereDistributedNetworkCondition{
+		Type: v1alpha1.VsphereDistributedNetworkIPPoolPressure, Status: corev1.ConditionTrue, Reason: v1alpha1.IPPoolReasonExhausted, Message: msg,
+	}
+	if vds != wantVDS {
+		t.Errorf("expected %+v, got %+v", wantVDS, vds)
+	}
+	lbc := v1alpha1.LoadBalancerConfigIPPoolPressureCondition(v1alpha1.DefaultIPPoolPressurePolicy, pools)
+	wantLBC := v1alpha1.LoadBalancerConfigCondition{
+		Type: v1alpha1.LoadBalancerConfigIPPoolPressure, Status: corev1.ConditionTrue, Reason: v1alpha1.IPPoolReasonExhausted, Message: msg,
+	}
+	if lbc != wantLBC {
+		t.Errorf("expected %+v, got %+v", wantLBC, lbc)
+	}
+}
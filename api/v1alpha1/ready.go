@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkloadNetworkReadyCondition computes the WorkloadNetworkConditionReady
+// condition from status. See v1alpha2.WorkloadNetworkReadyCondition.
+func WorkloadNetworkReadyCondition(status *WorkloadNetworkConfigurationStatus) metav1.Condition {
+	var hub *v1alpha2.WorkloadNetworkConfigurationStatus
+	if status != nil {
+		hub = &v1alpha2.WorkloadNetworkConfigurationStatus{}
+		// The conversion of the status never fails.
+		_ = Convert_v1alpha1_WorkloadNetworkConfigurationStatus_To_v1alpha2_WorkloadNetworkConfigurationStatus(status, hub, nil)
+	}
+	return v1alpha2.WorkloadNetworkReadyCondition(hub)
+}
+
+// NamespaceNetworkReadyCondition computes the NamespaceNetworkConditionReady
+// condition from status. See v1alpha2.NamespaceNetworkReadyCondition.
+func NamespaceNetworkReadyCondition(status *NamespaceNetworkStatus) metav1.Condition {
+	var hub *v1alpha2.NamespaceNetworkStatus
+	if status != nil {
+		hub = &v1alpha2.NamespaceNetworkStatus{}
+		// The conversion of the status never fails.
+		_ = Convert_v1alpha1_NamespaceNetworkStatus_To_v1alpha2_NamespaceNetworkStatus(status, hub, nil)
+	}
+	return v1alpha2.NamespaceNetworkReadyCondition(hub)
+}
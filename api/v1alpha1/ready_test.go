@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The computation is tested in v1alpha2. These tests only check that the
+// wrappers convert the status and delegate to it.
+
+func TestWorkloadNetworkReadyCondition(t *testing.T) {
+	status := &v1alpha1.WorkloadNetworkConfigurationStatus{Conditions: []metav1.Condition{{
+		Type:    v1alpha1.WorkloadNetworkConditionSystemReady,
+		Status:  metav1.ConditionFalse,
+		Reason:  v1alpha1.WorkloadNetworkReasonFailed,
+		Message: "no such project",
+	}}}
+	got := v1alpha1.WorkloadNetworkReadyCondition(status)
+	want := metav1.Condition{
+		Type:    v1alpha1.WorkloadNetworkConditionReady,
+		Status:  metav1.ConditionFalse,
+		Reason:  v1alpha1.WorkloadNetworkReasonFailed,
+		Message: v1alpha1.WorkloadNetworkConditionSystemReady + ": no such project",
+	}
+	if got != want {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestNamespaceNetworkReadyCondition(t *testing.T) {
+	status := &v1alpha1.NamespaceNetworkStatus{AssociatedNamespaces: []v1alpha1.NamespaceNetworkAssociation{
+		{Name: "ns1", Status: v1alpha1.NamespaceNetworkReconciling, Message: "creating subnets"},
+		{Name: "ns2", Status: v1alpha1.NamespaceNetworkReconciled},
+	}}
+	got := v1alpha1.NamespaceNetworkReadyCondition(status)
+	want := metav1.Condition{
+		Type:    v1alpha1.NamespaceNetworkConditionReady,
+		Status:  metav1.ConditionFalse,
+		Reason:  v1alpha1.NamespaceNetworkReasonPending,
+		Message: "namespace ns1: creating subnets",
+	}
+	if got != want {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
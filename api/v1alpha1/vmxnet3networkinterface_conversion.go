@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this VMXNET3NetworkInterface to the Hub version.
+func (src *VMXNET3NetworkInterface) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.VMXNET3NetworkInterface)
+	return Convert_v1alpha1_VMXNET3NetworkInterface_To_v1alpha2_VMXNET3NetworkInterface(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this VMXNET3NetworkInterface.
+func (dst *VMXNET3NetworkInterface) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.VMXNET3NetworkInterface)
+	return Convert_v1alpha2_VMXNET3NetworkInterface_To_v1alpha1_VMXNET3NetworkInterface(src, dst, nil)
+}
+
+// ConvertTo converts this VMXNET3NetworkInterfaceList to the Hub version.
+func (src *VMXNET3NetworkInterfaceList) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.VMXNET3NetworkInterfaceList)
+	return Convert_v1alpha1_VMXNET3NetworkInterfaceList_To_v1alpha2_VMXNET3NetworkInterfaceList(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this VMXNET3NetworkInterfaceList.
+func (dst *VMXNET3NetworkInterfaceList) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.VMXNET3NetworkInterfaceList)
+	return Convert_v1alpha2_VMXNET3NetworkInterfaceList_To_v1alpha1_VMXNET3NetworkInterfaceList(src, dst, nil)
+}
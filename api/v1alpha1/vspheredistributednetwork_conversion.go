@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiconversion "k8s.io/apimachinery/pkg/conversion"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this VSphereDistributedNetwork to the Hub version.
+func (src *VSphereDistributedNetwork) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.VSphereDistributedNetwork)
+	return Convert_v1alpha1_VSphereDistributedNetwork_To_v1alpha2_VSphereDistributedNetwork(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this VSphereDistributedNetwork.
+func (dst *VSphereDistributedNetwork) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.VSphereDistributedNetwork)
+	return Convert_v1alpha2_VSphereDistributedNetwork_To_v1alpha1_VSphereDistributedNetwork(src, dst, nil)
+}
+
+// ConvertTo converts this VSphereDistributedNetworkList to the Hub version.
+func (src *VSphereDistributedNetworkList) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.VSphereDistributedNetworkList)
+	return Convert_v1alpha1_VSphereDistributedNetworkList_To_v1alpha2_VSphereDistributedNetworkList(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this VSphereDistributedNetworkList.
+func (dst *VSphereDistributedNetworkList) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.VSphereDistributedNetworkList)
+	return Convert_v1alpha2_VSphereDistributedNetworkList_To_v1alpha1_VSphereDistributedNetworkList(src, dst, nil)
+}
+
+// Convert_v1alpha1_VSphereDistributedNetwork_To_v1alpha2_VSphereDistributedNetwork converts a VSphereDistributedNetwork to the Hub
+// version, restoring the condition fields recorded when it was converted from
+// the Hub and defaulting the ones the Hub version requires.
+func Convert_v1alpha1_VSphereDistributedNetwork_To_v1alpha2_VSphereDistributedNetwork(in *VSphereDistributedNetwork, out *v1alpha2.VSphereDistributedNetwork, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha1_VSphereDistributedNetwork_To_v1alpha2_VSphereDistributedNetwork(in, out, s); err != nil {
+		return err
+	}
+	return restoreConversionData(out, out.Status.Conditions)
+}
+
+// Convert_v1alpha2_VSphereDistributedNetwork_To_v1alpha1_VSphereDistributedNetwork converts the Hub version to a
+// VSphereDistributedNetwork, recording the condition fields v1alpha1 cannot represent
+// and clearing the ones defaulted when it was converted from v1alpha1.
+func Convert_v1alpha2_VSphereDistributedNetwork_To_v1alpha1_VSphereDistributedNetwork(in *v1alpha2.VSphereDistributedNetwork, out *VSphereDistributedNetwork, s apiconversion.Scope) error {
+	if err := autoConvert_v1alpha2_VSphereDistributedNetwork_To_v1alpha1_VSphereDistributedNetwork(in, out, s); err != nil {
+		return err
+	}
+	defaults, err := marshalConversionData(out, in.Status.Conditions, true)
+	if err != nil {
+		return err
+	}
+	for i, d := range defaults {
+		if d.Reason != "" {
+			out.Status.Conditions[i].Reason = ""
+		}
+		if d.LastTransitionTime != nil {
+			out.Status.Conditions[i].LastTransitionTime = metav1.Time{}
+		}
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VSphereDistributedNetworkCondition_To_v1_Condition converts a VSphereDistributedNetworkCondition
+// to a metav1.Condition.
+func Convert_v1alpha1_VSphereDistributedNetworkCondition_To_v1_Condition(in *VSphereDistributedNetworkCondition, out *metav1.Condition, _ apiconversion.Scope) error {
+	out.Type = string(in.Type)
+	out.Status = metav1.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_Condition_To_v1alpha1_VSphereDistributedNetworkCondition converts a metav1.Condition
+// to a VSphereDistributedNetworkCondition. The ObservedGeneration of the condition
+// cannot be represented and is recorded in the ConversionDataAnnotation instead.
+func Convert_v1_Condition_To_v1alpha1_VSphereDistributedNetworkCondition(in *metav1.Condition, out *VSphereDistributedNetworkCondition, _ apiconversion.Scope) error {
+	out.Type = VSphereDistributedNetworkConditionType(in.Type)
+	out.Status = corev1.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
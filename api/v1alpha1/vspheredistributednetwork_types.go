@@ -36,7 +36,10 @@ const (
 // VSphereDistributedNetworkIPRange is the static IP range for a VSphereDistributedNetwork.
 type VSphereDistributedNetworkIPRange struct {
 	// address is the starting IPv4 or IPv6 address of the range.
-	// +kubebuilder:validation:XValidation:rule="self.matches('^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)$') || self.matches('^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:))$')",message="Address must be a valid IPv4 or IPv6 address"
+	// The items of the atomic addressRanges list cannot be compared to the stored
+	// object, so an address that the earlier patterns accepted, such as one with
+	// leading zeros, stays valid.
+	// +kubebuilder:validation:XValidation:rule="isIP(self) || self.matches('^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)$') || self.matches('^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:))$')",message="Address must be a valid IPv4 or IPv6 address"
 	// +kubebuilder:validation:MinLength=2
 	// +kubebuilder:validation:MaxLength=45
 	// +required
@@ -69,6 +72,10 @@ type VSphereDistributedNetworkCondition struct {
 // +kubebuilder:validation:XValidation:rule="(has(self.ipAssignmentMode) && (self.ipAssignmentMode == 'dhcp' || self.ipAssignmentMode == 'none')) ? (!has(self.ipPools) || size(self.ipPools) == 0) : true",message="IPPools must be empty when IpAssignmentMode is dhcp or none"
 // +kubebuilder:validation:XValidation:rule="(!has(self.ipAssignmentMode) || self.ipAssignmentMode == 'staticpool') ? (has(self.gateway) && self.gateway != '') : true",message="Gateway is required when IpAssignmentMode is staticpool or unset"
 // +kubebuilder:validation:XValidation:rule="(!has(self.ipAssignmentMode) || self.ipAssignmentMode == 'staticpool') ? (has(self.subnetMask) && self.subnetMask != '') : true",message="SubnetMask is required when IpAssignmentMode is staticpool or unset"
+// +kubebuilder:validation:XValidation:rule="(oldSelf.hasValue() && has(oldSelf.value().ipv6AssignmentMode) == has(self.ipv6AssignmentMode) && (!has(self.ipv6AssignmentMode) || oldSelf.value().ipv6AssignmentMode == self.ipv6AssignmentMode) && has(oldSelf.value().ipv6Gateway) == has(self.ipv6Gateway) && (!has(self.ipv6Gateway) || oldSelf.value().ipv6Gateway == self.ipv6Gateway)) || ((!has(self.ipv6AssignmentMode) || self.ipv6AssignmentMode == 'dhcp' || self.ipv6AssignmentMode == 'none') ? (!has(self.ipv6Gateway) || self.ipv6Gateway == '') : true)",message="IPv6Gateway must be empty when IPv6AssignmentMode is dhcp, none or unset",optionalOldSelf=true
+// +kubebuilder:validation:XValidation:rule="(oldSelf.hasValue() && has(oldSelf.value().ipv6AssignmentMode) == has(self.ipv6AssignmentMode) && (!has(self.ipv6AssignmentMode) || oldSelf.value().ipv6AssignmentMode == self.ipv6AssignmentMode) && has(oldSelf.value().ipv6Prefix) == has(self.ipv6Prefix) && (!has(self.ipv6Prefix) || oldSelf.value().ipv6Prefix == self.ipv6Prefix)) || ((!has(self.ipv6AssignmentMode) || self.ipv6AssignmentMode == 'dhcp' || self.ipv6AssignmentMode == 'none') ? !has(self.ipv6Prefix) : true)",message="IPv6Prefix must be unset when IPv6AssignmentMode is dhcp, none or unset",optionalOldSelf=true
+// +kubebuilder:validation:XValidation:rule="(oldSelf.hasValue() && has(oldSelf.value().ipv6AssignmentMode) == has(self.ipv6AssignmentMode) && (!has(self.ipv6AssignmentMode) || oldSelf.value().ipv6AssignmentMode == self.ipv6AssignmentMode) && has(oldSelf.value().ipv6Gateway) == has(self.ipv6Gateway) && (!has(self.ipv6Gateway) || oldSelf.value().ipv6Gateway == self.ipv6Gateway)) || ((has(self.ipv6AssignmentMode) && self.ipv6AssignmentMode == 'staticpool') ? (has(self.ipv6Gateway) && self.ipv6Gateway != '') : true)",message="IPv6Gateway is required when IPv6AssignmentMode is staticpool",optionalOldSelf=true
+// +kubebuilder:validation:XValidation:rule="(oldSelf.hasValue() && has(oldSelf.value().ipv6AssignmentMode) == has(self.ipv6AssignmentMode) && (!has(self.ipv6AssignmentMode) || oldSelf.value().ipv6AssignmentMode == self.ipv6AssignmentMode) && has(oldSelf.value().ipv6Prefix) == has(self.ipv6Prefix) && (!has(self.ipv6Prefix) || oldSelf.value().ipv6Prefix == self.ipv6Prefix)) || ((has(self.ipv6AssignmentMode) && self.ipv6AssignmentMode == 'staticpool') ? has(self.ipv6Prefix) : true)",message="IPv6Prefix is required when IPv6AssignmentMode is staticpool",optionalOldSelf=true
 // VSphereDistributedNetworkSpec defines the desired state of VSphereDistributedNetwork.
 type VSphereDistributedNetworkSpec struct {
 	// PortGroupID is an existing vSphere Distributed PortGroup identifier.
@@ -89,6 +96,9 @@ type VSphereDistributedNetworkSpec struct {
 	// deployments. To enable IPv6 support, explicitly set this field to IPAssignmentModeStaticPool
 	// or IPAssignmentModeDHCP. This allows different assignment modes for IPv4 and IPv6, for
 	// example static IPv4 pool assignment combined with DHCPv6 for IPv6.
+	// When unset, IPAssignmentModeDHCP or IPAssignmentModeNone, IPv6Gateway and IPv6Prefix must be unset.
+	// For IPAssignmentModeStaticPool, they are required.
+	// +kubebuilder:validation:XValidation:rule="self in ['dhcp', 'staticpool', 'none'] || (oldSelf.hasValue() && oldSelf.value() == self)",message="IPv6AssignmentMode must be dhcp, staticpool or none",optionalOldSelf=true
 	// +optional
 	IPv6AssignmentMode IPAssignmentModeType `json:"ipv6AssignmentMode,omitempty"`
 
@@ -100,13 +110,16 @@ type VSphereDistributedNetworkSpec struct {
 	// where needed, and every retained reference (including ones that already matched a range) is reconciled.
 	// +kubebuilder:default:={}
 	// +optional
+	// +listType=map
+	// +listMapKey=name
 	IPPools []IPPoolReference `json:"ipPools"`
 
 	// Gateway setting to use for network interfaces. This field should only be set when using
 	// IPAssignmentModeStaticPool. For all other modes (IPAssignmentModeDHCP, IPAssignmentModeNone), this should be set
 	// to an empty string.
-	// Note: The regex pattern performs IPv4 validation but also allows an empty string for backward compatibility.
-	// +kubebuilder:validation:Pattern="^(|((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?))$"
+	// Note: The value must be a valid IPv4 address, but an empty string is allowed for backward compatibility.
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:XValidation:rule="self == '' || (isIP(self) && ip(self).family() == 4) || (oldSelf.hasValue() && oldSelf.value() == self)",message="Gateway must be empty or a valid IPv4 address",optionalOldSelf=true
 	// +kubebuilder:default:=""
 	// +optional
 	Gateway string `json:"gateway"`
@@ -114,8 +127,9 @@ type VSphereDistributedNetworkSpec struct {
 	// SubnetMask setting to use for network interfaces. This field should only be set when using
 	// IPAssignmentModeStaticPool. For all other modes (IPAssignmentModeDHCP, IPAssignmentModeNone), this should be set
 	// to an empty string.
-	// Note: The regex pattern performs IPv4 validation but also allows an empty string for backward compatibility.
-	// +kubebuilder:validation:Pattern="^(|((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?))$"
+	// Note: The value must be a valid IPv4 address, but an empty string is allowed for backward compatibility.
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:XValidation:rule="self == '' || (isIP(self) && ip(self).family() == 4) || (oldSelf.hasValue() && oldSelf.value() == self)",message="SubnetMask must be empty or a valid IPv4 address",optionalOldSelf=true
 	// +kubebuilder:default:=""
 	// +optional
 	SubnetMask string `json:"subnetMask"`
@@ -123,6 +137,8 @@ type VSphereDistributedNetworkSpec struct {
 	// IPv6Gateway setting to use for IPv6 addresses on network interfaces. This field should only
 	// be set when using IPv6AssignmentMode IPAssignmentModeStaticPool. For all other modes
 	// (IPAssignmentModeDHCP, IPAssignmentModeNone), this should be empty/unset.
+	// +kubebuilder:validation:MaxLength=45
+	// +kubebuilder:validation:XValidation:rule="self == '' || (isIP(self) && ip(self).family() == 6) || (oldSelf.hasValue() && oldSelf.value() == self)",message="IPv6Gateway must be a valid IPv6 address",optionalOldSelf=true
 	// +optional
 	IPv6Gateway string `json:"ipv6Gateway,omitempty"`
 
@@ -191,6 +207,7 @@ type VlanSpec struct {
 	// Each range's Start and End values must be between 0 and 4094 inclusive.
 	// Overlapping ranges are allowed.
 	// +optional
+	// +listType=atomic
 	TrunkRange []VLANTrunkRange `json:"trunkRange,omitempty"`
 
 	// PrivateVlanID specifies the private VLAN ID when Type is VLANTypePrivate.
@@ -265,6 +282,8 @@ type VSphereDistributedPortConfig struct {
 // VSphereDistributedNetworkStatus defines the observed state of VSphereDistributedNetwork.
 type VSphereDistributedNetworkStatus struct {
 	// Conditions is an array of current observed vSphere Distributed network conditions.
+	// +listType=map
+	// +listMapKey=type
 	Conditions []VSphereDistributedNetworkCondition `json:"conditions,omitempty"`
 
 	// DefaultPortConfig represents the default port-level configuration that applies to all ports
@@ -277,8 +296,15 @@ type VSphereDistributedNetworkStatus struct {
 // +genclient
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:resource:scope=Cluster,shortName=vdsnet,categories=netop
 // +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 253 && self.metadata.name.matches('^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$')",message="metadata.name must be a lowercase RFC 1123 DNS subdomain (alphanumeric or '-' or '.', each segment starting/ending with alphanumeric; max 253 characters)"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="PortGroup",type="string",JSONPath=".spec.portGroupID"
+// +kubebuilder:printcolumn:name="IPv4 Mode",type="string",JSONPath=".spec.ipAssignmentMode"
+// +kubebuilder:printcolumn:name="IPv6 Mode",type="string",JSONPath=".spec.ipv6AssignmentMode"
+// +kubebuilder:printcolumn:name="Gateway",type="string",JSONPath=".spec.gateway",priority=1
+// +kubebuilder:printcolumn:name="IPv6 Gateway",type="string",JSONPath=".spec.ipv6Gateway",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // VSphereDistributedNetwork represents schema for a network backed by a vSphere Distributed PortGroup on vSphere
 // Distributed switch.
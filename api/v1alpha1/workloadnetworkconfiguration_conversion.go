@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha1
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this WorkloadNetworkConfiguration to the Hub version.
+func (src *WorkloadNetworkConfiguration) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.WorkloadNetworkConfiguration)
+	return Convert_v1alpha1_WorkloadNetworkConfiguration_To_v1alpha2_WorkloadNetworkConfiguration(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this WorkloadNetworkConfiguration.
+func (dst *WorkloadNetworkConfiguration) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.WorkloadNetworkConfiguration)
+	return Convert_v1alpha2_WorkloadNetworkConfiguration_To_v1alpha1_WorkloadNetworkConfiguration(src, dst, nil)
+}
+
+// ConvertTo converts this WorkloadNetworkConfigurationList to the Hub version.
+func (src *WorkloadNetworkConfigurationList) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*v1alpha2.WorkloadNetworkConfigurationList)
+	return Convert_v1alpha1_WorkloadNetworkConfigurationList_To_v1alpha2_WorkloadNetworkConfigurationList(src, dst, nil)
+}
+
+// ConvertFrom converts the Hub version to this WorkloadNetworkConfigurationList.
+func (dst *WorkloadNetworkConfigurationList) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*v1alpha2.WorkloadNetworkConfigurationList)
+	return Convert_v1alpha2_WorkloadNetworkConfigurationList_To_v1alpha1_WorkloadNetworkConfigurationList(src, dst, nil)
+}
@@ -29,6 +29,10 @@ const (
 	// WorkloadNetworkReasonFailed is set on a condition with status False when
 	// the controller encountered an error during reconciliation.
 	WorkloadNetworkReasonFailed = "Failed"
+
+	// WorkloadNetworkReasonReconciled is set on the Ready condition when it is
+	// True.
+	WorkloadNetworkReasonReconciled = "Reconciled"
 )
 
 // +kubebuilder:validation:XValidation:rule="self.type == 'vsphere-distributed' || self.type == 'vpc' || self.type == 'nsx-tier1'",message="type must be one of: vsphere-distributed, vpc, nsx-tier1"
@@ -90,9 +94,12 @@ type WorkloadNetworkConfigurationStatus struct {
 // +genclient
 // +genclient:nonNamespaced
 // +kubebuilder:object:root=true
-// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:resource:scope=Cluster,shortName=wnc,categories=netop
 // +kubebuilder:subresource:status
 // +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default'",message="WorkloadNetworkConfiguration must be named 'default'"
+// +kubebuilder:printcolumn:name="Active Provider",type="string",JSONPath=".spec.activeSystemProvider"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // WorkloadNetworkConfiguration is a singleton cluster-scoped resource that describes the
 // network providers available in this Supervisor and which provider is currently active for
@@ -0,0 +1,3192 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*ActivePassiveAvailabilityMode)(nil), (*v1alpha2.ActivePassiveAvailabilityMode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ActivePassiveAvailabilityMode_To_v1alpha2_ActivePassiveAvailabilityMode(a.(*ActivePassiveAvailabilityMode), b.(*v1alpha2.ActivePassiveAvailabilityMode), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.ActivePassiveAvailabilityMode)(nil), (*ActivePassiveAvailabilityMode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ActivePassiveAvailabilityMode_To_v1alpha1_ActivePassiveAvailabilityMode(a.(*v1alpha2.ActivePassiveAvailabilityMode), b.(*ActivePassiveAvailabilityMode), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AutoCreateVPCConfig)(nil), (*v1alpha2.AutoCreateVPCConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AutoCreateVPCConfig_To_v1alpha2_AutoCreateVPCConfig(a.(*AutoCreateVPCConfig), b.(*v1alpha2.AutoCreateVPCConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.AutoCreateVPCConfig)(nil), (*AutoCreateVPCConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AutoCreateVPCConfig_To_v1alpha1_AutoCreateVPCConfig(a.(*v1alpha2.AutoCreateVPCConfig), b.(*AutoCreateVPCConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AviLoadBalancerConfig)(nil), (*v1alpha2.AviLoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AviLoadBalancerConfig_To_v1alpha2_AviLoadBalancerConfig(a.(*AviLoadBalancerConfig), b.(*v1alpha2.AviLoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.AviLoadBalancerConfig)(nil), (*AviLoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AviLoadBalancerConfig_To_v1alpha1_AviLoadBalancerConfig(a.(*v1alpha2.AviLoadBalancerConfig), b.(*AviLoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AviLoadBalancerConfigList)(nil), (*v1alpha2.AviLoadBalancerConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AviLoadBalancerConfigList_To_v1alpha2_AviLoadBalancerConfigList(a.(*AviLoadBalancerConfigList), b.(*v1alpha2.AviLoadBalancerConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.AviLoadBalancerConfigList)(nil), (*AviLoadBalancerConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AviLoadBalancerConfigList_To_v1alpha1_AviLoadBalancerConfigList(a.(*v1alpha2.AviLoadBalancerConfigList), b.(*AviLoadBalancerConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AviLoadBalancerConfigSpec)(nil), (*v1alpha2.AviLoadBalancerConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AviLoadBalancerConfigSpec_To_v1alpha2_AviLoadBalancerConfigSpec(a.(*AviLoadBalancerConfigSpec), b.(*v1alpha2.AviLoadBalancerConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.AviLoadBalancerConfigSpec)(nil), (*AviLoadBalancerConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AviLoadBalancerConfigSpec_To_v1alpha1_AviLoadBalancerConfigSpec(a.(*v1alpha2.AviLoadBalancerConfigSpec), b.(*AviLoadBalancerConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AviLoadBalancerConfigStatus)(nil), (*v1alpha2.AviLoadBalancerConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AviLoadBalancerConfigStatus_To_v1alpha2_AviLoadBalancerConfigStatus(a.(*AviLoadBalancerConfigStatus), b.(*v1alpha2.AviLoadBalancerConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.AviLoadBalancerConfigStatus)(nil), (*AviLoadBalancerConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_AviLoadBalancerConfigStatus_To_v1alpha1_AviLoadBalancerConfigStatus(a.(*v1alpha2.AviLoadBalancerConfigStatus), b.(*AviLoadBalancerConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClientSecretReference)(nil), (*v1alpha2.ClientSecretReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClientSecretReference_To_v1alpha2_ClientSecretReference(a.(*ClientSecretReference), b.(*v1alpha2.ClientSecretReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.ClientSecretReference)(nil), (*ClientSecretReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ClientSecretReference_To_v1alpha1_ClientSecretReference(a.(*v1alpha2.ClientSecretReference), b.(*ClientSecretReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FoundationLoadBalancerConfig)(nil), (*v1alpha2.FoundationLoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FoundationLoadBalancerConfig_To_v1alpha2_FoundationLoadBalancerConfig(a.(*FoundationLoadBalancerConfig), b.(*v1alpha2.FoundationLoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.FoundationLoadBalancerConfig)(nil), (*FoundationLoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_FoundationLoadBalancerConfig_To_v1alpha1_FoundationLoadBalancerConfig(a.(*v1alpha2.FoundationLoadBalancerConfig), b.(*FoundationLoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FoundationLoadBalancerConfigList)(nil), (*v1alpha2.FoundationLoadBalancerConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FoundationLoadBalancerConfigList_To_v1alpha2_FoundationLoadBalancerConfigList(a.(*FoundationLoadBalancerConfigList), b.(*v1alpha2.FoundationLoadBalancerConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.FoundationLoadBalancerConfigList)(nil), (*FoundationLoadBalancerConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_FoundationLoadBalancerConfigList_To_v1alpha1_FoundationLoadBalancerConfigList(a.(*v1alpha2.FoundationLoadBalancerConfigList), b.(*FoundationLoadBalancerConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FoundationLoadBalancerConfigSpec)(nil), (*v1alpha2.FoundationLoadBalancerConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FoundationLoadBalancerConfigSpec_To_v1alpha2_FoundationLoadBalancerConfigSpec(a.(*FoundationLoadBalancerConfigSpec), b.(*v1alpha2.FoundationLoadBalancerConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.FoundationLoadBalancerConfigSpec)(nil), (*FoundationLoadBalancerConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_FoundationLoadBalancerConfigSpec_To_v1alpha1_FoundationLoadBalancerConfigSpec(a.(*v1alpha2.FoundationLoadBalancerConfigSpec), b.(*FoundationLoadBalancerConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FoundationLoadBalancerConfigStatus)(nil), (*v1alpha2.FoundationLoadBalancerConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FoundationLoadBalancerConfigStatus_To_v1alpha2_FoundationLoadBalancerConfigStatus(a.(*FoundationLoadBalancerConfigStatus), b.(*v1alpha2.FoundationLoadBalancerConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.FoundationLoadBalancerConfigStatus)(nil), (*FoundationLoadBalancerConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_FoundationLoadBalancerConfigStatus_To_v1alpha1_FoundationLoadBalancerConfigStatus(a.(*v1alpha2.FoundationLoadBalancerConfigStatus), b.(*FoundationLoadBalancerConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FoundationLoadBalancerDeploymentSpec)(nil), (*v1alpha2.FoundationLoadBalancerDeploymentSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FoundationLoadBalancerDeploymentSpec_To_v1alpha2_FoundationLoadBalancerDeploymentSpec(a.(*FoundationLoadBalancerDeploymentSpec), b.(*v1alpha2.FoundationLoadBalancerDeploymentSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.FoundationLoadBalancerDeploymentSpec)(nil), (*FoundationLoadBalancerDeploymentSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_FoundationLoadBalancerDeploymentSpec_To_v1alpha1_FoundationLoadBalancerDeploymentSpec(a.(*v1alpha2.FoundationLoadBalancerDeploymentSpec), b.(*FoundationLoadBalancerDeploymentSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FoundationLoadBalancerNetworkConfigSpec)(nil), (*v1alpha2.FoundationLoadBalancerNetworkConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FoundationLoadBalancerNetworkConfigSpec_To_v1alpha2_FoundationLoadBalancerNetworkConfigSpec(a.(*FoundationLoadBalancerNetworkConfigSpec), b.(*v1alpha2.FoundationLoadBalancerNetworkConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.FoundationLoadBalancerNetworkConfigSpec)(nil), (*FoundationLoadBalancerNetworkConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_FoundationLoadBalancerNetworkConfigSpec_To_v1alpha1_FoundationLoadBalancerNetworkConfigSpec(a.(*v1alpha2.FoundationLoadBalancerNetworkConfigSpec), b.(*FoundationLoadBalancerNetworkConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FoundationLoadBalancerNodeStatus)(nil), (*v1alpha2.FoundationLoadBalancerNodeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FoundationLoadBalancerNodeStatus_To_v1alpha2_FoundationLoadBalancerNodeStatus(a.(*FoundationLoadBalancerNodeStatus), b.(*v1alpha2.FoundationLoadBalancerNodeStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.FoundationLoadBalancerNodeStatus)(nil), (*FoundationLoadBalancerNodeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_FoundationLoadBalancerNodeStatus_To_v1alpha1_FoundationLoadBalancerNodeStatus(a.(*v1alpha2.FoundationLoadBalancerNodeStatus), b.(*FoundationLoadBalancerNodeStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HAProxyLoadBalancerConfig)(nil), (*v1alpha2.HAProxyLoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HAProxyLoadBalancerConfig_To_v1alpha2_HAProxyLoadBalancerConfig(a.(*HAProxyLoadBalancerConfig), b.(*v1alpha2.HAProxyLoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.HAProxyLoadBalancerConfig)(nil), (*HAProxyLoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_HAProxyLoadBalancerConfig_To_v1alpha1_HAProxyLoadBalancerConfig(a.(*v1alpha2.HAProxyLoadBalancerConfig), b.(*HAProxyLoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HAProxyLoadBalancerConfigList)(nil), (*v1alpha2.HAProxyLoadBalancerConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HAProxyLoadBalancerConfigList_To_v1alpha2_HAProxyLoadBalancerConfigList(a.(*HAProxyLoadBalancerConfigList), b.(*v1alpha2.HAProxyLoadBalancerConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.HAProxyLoadBalancerConfigList)(nil), (*HAProxyLoadBalancerConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_HAProxyLoadBalancerConfigList_To_v1alpha1_HAProxyLoadBalancerConfigList(a.(*v1alpha2.HAProxyLoadBalancerConfigList), b.(*HAProxyLoadBalancerConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HAProxyLoadBalancerConfigSpec)(nil), (*v1alpha2.HAProxyLoadBalancerConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HAProxyLoadBalancerConfigSpec_To_v1alpha2_HAProxyLoadBalancerConfigSpec(a.(*HAProxyLoadBalancerConfigSpec), b.(*v1alpha2.HAProxyLoadBalancerConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.HAProxyLoadBalancerConfigSpec)(nil), (*HAProxyLoadBalancerConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_HAProxyLoadBalancerConfigSpec_To_v1alpha1_HAProxyLoadBalancerConfigSpec(a.(*v1alpha2.HAProxyLoadBalancerConfigSpec), b.(*HAProxyLoadBalancerConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HAProxyLoadBalancerConfigStatus)(nil), (*v1alpha2.HAProxyLoadBalancerConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HAProxyLoadBalancerConfigStatus_To_v1alpha2_HAProxyLoadBalancerConfigStatus(a.(*HAProxyLoadBalancerConfigStatus), b.(*v1alpha2.HAProxyLoadBalancerConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.HAProxyLoadBalancerConfigStatus)(nil), (*HAProxyLoadBalancerConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_HAProxyLoadBalancerConfigStatus_To_v1alpha1_HAProxyLoadBalancerConfigStatus(a.(*v1alpha2.HAProxyLoadBalancerConfigStatus), b.(*HAProxyLoadBalancerConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPAddressAllocationList)(nil), (*v1alpha2.IPAddressAllocationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPAddressAllocationList_To_v1alpha2_IPAddressAllocationList(a.(*IPAddressAllocationList), b.(*v1alpha2.IPAddressAllocationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.IPAddressAllocationList)(nil), (*IPAddressAllocationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPAddressAllocationList_To_v1alpha1_IPAddressAllocationList(a.(*v1alpha2.IPAddressAllocationList), b.(*IPAddressAllocationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPAddressAllocationSpec)(nil), (*v1alpha2.IPAddressAllocationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPAddressAllocationSpec_To_v1alpha2_IPAddressAllocationSpec(a.(*IPAddressAllocationSpec), b.(*v1alpha2.IPAddressAllocationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.IPAddressAllocationSpec)(nil), (*IPAddressAllocationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPAddressAllocationSpec_To_v1alpha1_IPAddressAllocationSpec(a.(*v1alpha2.IPAddressAllocationSpec), b.(*IPAddressAllocationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPAddressAllocationStatus)(nil), (*v1alpha2.IPAddressAllocationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPAddressAllocationStatus_To_v1alpha2_IPAddressAllocationStatus(a.(*IPAddressAllocationStatus), b.(*v1alpha2.IPAddressAllocationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.IPAddressAllocationStatus)(nil), (*IPAddressAllocationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPAddressAllocationStatus_To_v1alpha1_IPAddressAllocationStatus(a.(*v1alpha2.IPAddressAllocationStatus), b.(*IPAddressAllocationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPConfig)(nil), (*v1alpha2.IPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPConfig_To_v1alpha2_IPConfig(a.(*IPConfig), b.(*v1alpha2.IPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.IPConfig)(nil), (*IPConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPConfig_To_v1alpha1_IPConfig(a.(*v1alpha2.IPConfig), b.(*IPConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPPoolList)(nil), (*v1alpha2.IPPoolList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPPoolList_To_v1alpha2_IPPoolList(a.(*IPPoolList), b.(*v1alpha2.IPPoolList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.IPPoolList)(nil), (*IPPoolList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPPoolList_To_v1alpha1_IPPoolList(a.(*v1alpha2.IPPoolList), b.(*IPPoolList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPPoolPressurePolicy)(nil), (*v1alpha2.IPPoolPressurePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPPoolPressurePolicy_To_v1alpha2_IPPoolPressurePolicy(a.(*IPPoolPressurePolicy), b.(*v1alpha2.IPPoolPressurePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.IPPoolPressurePolicy)(nil), (*IPPoolPressurePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPPoolPressurePolicy_To_v1alpha1_IPPoolPressurePolicy(a.(*v1alpha2.IPPoolPressurePolicy), b.(*IPPoolPressurePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPPoolReference)(nil), (*v1alpha2.IPPoolReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPPoolReference_To_v1alpha2_IPPoolReference(a.(*IPPoolReference), b.(*v1alpha2.IPPoolReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.IPPoolReference)(nil), (*IPPoolReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPPoolReference_To_v1alpha1_IPPoolReference(a.(*v1alpha2.IPPoolReference), b.(*IPPoolReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPPoolSpec)(nil), (*v1alpha2.IPPoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPPoolSpec_To_v1alpha2_IPPoolSpec(a.(*IPPoolSpec), b.(*v1alpha2.IPPoolSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.IPPoolSpec)(nil), (*IPPoolSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPPoolSpec_To_v1alpha1_IPPoolSpec(a.(*v1alpha2.IPPoolSpec), b.(*IPPoolSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPPoolStatus)(nil), (*v1alpha2.IPPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPPoolStatus_To_v1alpha2_IPPoolStatus(a.(*IPPoolStatus), b.(*v1alpha2.IPPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.IPPoolStatus)(nil), (*IPPoolStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPPoolStatus_To_v1alpha1_IPPoolStatus(a.(*v1alpha2.IPPoolStatus), b.(*IPPoolStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPRange)(nil), (*v1alpha2.IPRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPRange_To_v1alpha2_IPRange(a.(*IPRange), b.(*v1alpha2.IPRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.IPRange)(nil), (*IPRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPRange_To_v1alpha1_IPRange(a.(*v1alpha2.IPRange), b.(*IPRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerConfigList)(nil), (*v1alpha2.LoadBalancerConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerConfigList_To_v1alpha2_LoadBalancerConfigList(a.(*LoadBalancerConfigList), b.(*v1alpha2.LoadBalancerConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.LoadBalancerConfigList)(nil), (*LoadBalancerConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_LoadBalancerConfigList_To_v1alpha1_LoadBalancerConfigList(a.(*v1alpha2.LoadBalancerConfigList), b.(*LoadBalancerConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerConfigProviderReference)(nil), (*v1alpha2.LoadBalancerConfigProviderReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerConfigProviderReference_To_v1alpha2_LoadBalancerConfigProviderReference(a.(*LoadBalancerConfigProviderReference), b.(*v1alpha2.LoadBalancerConfigProviderReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.LoadBalancerConfigProviderReference)(nil), (*LoadBalancerConfigProviderReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_LoadBalancerConfigProviderReference_To_v1alpha1_LoadBalancerConfigProviderReference(a.(*v1alpha2.LoadBalancerConfigProviderReference), b.(*LoadBalancerConfigProviderReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerConfigSpec)(nil), (*v1alpha2.LoadBalancerConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerConfigSpec_To_v1alpha2_LoadBalancerConfigSpec(a.(*LoadBalancerConfigSpec), b.(*v1alpha2.LoadBalancerConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.LoadBalancerConfigSpec)(nil), (*LoadBalancerConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_LoadBalancerConfigSpec_To_v1alpha1_LoadBalancerConfigSpec(a.(*v1alpha2.LoadBalancerConfigSpec), b.(*LoadBalancerConfigSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerConfigStatus)(nil), (*v1alpha2.LoadBalancerConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerConfigStatus_To_v1alpha2_LoadBalancerConfigStatus(a.(*LoadBalancerConfigStatus), b.(*v1alpha2.LoadBalancerConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.LoadBalancerConfigStatus)(nil), (*LoadBalancerConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_LoadBalancerConfigStatus_To_v1alpha1_LoadBalancerConfigStatus(a.(*v1alpha2.LoadBalancerConfigStatus), b.(*LoadBalancerConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MacLearningPolicy)(nil), (*v1alpha2.MacLearningPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MacLearningPolicy_To_v1alpha2_MacLearningPolicy(a.(*MacLearningPolicy), b.(*v1alpha2.MacLearningPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.MacLearningPolicy)(nil), (*MacLearningPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_MacLearningPolicy_To_v1alpha1_MacLearningPolicy(a.(*v1alpha2.MacLearningPolicy), b.(*MacLearningPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MacManagementPolicy)(nil), (*v1alpha2.MacManagementPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MacManagementPolicy_To_v1alpha2_MacManagementPolicy(a.(*MacManagementPolicy), b.(*v1alpha2.MacManagementPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.MacManagementPolicy)(nil), (*MacManagementPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_MacManagementPolicy_To_v1alpha1_MacManagementPolicy(a.(*v1alpha2.MacManagementPolicy), b.(*MacManagementPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NSXTier1Config)(nil), (*v1alpha2.NSXTier1Config)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NSXTier1Config_To_v1alpha2_NSXTier1Config(a.(*NSXTier1Config), b.(*v1alpha2.NSXTier1Config), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NSXTier1Config)(nil), (*NSXTier1Config)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NSXTier1Config_To_v1alpha1_NSXTier1Config(a.(*v1alpha2.NSXTier1Config), b.(*NSXTier1Config), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceNetworkAssociation)(nil), (*v1alpha2.NamespaceNetworkAssociation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamespaceNetworkAssociation_To_v1alpha2_NamespaceNetworkAssociation(a.(*NamespaceNetworkAssociation), b.(*v1alpha2.NamespaceNetworkAssociation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NamespaceNetworkAssociation)(nil), (*NamespaceNetworkAssociation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NamespaceNetworkAssociation_To_v1alpha1_NamespaceNetworkAssociation(a.(*v1alpha2.NamespaceNetworkAssociation), b.(*NamespaceNetworkAssociation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceNetworkConfig)(nil), (*v1alpha2.NamespaceNetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamespaceNetworkConfig_To_v1alpha2_NamespaceNetworkConfig(a.(*NamespaceNetworkConfig), b.(*v1alpha2.NamespaceNetworkConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NamespaceNetworkConfig)(nil), (*NamespaceNetworkConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NamespaceNetworkConfig_To_v1alpha1_NamespaceNetworkConfig(a.(*v1alpha2.NamespaceNetworkConfig), b.(*NamespaceNetworkConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceNetworkConfiguration)(nil), (*v1alpha2.NamespaceNetworkConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamespaceNetworkConfiguration_To_v1alpha2_NamespaceNetworkConfiguration(a.(*NamespaceNetworkConfiguration), b.(*v1alpha2.NamespaceNetworkConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NamespaceNetworkConfiguration)(nil), (*NamespaceNetworkConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NamespaceNetworkConfiguration_To_v1alpha1_NamespaceNetworkConfiguration(a.(*v1alpha2.NamespaceNetworkConfiguration), b.(*NamespaceNetworkConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceNetworkConfigurationList)(nil), (*v1alpha2.NamespaceNetworkConfigurationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamespaceNetworkConfigurationList_To_v1alpha2_NamespaceNetworkConfigurationList(a.(*NamespaceNetworkConfigurationList), b.(*v1alpha2.NamespaceNetworkConfigurationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NamespaceNetworkConfigurationList)(nil), (*NamespaceNetworkConfigurationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NamespaceNetworkConfigurationList_To_v1alpha1_NamespaceNetworkConfigurationList(a.(*v1alpha2.NamespaceNetworkConfigurationList), b.(*NamespaceNetworkConfigurationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceNetworkSpec)(nil), (*v1alpha2.NamespaceNetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamespaceNetworkSpec_To_v1alpha2_NamespaceNetworkSpec(a.(*NamespaceNetworkSpec), b.(*v1alpha2.NamespaceNetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NamespaceNetworkSpec)(nil), (*NamespaceNetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NamespaceNetworkSpec_To_v1alpha1_NamespaceNetworkSpec(a.(*v1alpha2.NamespaceNetworkSpec), b.(*NamespaceNetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceNetworkStatus)(nil), (*v1alpha2.NamespaceNetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamespaceNetworkStatus_To_v1alpha2_NamespaceNetworkStatus(a.(*NamespaceNetworkStatus), b.(*v1alpha2.NamespaceNetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NamespaceNetworkStatus)(nil), (*NamespaceNetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NamespaceNetworkStatus_To_v1alpha1_NamespaceNetworkStatus(a.(*v1alpha2.NamespaceNetworkStatus), b.(*NamespaceNetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceList)(nil), (*v1alpha2.NetworkInterfaceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterfaceList_To_v1alpha2_NetworkInterfaceList(a.(*NetworkInterfaceList), b.(*v1alpha2.NetworkInterfaceList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NetworkInterfaceList)(nil), (*NetworkInterfaceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkInterfaceList_To_v1alpha1_NetworkInterfaceList(a.(*v1alpha2.NetworkInterfaceList), b.(*NetworkInterfaceList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfacePortAllocation)(nil), (*v1alpha2.NetworkInterfacePortAllocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterfacePortAllocation_To_v1alpha2_NetworkInterfacePortAllocation(a.(*NetworkInterfacePortAllocation), b.(*v1alpha2.NetworkInterfacePortAllocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NetworkInterfacePortAllocation)(nil), (*NetworkInterfacePortAllocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkInterfacePortAllocation_To_v1alpha1_NetworkInterfacePortAllocation(a.(*v1alpha2.NetworkInterfacePortAllocation), b.(*NetworkInterfacePortAllocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceProviderReference)(nil), (*v1alpha2.NetworkInterfaceProviderReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterfaceProviderReference_To_v1alpha2_NetworkInterfaceProviderReference(a.(*NetworkInterfaceProviderReference), b.(*v1alpha2.NetworkInterfaceProviderReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NetworkInterfaceProviderReference)(nil), (*NetworkInterfaceProviderReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkInterfaceProviderReference_To_v1alpha1_NetworkInterfaceProviderReference(a.(*v1alpha2.NetworkInterfaceProviderReference), b.(*NetworkInterfaceProviderReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceReference)(nil), (*v1alpha2.NetworkInterfaceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterfaceReference_To_v1alpha2_NetworkInterfaceReference(a.(*NetworkInterfaceReference), b.(*v1alpha2.NetworkInterfaceReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NetworkInterfaceReference)(nil), (*NetworkInterfaceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkInterfaceReference_To_v1alpha1_NetworkInterfaceReference(a.(*v1alpha2.NetworkInterfaceReference), b.(*NetworkInterfaceReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceSpec)(nil), (*v1alpha2.NetworkInterfaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterfaceSpec_To_v1alpha2_NetworkInterfaceSpec(a.(*NetworkInterfaceSpec), b.(*v1alpha2.NetworkInterfaceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NetworkInterfaceSpec)(nil), (*NetworkInterfaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkInterfaceSpec_To_v1alpha1_NetworkInterfaceSpec(a.(*v1alpha2.NetworkInterfaceSpec), b.(*NetworkInterfaceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkInterfaceStatus)(nil), (*v1alpha2.NetworkInterfaceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterfaceStatus_To_v1alpha2_NetworkInterfaceStatus(a.(*NetworkInterfaceStatus), b.(*v1alpha2.NetworkInterfaceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NetworkInterfaceStatus)(nil), (*NetworkInterfaceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStatus(a.(*v1alpha2.NetworkInterfaceStatus), b.(*NetworkInterfaceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkList)(nil), (*v1alpha2.NetworkList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkList_To_v1alpha2_NetworkList(a.(*NetworkList), b.(*v1alpha2.NetworkList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NetworkList)(nil), (*NetworkList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkList_To_v1alpha1_NetworkList(a.(*v1alpha2.NetworkList), b.(*NetworkList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkProviderEntry)(nil), (*v1alpha2.NetworkProviderEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkProviderEntry_To_v1alpha2_NetworkProviderEntry(a.(*NetworkProviderEntry), b.(*v1alpha2.NetworkProviderEntry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NetworkProviderEntry)(nil), (*NetworkProviderEntry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkProviderEntry_To_v1alpha1_NetworkProviderEntry(a.(*v1alpha2.NetworkProviderEntry), b.(*NetworkProviderEntry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkProviderReference)(nil), (*v1alpha2.NetworkProviderReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkProviderReference_To_v1alpha2_NetworkProviderReference(a.(*NetworkProviderReference), b.(*v1alpha2.NetworkProviderReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NetworkProviderReference)(nil), (*NetworkProviderReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkProviderReference_To_v1alpha1_NetworkProviderReference(a.(*v1alpha2.NetworkProviderReference), b.(*NetworkProviderReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkReference)(nil), (*v1alpha2.NetworkReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkReference_To_v1alpha2_NetworkReference(a.(*NetworkReference), b.(*v1alpha2.NetworkReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NetworkReference)(nil), (*NetworkReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkReference_To_v1alpha1_NetworkReference(a.(*v1alpha2.NetworkReference), b.(*NetworkReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkSettings)(nil), (*v1alpha2.NetworkSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkSettings_To_v1alpha2_NetworkSettings(a.(*NetworkSettings), b.(*v1alpha2.NetworkSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NetworkSettings)(nil), (*NetworkSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkSettings_To_v1alpha1_NetworkSettings(a.(*v1alpha2.NetworkSettings), b.(*NetworkSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkSettingsList)(nil), (*v1alpha2.NetworkSettingsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkSettingsList_To_v1alpha2_NetworkSettingsList(a.(*NetworkSettingsList), b.(*v1alpha2.NetworkSettingsList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NetworkSettingsList)(nil), (*NetworkSettingsList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkSettingsList_To_v1alpha1_NetworkSettingsList(a.(*v1alpha2.NetworkSettingsList), b.(*NetworkSettingsList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkSpec)(nil), (*v1alpha2.NetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkSpec_To_v1alpha2_NetworkSpec(a.(*NetworkSpec), b.(*v1alpha2.NetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NetworkSpec)(nil), (*NetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkSpec_To_v1alpha1_NetworkSpec(a.(*v1alpha2.NetworkSpec), b.(*NetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkStatus)(nil), (*v1alpha2.NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkStatus_To_v1alpha2_NetworkStatus(a.(*NetworkStatus), b.(*v1alpha2.NetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.NetworkStatus)(nil), (*NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkStatus_To_v1alpha1_NetworkStatus(a.(*v1alpha2.NetworkStatus), b.(*NetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SharedSubnet)(nil), (*v1alpha2.SharedSubnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SharedSubnet_To_v1alpha2_SharedSubnet(a.(*SharedSubnet), b.(*v1alpha2.SharedSubnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.SharedSubnet)(nil), (*SharedSubnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_SharedSubnet_To_v1alpha1_SharedSubnet(a.(*v1alpha2.SharedSubnet), b.(*SharedSubnet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SingleNodeAvailabilityMode)(nil), (*v1alpha2.SingleNodeAvailabilityMode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SingleNodeAvailabilityMode_To_v1alpha2_SingleNodeAvailabilityMode(a.(*SingleNodeAvailabilityMode), b.(*v1alpha2.SingleNodeAvailabilityMode), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.SingleNodeAvailabilityMode)(nil), (*SingleNodeAvailabilityMode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_SingleNodeAvailabilityMode_To_v1alpha1_SingleNodeAvailabilityMode(a.(*v1alpha2.SingleNodeAvailabilityMode), b.(*SingleNodeAvailabilityMode), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VLANTrunkRange)(nil), (*v1alpha2.VLANTrunkRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VLANTrunkRange_To_v1alpha2_VLANTrunkRange(a.(*VLANTrunkRange), b.(*v1alpha2.VLANTrunkRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.VLANTrunkRange)(nil), (*VLANTrunkRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VLANTrunkRange_To_v1alpha1_VLANTrunkRange(a.(*v1alpha2.VLANTrunkRange), b.(*VLANTrunkRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VMXNET3NetworkInterface)(nil), (*v1alpha2.VMXNET3NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VMXNET3NetworkInterface_To_v1alpha2_VMXNET3NetworkInterface(a.(*VMXNET3NetworkInterface), b.(*v1alpha2.VMXNET3NetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.VMXNET3NetworkInterface)(nil), (*VMXNET3NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VMXNET3NetworkInterface_To_v1alpha1_VMXNET3NetworkInterface(a.(*v1alpha2.VMXNET3NetworkInterface), b.(*VMXNET3NetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VMXNET3NetworkInterfaceList)(nil), (*v1alpha2.VMXNET3NetworkInterfaceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VMXNET3NetworkInterfaceList_To_v1alpha2_VMXNET3NetworkInterfaceList(a.(*VMXNET3NetworkInterfaceList), b.(*v1alpha2.VMXNET3NetworkInterfaceList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.VMXNET3NetworkInterfaceList)(nil), (*VMXNET3NetworkInterfaceList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VMXNET3NetworkInterfaceList_To_v1alpha1_VMXNET3NetworkInterfaceList(a.(*v1alpha2.VMXNET3NetworkInterfaceList), b.(*VMXNET3NetworkInterfaceList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VMXNET3NetworkInterfaceSpec)(nil), (*v1alpha2.VMXNET3NetworkInterfaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VMXNET3NetworkInterfaceSpec_To_v1alpha2_VMXNET3NetworkInterfaceSpec(a.(*VMXNET3NetworkInterfaceSpec), b.(*v1alpha2.VMXNET3NetworkInterfaceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.VMXNET3NetworkInterfaceSpec)(nil), (*VMXNET3NetworkInterfaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VMXNET3NetworkInterfaceSpec_To_v1alpha1_VMXNET3NetworkInterfaceSpec(a.(*v1alpha2.VMXNET3NetworkInterfaceSpec), b.(*VMXNET3NetworkInterfaceSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VMXNET3NetworkInterfaceStatus)(nil), (*v1alpha2.VMXNET3NetworkInterfaceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VMXNET3NetworkInterfaceStatus_To_v1alpha2_VMXNET3NetworkInterfaceStatus(a.(*VMXNET3NetworkInterfaceStatus), b.(*v1alpha2.VMXNET3NetworkInterfaceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.VMXNET3NetworkInterfaceStatus)(nil), (*VMXNET3NetworkInterfaceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VMXNET3NetworkInterfaceStatus_To_v1alpha1_VMXNET3NetworkInterfaceStatus(a.(*v1alpha2.VMXNET3NetworkInterfaceStatus), b.(*VMXNET3NetworkInterfaceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VPCConfig)(nil), (*v1alpha2.VPCConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VPCConfig_To_v1alpha2_VPCConfig(a.(*VPCConfig), b.(*v1alpha2.VPCConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.VPCConfig)(nil), (*VPCConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VPCConfig_To_v1alpha1_VPCConfig(a.(*v1alpha2.VPCConfig), b.(*VPCConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VSphereDistributedConfig)(nil), (*v1alpha2.VSphereDistributedConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VSphereDistributedConfig_To_v1alpha2_VSphereDistributedConfig(a.(*VSphereDistributedConfig), b.(*v1alpha2.VSphereDistributedConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.VSphereDistributedConfig)(nil), (*VSphereDistributedConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VSphereDistributedConfig_To_v1alpha1_VSphereDistributedConfig(a.(*v1alpha2.VSphereDistributedConfig), b.(*VSphereDistributedConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VSphereDistributedNetworkIPRange)(nil), (*v1alpha2.VSphereDistributedNetworkIPRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VSphereDistributedNetworkIPRange_To_v1alpha2_VSphereDistributedNetworkIPRange(a.(*VSphereDistributedNetworkIPRange), b.(*v1alpha2.VSphereDistributedNetworkIPRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.VSphereDistributedNetworkIPRange)(nil), (*VSphereDistributedNetworkIPRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VSphereDistributedNetworkIPRange_To_v1alpha1_VSphereDistributedNetworkIPRange(a.(*v1alpha2.VSphereDistributedNetworkIPRange), b.(*VSphereDistributedNetworkIPRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VSphereDistributedNetworkList)(nil), (*v1alpha2.VSphereDistributedNetworkList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VSphereDistributedNetworkList_To_v1alpha2_VSphereDistributedNetworkList(a.(*VSphereDistributedNetworkList), b.(*v1alpha2.VSphereDistributedNetworkList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.VSphereDistributedNetworkList)(nil), (*VSphereDistributedNetworkList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VSphereDistributedNetworkList_To_v1alpha1_VSphereDistributedNetworkList(a.(*v1alpha2.VSphereDistributedNetworkList), b.(*VSphereDistributedNetworkList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VSphereDistributedNetworkRef)(nil), (*v1alpha2.VSphereDistributedNetworkRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VSphereDistributedNetworkRef_To_v1alpha2_VSphereDistributedNetworkRef(a.(*VSphereDistributedNetworkRef), b.(*v1alpha2.VSphereDistributedNetworkRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.VSphereDistributedNetworkRef)(nil), (*VSphereDistributedNetworkRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VSphereDistributedNetworkRef_To_v1alpha1_VSphereDistributedNetworkRef(a.(*v1alpha2.VSphereDistributedNetworkRef), b.(*VSphereDistributedNetworkRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VSphereDistributedNetworkSpec)(nil), (*v1alpha2.VSphereDistributedNetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VSphereDistributedNetworkSpec_To_v1alpha2_VSphereDistributedNetworkSpec(a.(*VSphereDistributedNetworkSpec), b.(*v1alpha2.VSphereDistributedNetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.VSphereDistributedNetworkSpec)(nil), (*VSphereDistributedNetworkSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VSphereDistributedNetworkSpec_To_v1alpha1_VSphereDistributedNetworkSpec(a.(*v1alpha2.VSphereDistributedNetworkSpec), b.(*VSphereDistributedNetworkSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VSphereDistributedNetworkStatus)(nil), (*v1alpha2.VSphereDistributedNetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VSphereDistributedNetworkStatus_To_v1alpha2_VSphereDistributedNetworkStatus(a.(*VSphereDistributedNetworkStatus), b.(*v1alpha2.VSphereDistributedNetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.VSphereDistributedNetworkStatus)(nil), (*VSphereDistributedNetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VSphereDistributedNetworkStatus_To_v1alpha1_VSphereDistributedNetworkStatus(a.(*v1alpha2.VSphereDistributedNetworkStatus), b.(*VSphereDistributedNetworkStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VSphereDistributedPortConfig)(nil), (*v1alpha2.VSphereDistributedPortConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VSphereDistributedPortConfig_To_v1alpha2_VSphereDistributedPortConfig(a.(*VSphereDistributedPortConfig), b.(*v1alpha2.VSphereDistributedPortConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.VSphereDistributedPortConfig)(nil), (*VSphereDistributedPortConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VSphereDistributedPortConfig_To_v1alpha1_VSphereDistributedPortConfig(a.(*v1alpha2.VSphereDistributedPortConfig), b.(*VSphereDistributedPortConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VirtualIPPoolsUtilization)(nil), (*v1alpha2.VirtualIPPoolsUtilization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VirtualIPPoolsUtilization_To_v1alpha2_VirtualIPPoolsUtilization(a.(*VirtualIPPoolsUtilization), b.(*v1alpha2.VirtualIPPoolsUtilization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.VirtualIPPoolsUtilization)(nil), (*VirtualIPPoolsUtilization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VirtualIPPoolsUtilization_To_v1alpha1_VirtualIPPoolsUtilization(a.(*v1alpha2.VirtualIPPoolsUtilization), b.(*VirtualIPPoolsUtilization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VlanSpec)(nil), (*v1alpha2.VlanSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VlanSpec_To_v1alpha2_VlanSpec(a.(*VlanSpec), b.(*v1alpha2.VlanSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.VlanSpec)(nil), (*VlanSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VlanSpec_To_v1alpha1_VlanSpec(a.(*v1alpha2.VlanSpec), b.(*VlanSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkloadNetworkConfiguration)(nil), (*v1alpha2.WorkloadNetworkConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkloadNetworkConfiguration_To_v1alpha2_WorkloadNetworkConfiguration(a.(*WorkloadNetworkConfiguration), b.(*v1alpha2.WorkloadNetworkConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.WorkloadNetworkConfiguration)(nil), (*WorkloadNetworkConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_WorkloadNetworkConfiguration_To_v1alpha1_WorkloadNetworkConfiguration(a.(*v1alpha2.WorkloadNetworkConfiguration), b.(*WorkloadNetworkConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkloadNetworkConfigurationList)(nil), (*v1alpha2.WorkloadNetworkConfigurationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkloadNetworkConfigurationList_To_v1alpha2_WorkloadNetworkConfigurationList(a.(*WorkloadNetworkConfigurationList), b.(*v1alpha2.WorkloadNetworkConfigurationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.WorkloadNetworkConfigurationList)(nil), (*WorkloadNetworkConfigurationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_WorkloadNetworkConfigurationList_To_v1alpha1_WorkloadNetworkConfigurationList(a.(*v1alpha2.WorkloadNetworkConfigurationList), b.(*WorkloadNetworkConfigurationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkloadNetworkConfigurationSpec)(nil), (*v1alpha2.WorkloadNetworkConfigurationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkloadNetworkConfigurationSpec_To_v1alpha2_WorkloadNetworkConfigurationSpec(a.(*WorkloadNetworkConfigurationSpec), b.(*v1alpha2.WorkloadNetworkConfigurationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.WorkloadNetworkConfigurationSpec)(nil), (*WorkloadNetworkConfigurationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_WorkloadNetworkConfigurationSpec_To_v1alpha1_WorkloadNetworkConfigurationSpec(a.(*v1alpha2.WorkloadNetworkConfigurationSpec), b.(*WorkloadNetworkConfigurationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkloadNetworkConfigurationStatus)(nil), (*v1alpha2.WorkloadNetworkConfigurationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkloadNetworkConfigurationStatus_To_v1alpha2_WorkloadNetworkConfigurationStatus(a.(*WorkloadNetworkConfigurationStatus), b.(*v1alpha2.WorkloadNetworkConfigurationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha2.WorkloadNetworkConfigurationStatus)(nil), (*WorkloadNetworkConfigurationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_WorkloadNetworkConfigurationStatus_To_v1alpha1_WorkloadNetworkConfigurationStatus(a.(*v1alpha2.WorkloadNetworkConfigurationStatus), b.(*WorkloadNetworkConfigurationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.Condition)(nil), (*IPAddressAllocationCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Condition_To_v1alpha1_IPAddressAllocationCondition(a.(*v1.Condition), b.(*IPAddressAllocationCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.Condition)(nil), (*IPPoolCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Condition_To_v1alpha1_IPPoolCondition(a.(*v1.Condition), b.(*IPPoolCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.Condition)(nil), (*LoadBalancerConfigCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Condition_To_v1alpha1_LoadBalancerConfigCondition(a.(*v1.Condition), b.(*LoadBalancerConfigCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.Condition)(nil), (*NetworkCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Condition_To_v1alpha1_NetworkCondition(a.(*v1.Condition), b.(*NetworkCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.Condition)(nil), (*NetworkInterfaceCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Condition_To_v1alpha1_NetworkInterfaceCondition(a.(*v1.Condition), b.(*NetworkInterfaceCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1.Condition)(nil), (*VSphereDistributedNetworkCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Condition_To_v1alpha1_VSphereDistributedNetworkCondition(a.(*v1.Condition), b.(*VSphereDistributedNetworkCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*IPAddressAllocationCondition)(nil), (*v1.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPAddressAllocationCondition_To_v1_Condition(a.(*IPAddressAllocationCondition), b.(*v1.Condition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*IPAddressAllocation)(nil), (*v1alpha2.IPAddressAllocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPAddressAllocation_To_v1alpha2_IPAddressAllocation(a.(*IPAddressAllocation), b.(*v1alpha2.IPAddressAllocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*IPPoolCondition)(nil), (*v1.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPPoolCondition_To_v1_Condition(a.(*IPPoolCondition), b.(*v1.Condition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*IPPool)(nil), (*v1alpha2.IPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPPool_To_v1alpha2_IPPool(a.(*IPPool), b.(*v1alpha2.IPPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*LoadBalancerConfigCondition)(nil), (*v1.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerConfigCondition_To_v1_Condition(a.(*LoadBalancerConfigCondition), b.(*v1.Condition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*LoadBalancerConfig)(nil), (*v1alpha2.LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerConfig_To_v1alpha2_LoadBalancerConfig(a.(*LoadBalancerConfig), b.(*v1alpha2.LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*NetworkCondition)(nil), (*v1.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkCondition_To_v1_Condition(a.(*NetworkCondition), b.(*v1.Condition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*NetworkInterfaceCondition)(nil), (*v1.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterfaceCondition_To_v1_Condition(a.(*NetworkInterfaceCondition), b.(*v1.Condition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*NetworkInterface)(nil), (*v1alpha2.NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NetworkInterface_To_v1alpha2_NetworkInterface(a.(*NetworkInterface), b.(*v1alpha2.NetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*Network)(nil), (*v1alpha2.Network)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Network_To_v1alpha2_Network(a.(*Network), b.(*v1alpha2.Network), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*VSphereDistributedNetworkCondition)(nil), (*v1.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VSphereDistributedNetworkCondition_To_v1_Condition(a.(*VSphereDistributedNetworkCondition), b.(*v1.Condition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*VSphereDistributedNetwork)(nil), (*v1alpha2.VSphereDistributedNetwork)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VSphereDistributedNetwork_To_v1alpha2_VSphereDistributedNetwork(a.(*VSphereDistributedNetwork), b.(*v1alpha2.VSphereDistributedNetwork), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha2.IPAddressAllocation)(nil), (*IPAddressAllocation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPAddressAllocation_To_v1alpha1_IPAddressAllocation(a.(*v1alpha2.IPAddressAllocation), b.(*IPAddressAllocation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha2.IPPool)(nil), (*IPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_IPPool_To_v1alpha1_IPPool(a.(*v1alpha2.IPPool), b.(*IPPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha2.LoadBalancerConfig)(nil), (*LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(a.(*v1alpha2.LoadBalancerConfig), b.(*LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha2.NetworkInterface)(nil), (*NetworkInterface)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_NetworkInterface_To_v1alpha1_NetworkInterface(a.(*v1alpha2.NetworkInterface), b.(*NetworkInterface), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha2.Network)(nil), (*Network)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_Network_To_v1alpha1_Network(a.(*v1alpha2.Network), b.(*Network), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1alpha2.VSphereDistributedNetwork)(nil), (*VSphereDistributedNetwork)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_VSphereDistributedNetwork_To_v1alpha1_VSphereDistributedNetwork(a.(*v1alpha2.VSphereDistributedNetwork), b.(*VSphereDistributedNetwork), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_ActivePassiveAvailabilityMode_To_v1alpha2_ActivePassiveAvailabilityMode(in *ActivePassiveAvailabilityMode, out *v1alpha2.ActivePassiveAvailabilityMode, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	return nil
+}
+
+// Convert_v1alpha1_ActivePassiveAvailabilityMode_To_v1alpha2_ActivePassiveAvailabilityMode is an autogenerated conversion function.
+func Convert_v1alpha1_ActivePassiveAvailabilityMode_To_v1alpha2_ActivePassiveAvailabilityMode(in *ActivePassiveAvailabilityMode, out *v1alpha2.ActivePassiveAvailabilityMode, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ActivePassiveAvailabilityMode_To_v1alpha2_ActivePassiveAvailabilityMode(in, out, s)
+}
+
+func autoConvert_v1alpha2_ActivePassiveAvailabilityMode_To_v1alpha1_ActivePassiveAvailabilityMode(in *v1alpha2.ActivePassiveAvailabilityMode, out *ActivePassiveAvailabilityMode, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	return nil
+}
+
+// Convert_v1alpha2_ActivePassiveAvailabilityMode_To_v1alpha1_ActivePassiveAvailabilityMode is an autogenerated conversion function.
+func Convert_v1alpha2_ActivePassiveAvailabilityMode_To_v1alpha1_ActivePassiveAvailabilityMode(in *v1alpha2.ActivePassiveAvailabilityMode, out *ActivePassiveAvailabilityMode, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ActivePassiveAvailabilityMode_To_v1alpha1_ActivePassiveAvailabilityMode(in, out, s)
+}
+
+func autoConvert_v1alpha1_AutoCreateVPCConfig_To_v1alpha2_AutoCreateVPCConfig(in *AutoCreateVPCConfig, out *v1alpha2.AutoCreateVPCConfig, s conversion.Scope) error {
+	out.NSXProject = in.NSXProject
+	out.VPCConnectivityProfile = in.VPCConnectivityProfile
+	out.PrivateCIDRs = *(*[]string)(unsafe.Pointer(&in.PrivateCIDRs))
+	return nil
+}
+
+// Convert_v1alpha1_AutoCreateVPCConfig_To_v1alpha2_AutoCreateVPCConfig is an autogenerated conversion function.
+func Convert_v1alpha1_AutoCreateVPCConfig_To_v1alpha2_AutoCreateVPCConfig(in *AutoCreateVPCConfig, out *v1alpha2.AutoCreateVPCConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AutoCreateVPCConfig_To_v1alpha2_AutoCreateVPCConfig(in, out, s)
+}
+
+func autoConvert_v1alpha2_AutoCreateVPCConfig_To_v1alpha1_AutoCreateVPCConfig(in *v1alpha2.AutoCreateVPCConfig, out *AutoCreateVPCConfig, s conversion.Scope) error {
+	out.NSXProject = in.NSXProject
+	out.VPCConnectivityProfile = in.VPCConnectivityProfile
+	out.PrivateCIDRs = *(*[]string)(unsafe.Pointer(&in.PrivateCIDRs))
+	return nil
+}
+
+// Convert_v1alpha2_AutoCreateVPCConfig_To_v1alpha1_AutoCreateVPCConfig is an autogenerated conversion function.
+func Convert_v1alpha2_AutoCreateVPCConfig_To_v1alpha1_AutoCreateVPCConfig(in *v1alpha2.AutoCreateVPCConfig, out *AutoCreateVPCConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AutoCreateVPCConfig_To_v1alpha1_AutoCreateVPCConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_AviLoadBalancerConfig_To_v1alpha2_AviLoadBalancerConfig(in *AviLoadBalancerConfig, out *v1alpha2.AviLoadBalancerConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_AviLoadBalancerConfigSpec_To_v1alpha2_AviLoadBalancerConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_AviLoadBalancerConfigStatus_To_v1alpha2_AviLoadBalancerConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_AviLoadBalancerConfig_To_v1alpha2_AviLoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_AviLoadBalancerConfig_To_v1alpha2_AviLoadBalancerConfig(in *AviLoadBalancerConfig, out *v1alpha2.AviLoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AviLoadBalancerConfig_To_v1alpha2_AviLoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha2_AviLoadBalancerConfig_To_v1alpha1_AviLoadBalancerConfig(in *v1alpha2.AviLoadBalancerConfig, out *AviLoadBalancerConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_AviLoadBalancerConfigSpec_To_v1alpha1_AviLoadBalancerConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_AviLoadBalancerConfigStatus_To_v1alpha1_AviLoadBalancerConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_AviLoadBalancerConfig_To_v1alpha1_AviLoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha2_AviLoadBalancerConfig_To_v1alpha1_AviLoadBalancerConfig(in *v1alpha2.AviLoadBalancerConfig, out *AviLoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AviLoadBalancerConfig_To_v1alpha1_AviLoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_AviLoadBalancerConfigList_To_v1alpha2_AviLoadBalancerConfigList(in *AviLoadBalancerConfigList, out *v1alpha2.AviLoadBalancerConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha2.AviLoadBalancerConfig)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_AviLoadBalancerConfigList_To_v1alpha2_AviLoadBalancerConfigList is an autogenerated conversion function.
+func Convert_v1alpha1_AviLoadBalancerConfigList_To_v1alpha2_AviLoadBalancerConfigList(in *AviLoadBalancerConfigList, out *v1alpha2.AviLoadBalancerConfigList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AviLoadBalancerConfigList_To_v1alpha2_AviLoadBalancerConfigList(in, out, s)
+}
+
+func autoConvert_v1alpha2_AviLoadBalancerConfigList_To_v1alpha1_AviLoadBalancerConfigList(in *v1alpha2.AviLoadBalancerConfigList, out *AviLoadBalancerConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]AviLoadBalancerConfig)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha2_AviLoadBalancerConfigList_To_v1alpha1_AviLoadBalancerConfigList is an autogenerated conversion function.
+func Convert_v1alpha2_AviLoadBalancerConfigList_To_v1alpha1_AviLoadBalancerConfigList(in *v1alpha2.AviLoadBalancerConfigList, out *AviLoadBalancerConfigList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AviLoadBalancerConfigList_To_v1alpha1_AviLoadBalancerConfigList(in, out, s)
+}
+
+func autoConvert_v1alpha1_AviLoadBalancerConfigSpec_To_v1alpha2_AviLoadBalancerConfigSpec(in *AviLoadBalancerConfigSpec, out *v1alpha2.AviLoadBalancerConfigSpec, s conversion.Scope) error {
+	out.Server = in.Server
+	out.CloudName = in.CloudName
+	out.AdvancedL4 = (*bool)(unsafe.Pointer(in.AdvancedL4))
+	out.LogLevel = v1alpha2.AviLoadBalancerLogLevel(in.LogLevel)
+	out.IPAMType = v1alpha2.AviLoadBalancerIPAMType(in.IPAMType)
+	if err := Convert_v1alpha1_ClientSecretReference_To_v1alpha2_ClientSecretReference(&in.CredentialSecretRef, &out.CredentialSecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_AviLoadBalancerConfigSpec_To_v1alpha2_AviLoadBalancerConfigSpec is an autogenerated conversion function.
+func Convert_v1alpha1_AviLoadBalancerConfigSpec_To_v1alpha2_AviLoadBalancerConfigSpec(in *AviLoadBalancerConfigSpec, out *v1alpha2.AviLoadBalancerConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AviLoadBalancerConfigSpec_To_v1alpha2_AviLoadBalancerConfigSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_AviLoadBalancerConfigSpec_To_v1alpha1_AviLoadBalancerConfigSpec(in *v1alpha2.AviLoadBalancerConfigSpec, out *AviLoadBalancerConfigSpec, s conversion.Scope) error {
+	out.Server = in.Server
+	out.CloudName = in.CloudName
+	out.AdvancedL4 = (*bool)(unsafe.Pointer(in.AdvancedL4))
+	out.LogLevel = AviLoadBalancerLogLevel(in.LogLevel)
+	out.IPAMType = AviLoadBalancerIPAMType(in.IPAMType)
+	if err := Convert_v1alpha2_ClientSecretReference_To_v1alpha1_ClientSecretReference(&in.CredentialSecretRef, &out.CredentialSecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_AviLoadBalancerConfigSpec_To_v1alpha1_AviLoadBalancerConfigSpec is an autogenerated conversion function.
+func Convert_v1alpha2_AviLoadBalancerConfigSpec_To_v1alpha1_AviLoadBalancerConfigSpec(in *v1alpha2.AviLoadBalancerConfigSpec, out *AviLoadBalancerConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AviLoadBalancerConfigSpec_To_v1alpha1_AviLoadBalancerConfigSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_AviLoadBalancerConfigStatus_To_v1alpha2_AviLoadBalancerConfigStatus(in *AviLoadBalancerConfigStatus, out *v1alpha2.AviLoadBalancerConfigStatus, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_AviLoadBalancerConfigStatus_To_v1alpha2_AviLoadBalancerConfigStatus is an autogenerated conversion function.
+func Convert_v1alpha1_AviLoadBalancerConfigStatus_To_v1alpha2_AviLoadBalancerConfigStatus(in *AviLoadBalancerConfigStatus, out *v1alpha2.AviLoadBalancerConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AviLoadBalancerConfigStatus_To_v1alpha2_AviLoadBalancerConfigStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_AviLoadBalancerConfigStatus_To_v1alpha1_AviLoadBalancerConfigStatus(in *v1alpha2.AviLoadBalancerConfigStatus, out *AviLoadBalancerConfigStatus, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha2_AviLoadBalancerConfigStatus_To_v1alpha1_AviLoadBalancerConfigStatus is an autogenerated conversion function.
+func Convert_v1alpha2_AviLoadBalancerConfigStatus_To_v1alpha1_AviLoadBalancerConfigStatus(in *v1alpha2.AviLoadBalancerConfigStatus, out *AviLoadBalancerConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_AviLoadBalancerConfigStatus_To_v1alpha1_AviLoadBalancerConfigStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClientSecretReference_To_v1alpha2_ClientSecretReference(in *ClientSecretReference, out *v1alpha2.ClientSecretReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_v1alpha1_ClientSecretReference_To_v1alpha2_ClientSecretReference is an autogenerated conversion function.
+func Convert_v1alpha1_ClientSecretReference_To_v1alpha2_ClientSecretReference(in *ClientSecretReference, out *v1alpha2.ClientSecretReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClientSecretReference_To_v1alpha2_ClientSecretReference(in, out, s)
+}
+
+func autoConvert_v1alpha2_ClientSecretReference_To_v1alpha1_ClientSecretReference(in *v1alpha2.ClientSecretReference, out *ClientSecretReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_v1alpha2_ClientSecretReference_To_v1alpha1_ClientSecretReference is an autogenerated conversion function.
+func Convert_v1alpha2_ClientSecretReference_To_v1alpha1_ClientSecretReference(in *v1alpha2.ClientSecretReference, out *ClientSecretReference, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ClientSecretReference_To_v1alpha1_ClientSecretReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_FoundationLoadBalancerConfig_To_v1alpha2_FoundationLoadBalancerConfig(in *FoundationLoadBalancerConfig, out *v1alpha2.FoundationLoadBalancerConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_FoundationLoadBalancerConfigSpec_To_v1alpha2_FoundationLoadBalancerConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_FoundationLoadBalancerConfigStatus_To_v1alpha2_FoundationLoadBalancerConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_FoundationLoadBalancerConfig_To_v1alpha2_FoundationLoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_FoundationLoadBalancerConfig_To_v1alpha2_FoundationLoadBalancerConfig(in *FoundationLoadBalancerConfig, out *v1alpha2.FoundationLoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FoundationLoadBalancerConfig_To_v1alpha2_FoundationLoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha2_FoundationLoadBalancerConfig_To_v1alpha1_FoundationLoadBalancerConfig(in *v1alpha2.FoundationLoadBalancerConfig, out *FoundationLoadBalancerConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_FoundationLoadBalancerConfigSpec_To_v1alpha1_FoundationLoadBalancerConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_FoundationLoadBalancerConfigStatus_To_v1alpha1_FoundationLoadBalancerConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_FoundationLoadBalancerConfig_To_v1alpha1_FoundationLoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha2_FoundationLoadBalancerConfig_To_v1alpha1_FoundationLoadBalancerConfig(in *v1alpha2.FoundationLoadBalancerConfig, out *FoundationLoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_FoundationLoadBalancerConfig_To_v1alpha1_FoundationLoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_FoundationLoadBalancerConfigList_To_v1alpha2_FoundationLoadBalancerConfigList(in *FoundationLoadBalancerConfigList, out *v1alpha2.FoundationLoadBalancerConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha2.FoundationLoadBalancerConfig)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_FoundationLoadBalancerConfigList_To_v1alpha2_FoundationLoadBalancerConfigList is an autogenerated conversion function.
+func Convert_v1alpha1_FoundationLoadBalancerConfigList_To_v1alpha2_FoundationLoadBalancerConfigList(in *FoundationLoadBalancerConfigList, out *v1alpha2.FoundationLoadBalancerConfigList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FoundationLoadBalancerConfigList_To_v1alpha2_FoundationLoadBalancerConfigList(in, out, s)
+}
+
+func autoConvert_v1alpha2_FoundationLoadBalancerConfigList_To_v1alpha1_FoundationLoadBalancerConfigList(in *v1alpha2.FoundationLoadBalancerConfigList, out *FoundationLoadBalancerConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]FoundationLoadBalancerConfig)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha2_FoundationLoadBalancerConfigList_To_v1alpha1_FoundationLoadBalancerConfigList is an autogenerated conversion function.
+func Convert_v1alpha2_FoundationLoadBalancerConfigList_To_v1alpha1_FoundationLoadBalancerConfigList(in *v1alpha2.FoundationLoadBalancerConfigList, out *FoundationLoadBalancerConfigList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_FoundationLoadBalancerConfigList_To_v1alpha1_FoundationLoadBalancerConfigList(in, out, s)
+}
+
+func autoConvert_v1alpha1_FoundationLoadBalancerConfigSpec_To_v1alpha2_FoundationLoadBalancerConfigSpec(in *FoundationLoadBalancerConfigSpec, out *v1alpha2.FoundationLoadBalancerConfigSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha1_FoundationLoadBalancerDeploymentSpec_To_v1alpha2_FoundationLoadBalancerDeploymentSpec(&in.DeploymentSpec, &out.DeploymentSpec, s); err != nil {
+		return err
+	}
+	out.ManagementNetwork = (*v1alpha2.NetworkReference)(unsafe.Pointer(in.ManagementNetwork))
+	out.WorkloadNetworks = *(*[]v1alpha2.NetworkReference)(unsafe.Pointer(&in.WorkloadNetworks))
+	if err := Convert_v1alpha1_NetworkReference_To_v1alpha2_NetworkReference(&in.VirtualIPNetwork, &out.VirtualIPNetwork, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_FoundationLoadBalancerNetworkConfigSpec_To_v1alpha2_FoundationLoadBalancerNetworkConfigSpec(&in.NetworkSpec, &out.NetworkSpec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_FoundationLoadBalancerConfigSpec_To_v1alpha2_FoundationLoadBalancerConfigSpec is an autogenerated conversion function.
+func Convert_v1alpha1_FoundationLoadBalancerConfigSpec_To_v1alpha2_FoundationLoadBalancerConfigSpec(in *FoundationLoadBalancerConfigSpec, out *v1alpha2.FoundationLoadBalancerConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FoundationLoadBalancerConfigSpec_To_v1alpha2_FoundationLoadBalancerConfigSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_FoundationLoadBalancerConfigSpec_To_v1alpha1_FoundationLoadBalancerConfigSpec(in *v1alpha2.FoundationLoadBalancerConfigSpec, out *FoundationLoadBalancerConfigSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha2_FoundationLoadBalancerDeploymentSpec_To_v1alpha1_FoundationLoadBalancerDeploymentSpec(&in.DeploymentSpec, &out.DeploymentSpec, s); err != nil {
+		return err
+	}
+	out.ManagementNetwork = (*NetworkReference)(unsafe.Pointer(in.ManagementNetwork))
+	out.WorkloadNetworks = *(*[]NetworkReference)(unsafe.Pointer(&in.WorkloadNetworks))
+	if err := Convert_v1alpha2_NetworkReference_To_v1alpha1_NetworkReference(&in.VirtualIPNetwork, &out.VirtualIPNetwork, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_FoundationLoadBalancerNetworkConfigSpec_To_v1alpha1_FoundationLoadBalancerNetworkConfigSpec(&in.NetworkSpec, &out.NetworkSpec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_FoundationLoadBalancerConfigSpec_To_v1alpha1_FoundationLoadBalancerConfigSpec is an autogenerated conversion function.
+func Convert_v1alpha2_FoundationLoadBalancerConfigSpec_To_v1alpha1_FoundationLoadBalancerConfigSpec(in *v1alpha2.FoundationLoadBalancerConfigSpec, out *FoundationLoadBalancerConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_FoundationLoadBalancerConfigSpec_To_v1alpha1_FoundationLoadBalancerConfigSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_FoundationLoadBalancerConfigStatus_To_v1alpha2_FoundationLoadBalancerConfigStatus(in *FoundationLoadBalancerConfigStatus, out *v1alpha2.FoundationLoadBalancerConfigStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Nodes = *(*[]v1alpha2.FoundationLoadBalancerNodeStatus)(unsafe.Pointer(&in.Nodes))
+	if err := Convert_v1alpha1_VirtualIPPoolsUtilization_To_v1alpha2_VirtualIPPoolsUtilization(&in.VirtualServerIPPoolsUtilization, &out.VirtualServerIPPoolsUtilization, s); err != nil {
+		return err
+	}
+	out.TokenDigest = in.TokenDigest
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.EffectiveVirtualServerIPPools = *(*[]string)(unsafe.Pointer(&in.EffectiveVirtualServerIPPools))
+	return nil
+}
+
+// Convert_v1alpha1_FoundationLoadBalancerConfigStatus_To_v1alpha2_FoundationLoadBalancerConfigStatus is an autogenerated conversion function.
+func Convert_v1alpha1_FoundationLoadBalancerConfigStatus_To_v1alpha2_FoundationLoadBalancerConfigStatus(in *FoundationLoadBalancerConfigStatus, out *v1alpha2.FoundationLoadBalancerConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FoundationLoadBalancerConfigStatus_To_v1alpha2_FoundationLoadBalancerConfigStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_FoundationLoadBalancerConfigStatus_To_v1alpha1_FoundationLoadBalancerConfigStatus(in *v1alpha2.FoundationLoadBalancerConfigStatus, out *FoundationLoadBalancerConfigStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Nodes = *(*[]FoundationLoadBalancerNodeStatus)(unsafe.Pointer(&in.Nodes))
+	if err := Convert_v1alpha2_VirtualIPPoolsUtilization_To_v1alpha1_VirtualIPPoolsUtilization(&in.VirtualServerIPPoolsUtilization, &out.VirtualServerIPPoolsUtilization, s); err != nil {
+		return err
+	}
+	out.TokenDigest = in.TokenDigest
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.EffectiveVirtualServerIPPools = *(*[]string)(unsafe.Pointer(&in.EffectiveVirtualServerIPPools))
+	return nil
+}
+
+// Convert_v1alpha2_FoundationLoadBalancerConfigStatus_To_v1alpha1_FoundationLoadBalancerConfigStatus is an autogenerated conversion function.
+func Convert_v1alpha2_FoundationLoadBalancerConfigStatus_To_v1alpha1_FoundationLoadBalancerConfigStatus(in *v1alpha2.FoundationLoadBalancerConfigStatus, out *FoundationLoadBalancerConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_FoundationLoadBalancerConfigStatus_To_v1alpha1_FoundationLoadBalancerConfigStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_FoundationLoadBalancerDeploymentSpec_To_v1alpha2_FoundationLoadBalancerDeploymentSpec(in *FoundationLoadBalancerDeploymentSpec, out *v1alpha2.FoundationLoadBalancerDeploymentSpec, s conversion.Scope) error {
+	out.Size = v1alpha2.FoundationLoadBalancerSize(in.Size)
+	out.StoragePolicy = in.StoragePolicy
+	out.Version = in.Version
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.AvailabilityMode = v1alpha2.FoundationLoadBalancerAvailabilityMode(in.AvailabilityMode)
+	out.ActivePassiveAvailabilityMode = (*v1alpha2.ActivePassiveAvailabilityMode)(unsafe.Pointer(in.ActivePassiveAvailabilityMode))
+	out.SingleNodeAvailabilityMode = (*v1alpha2.SingleNodeAvailabilityMode)(unsafe.Pointer(in.SingleNodeAvailabilityMode))
+	return nil
+}
+
+// Convert_v1alpha1_FoundationLoadBalancerDeploymentSpec_To_v1alpha2_FoundationLoadBalancerDeploymentSpec is an autogenerated conversion function.
+func Convert_v1alpha1_FoundationLoadBalancerDeploymentSpec_To_v1alpha2_FoundationLoadBalancerDeploymentSpec(in *FoundationLoadBalancerDeploymentSpec, out *v1alpha2.FoundationLoadBalancerDeploymentSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FoundationLoadBalancerDeploymentSpec_To_v1alpha2_FoundationLoadBalancerDeploymentSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_FoundationLoadBalancerDeploymentSpec_To_v1alpha1_FoundationLoadBalancerDeploymentSpec(in *v1alpha2.FoundationLoadBalancerDeploymentSpec, out *FoundationLoadBalancerDeploymentSpec, s conversion.Scope) error {
+	out.Size = FoundationLoadBalancerSize(in.Size)
+	out.StoragePolicy = in.StoragePolicy
+	out.Version = in.Version
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.AvailabilityMode = FoundationLoadBalancerAvailabilityMode(in.AvailabilityMode)
+	out.ActivePassiveAvailabilityMode = (*ActivePassiveAvailabilityMode)(unsafe.Pointer(in.ActivePassiveAvailabilityMode))
+	out.SingleNodeAvailabilityMode = (*SingleNodeAvailabilityMode)(unsafe.Pointer(in.SingleNodeAvailabilityMode))
+	return nil
+}
+
+// Convert_v1alpha2_FoundationLoadBalancerDeploymentSpec_To_v1alpha1_FoundationLoadBalancerDeploymentSpec is an autogenerated conversion function.
+func Convert_v1alpha2_FoundationLoadBalancerDeploymentSpec_To_v1alpha1_FoundationLoadBalancerDeploymentSpec(in *v1alpha2.FoundationLoadBalancerDeploymentSpec, out *FoundationLoadBalancerDeploymentSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_FoundationLoadBalancerDeploymentSpec_To_v1alpha1_FoundationLoadBalancerDeploymentSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_FoundationLoadBalancerNetworkConfigSpec_To_v1alpha2_FoundationLoadBalancerNetworkConfigSpec(in *FoundationLoadBalancerNetworkConfigSpec, out *v1alpha2.FoundationLoadBalancerNetworkConfigSpec, s conversion.Scope) error {
+	out.VirtualServerIPPools = *(*[]v1alpha2.IPPoolReference)(unsafe.Pointer(&in.VirtualServerIPPools))
+	out.VirtualServerIPRanges = *(*[]v1alpha2.IPRange)(unsafe.Pointer(&in.VirtualServerIPRanges))
+	out.VirtualServerSubnets = *(*[]string)(unsafe.Pointer(&in.VirtualServerSubnets))
+	out.DNSServers = *(*[]string)(unsafe.Pointer(&in.DNSServers))
+	out.DNSSearchDomains = *(*[]string)(unsafe.Pointer(&in.DNSSearchDomains))
+	out.NTPServers = *(*[]string)(unsafe.Pointer(&in.NTPServers))
+	out.SyslogEndpoint = in.SyslogEndpoint
+	out.SyslogCertificate = in.SyslogCertificate
+	return nil
+}
+
+// Convert_v1alpha1_FoundationLoadBalancerNetworkConfigSpec_To_v1alpha2_FoundationLoadBalancerNetworkConfigSpec is an autogenerated conversion function.
+func Convert_v1alpha1_FoundationLoadBalancerNetworkConfigSpec_To_v1alpha2_FoundationLoadBalancerNetworkConfigSpec(in *FoundationLoadBalancerNetworkConfigSpec, out *v1alpha2.FoundationLoadBalancerNetworkConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FoundationLoadBalancerNetworkConfigSpec_To_v1alpha2_FoundationLoadBalancerNetworkConfigSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_FoundationLoadBalancerNetworkConfigSpec_To_v1alpha1_FoundationLoadBalancerNetworkConfigSpec(in *v1alpha2.FoundationLoadBalancerNetworkConfigSpec, out *FoundationLoadBalancerNetworkConfigSpec, s conversion.Scope) error {
+	out.VirtualServerIPPools = *(*[]IPPoolReference)(unsafe.Pointer(&in.VirtualServerIPPools))
+	out.VirtualServerIPRanges = *(*[]IPRange)(unsafe.Pointer(&in.VirtualServerIPRanges))
+	out.VirtualServerSubnets = *(*[]string)(unsafe.Pointer(&in.VirtualServerSubnets))
+	out.DNSServers = *(*[]string)(unsafe.Pointer(&in.DNSServers))
+	out.DNSSearchDomains = *(*[]string)(unsafe.Pointer(&in.DNSSearchDomains))
+	out.NTPServers = *(*[]string)(unsafe.Pointer(&in.NTPServers))
+	out.SyslogEndpoint = in.SyslogEndpoint
+	out.SyslogCertificate = in.SyslogCertificate
+	return nil
+}
+
+// Convert_v1alpha2_FoundationLoadBalancerNetworkConfigSpec_To_v1alpha1_FoundationLoadBalancerNetworkConfigSpec is an autogenerated conversion function.
+func Convert_v1alpha2_FoundationLoadBalancerNetworkConfigSpec_To_v1alpha1_FoundationLoadBalancerNetworkConfigSpec(in *v1alpha2.FoundationLoadBalancerNetworkConfigSpec, out *FoundationLoadBalancerNetworkConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_FoundationLoadBalancerNetworkConfigSpec_To_v1alpha1_FoundationLoadBalancerNetworkConfigSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_FoundationLoadBalancerNodeStatus_To_v1alpha2_FoundationLoadBalancerNodeStatus(in *FoundationLoadBalancerNodeStatus, out *v1alpha2.FoundationLoadBalancerNodeStatus, s conversion.Scope) error {
+	out.NodeID = in.NodeID
+	if err := Convert_v1alpha1_NetworkInterfaceReference_To_v1alpha2_NetworkInterfaceReference(&in.ManagementNetworkInterface, &out.ManagementNetworkInterface, s); err != nil {
+		return err
+	}
+	out.WorkloadNetworkInterfaces = *(*[]v1alpha2.NetworkInterfaceReference)(unsafe.Pointer(&in.WorkloadNetworkInterfaces))
+	if err := Convert_v1alpha1_NetworkInterfaceReference_To_v1alpha2_NetworkInterfaceReference(&in.VIPNetworkInterface, &out.VIPNetworkInterface, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_FoundationLoadBalancerNodeStatus_To_v1alpha2_FoundationLoadBalancerNodeStatus is an autogenerated conversion function.
+func Convert_v1alpha1_FoundationLoadBalancerNodeStatus_To_v1alpha2_FoundationLoadBalancerNodeStatus(in *FoundationLoadBalancerNodeStatus, out *v1alpha2.FoundationLoadBalancerNodeStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FoundationLoadBalancerNodeStatus_To_v1alpha2_FoundationLoadBalancerNodeStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_FoundationLoadBalancerNodeStatus_To_v1alpha1_FoundationLoadBalancerNodeStatus(in *v1alpha2.FoundationLoadBalancerNodeStatus, out *FoundationLoadBalancerNodeStatus, s conversion.Scope) error {
+	out.NodeID = in.NodeID
+	if err := Convert_v1alpha2_NetworkInterfaceReference_To_v1alpha1_NetworkInterfaceReference(&in.ManagementNetworkInterface, &out.ManagementNetworkInterface, s); err != nil {
+		return err
+	}
+	out.WorkloadNetworkInterfaces = *(*[]NetworkInterfaceReference)(unsafe.Pointer(&in.WorkloadNetworkInterfaces))
+	if err := Convert_v1alpha2_NetworkInterfaceReference_To_v1alpha1_NetworkInterfaceReference(&in.VIPNetworkInterface, &out.VIPNetworkInterface, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_FoundationLoadBalancerNodeStatus_To_v1alpha1_FoundationLoadBalancerNodeStatus is an autogenerated conversion function.
+func Convert_v1alpha2_FoundationLoadBalancerNodeStatus_To_v1alpha1_FoundationLoadBalancerNodeStatus(in *v1alpha2.FoundationLoadBalancerNodeStatus, out *FoundationLoadBalancerNodeStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_FoundationLoadBalancerNodeStatus_To_v1alpha1_FoundationLoadBalancerNodeStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_HAProxyLoadBalancerConfig_To_v1alpha2_HAProxyLoadBalancerConfig(in *HAProxyLoadBalancerConfig, out *v1alpha2.HAProxyLoadBalancerConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_HAProxyLoadBalancerConfigSpec_To_v1alpha2_HAProxyLoadBalancerConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_HAProxyLoadBalancerConfigStatus_To_v1alpha2_HAProxyLoadBalancerConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_HAProxyLoadBalancerConfig_To_v1alpha2_HAProxyLoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_HAProxyLoadBalancerConfig_To_v1alpha2_HAProxyLoadBalancerConfig(in *HAProxyLoadBalancerConfig, out *v1alpha2.HAProxyLoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HAProxyLoadBalancerConfig_To_v1alpha2_HAProxyLoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha2_HAProxyLoadBalancerConfig_To_v1alpha1_HAProxyLoadBalancerConfig(in *v1alpha2.HAProxyLoadBalancerConfig, out *HAProxyLoadBalancerConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_HAProxyLoadBalancerConfigSpec_To_v1alpha1_HAProxyLoadBalancerConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_HAProxyLoadBalancerConfigStatus_To_v1alpha1_HAProxyLoadBalancerConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_HAProxyLoadBalancerConfig_To_v1alpha1_HAProxyLoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha2_HAProxyLoadBalancerConfig_To_v1alpha1_HAProxyLoadBalancerConfig(in *v1alpha2.HAProxyLoadBalancerConfig, out *HAProxyLoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_HAProxyLoadBalancerConfig_To_v1alpha1_HAProxyLoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_HAProxyLoadBalancerConfigList_To_v1alpha2_HAProxyLoadBalancerConfigList(in *HAProxyLoadBalancerConfigList, out *v1alpha2.HAProxyLoadBalancerConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha2.HAProxyLoadBalancerConfig)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_HAProxyLoadBalancerConfigList_To_v1alpha2_HAProxyLoadBalancerConfigList is an autogenerated conversion function.
+func Convert_v1alpha1_HAProxyLoadBalancerConfigList_To_v1alpha2_HAProxyLoadBalancerConfigList(in *HAProxyLoadBalancerConfigList, out *v1alpha2.HAProxyLoadBalancerConfigList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HAProxyLoadBalancerConfigList_To_v1alpha2_HAProxyLoadBalancerConfigList(in, out, s)
+}
+
+func autoConvert_v1alpha2_HAProxyLoadBalancerConfigList_To_v1alpha1_HAProxyLoadBalancerConfigList(in *v1alpha2.HAProxyLoadBalancerConfigList, out *HAProxyLoadBalancerConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]HAProxyLoadBalancerConfig)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha2_HAProxyLoadBalancerConfigList_To_v1alpha1_HAProxyLoadBalancerConfigList is an autogenerated conversion function.
+func Convert_v1alpha2_HAProxyLoadBalancerConfigList_To_v1alpha1_HAProxyLoadBalancerConfigList(in *v1alpha2.HAProxyLoadBalancerConfigList, out *HAProxyLoadBalancerConfigList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_HAProxyLoadBalancerConfigList_To_v1alpha1_HAProxyLoadBalancerConfigList(in, out, s)
+}
+
+func autoConvert_v1alpha1_HAProxyLoadBalancerConfigSpec_To_v1alpha2_HAProxyLoadBalancerConfigSpec(in *HAProxyLoadBalancerConfigSpec, out *v1alpha2.HAProxyLoadBalancerConfigSpec, s conversion.Scope) error {
+	out.EndPointURLs = *(*[]string)(unsafe.Pointer(&in.EndPointURLs))
+	out.ServerName = in.ServerName
+	if err := Convert_v1alpha1_ClientSecretReference_To_v1alpha2_ClientSecretReference(&in.CredentialSecretRef, &out.CredentialSecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_HAProxyLoadBalancerConfigSpec_To_v1alpha2_HAProxyLoadBalancerConfigSpec is an autogenerated conversion function.
+func Convert_v1alpha1_HAProxyLoadBalancerConfigSpec_To_v1alpha2_HAProxyLoadBalancerConfigSpec(in *HAProxyLoadBalancerConfigSpec, out *v1alpha2.HAProxyLoadBalancerConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HAProxyLoadBalancerConfigSpec_To_v1alpha2_HAProxyLoadBalancerConfigSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_HAProxyLoadBalancerConfigSpec_To_v1alpha1_HAProxyLoadBalancerConfigSpec(in *v1alpha2.HAProxyLoadBalancerConfigSpec, out *HAProxyLoadBalancerConfigSpec, s conversion.Scope) error {
+	out.EndPointURLs = *(*[]string)(unsafe.Pointer(&in.EndPointURLs))
+	out.ServerName = in.ServerName
+	if err := Convert_v1alpha2_ClientSecretReference_To_v1alpha1_ClientSecretReference(&in.CredentialSecretRef, &out.CredentialSecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_HAProxyLoadBalancerConfigSpec_To_v1alpha1_HAProxyLoadBalancerConfigSpec is an autogenerated conversion function.
+func Convert_v1alpha2_HAProxyLoadBalancerConfigSpec_To_v1alpha1_HAProxyLoadBalancerConfigSpec(in *v1alpha2.HAProxyLoadBalancerConfigSpec, out *HAProxyLoadBalancerConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_HAProxyLoadBalancerConfigSpec_To_v1alpha1_HAProxyLoadBalancerConfigSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_HAProxyLoadBalancerConfigStatus_To_v1alpha2_HAProxyLoadBalancerConfigStatus(in *HAProxyLoadBalancerConfigStatus, out *v1alpha2.HAProxyLoadBalancerConfigStatus, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_HAProxyLoadBalancerConfigStatus_To_v1alpha2_HAProxyLoadBalancerConfigStatus is an autogenerated conversion function.
+func Convert_v1alpha1_HAProxyLoadBalancerConfigStatus_To_v1alpha2_HAProxyLoadBalancerConfigStatus(in *HAProxyLoadBalancerConfigStatus, out *v1alpha2.HAProxyLoadBalancerConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HAProxyLoadBalancerConfigStatus_To_v1alpha2_HAProxyLoadBalancerConfigStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_HAProxyLoadBalancerConfigStatus_To_v1alpha1_HAProxyLoadBalancerConfigStatus(in *v1alpha2.HAProxyLoadBalancerConfigStatus, out *HAProxyLoadBalancerConfigStatus, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha2_HAProxyLoadBalancerConfigStatus_To_v1alpha1_HAProxyLoadBalancerConfigStatus is an autogenerated conversion function.
+func Convert_v1alpha2_HAProxyLoadBalancerConfigStatus_To_v1alpha1_HAProxyLoadBalancerConfigStatus(in *v1alpha2.HAProxyLoadBalancerConfigStatus, out *HAProxyLoadBalancerConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_HAProxyLoadBalancerConfigStatus_To_v1alpha1_HAProxyLoadBalancerConfigStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPAddressAllocation_To_v1alpha2_IPAddressAllocation(in *IPAddressAllocation, out *v1alpha2.IPAddressAllocation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_IPAddressAllocationSpec_To_v1alpha2_IPAddressAllocationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_IPAddressAllocationStatus_To_v1alpha2_IPAddressAllocationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha2_IPAddressAllocation_To_v1alpha1_IPAddressAllocation(in *v1alpha2.IPAddressAllocation, out *IPAddressAllocation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_IPAddressAllocationSpec_To_v1alpha1_IPAddressAllocationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_IPAddressAllocationStatus_To_v1alpha1_IPAddressAllocationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_IPAddressAllocationList_To_v1alpha2_IPAddressAllocationList(in *IPAddressAllocationList, out *v1alpha2.IPAddressAllocationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha2.IPAddressAllocation, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_IPAddressAllocation_To_v1alpha2_IPAddressAllocation(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_IPAddressAllocationList_To_v1alpha2_IPAddressAllocationList is an autogenerated conversion function.
+func Convert_v1alpha1_IPAddressAllocationList_To_v1alpha2_IPAddressAllocationList(in *IPAddressAllocationList, out *v1alpha2.IPAddressAllocationList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPAddressAllocationList_To_v1alpha2_IPAddressAllocationList(in, out, s)
+}
+
+func autoConvert_v1alpha2_IPAddressAllocationList_To_v1alpha1_IPAddressAllocationList(in *v1alpha2.IPAddressAllocationList, out *IPAddressAllocationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAddressAllocation, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_IPAddressAllocation_To_v1alpha1_IPAddressAllocation(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_IPAddressAllocationList_To_v1alpha1_IPAddressAllocationList is an autogenerated conversion function.
+func Convert_v1alpha2_IPAddressAllocationList_To_v1alpha1_IPAddressAllocationList(in *v1alpha2.IPAddressAllocationList, out *IPAddressAllocationList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_IPAddressAllocationList_To_v1alpha1_IPAddressAllocationList(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPAddressAllocationSpec_To_v1alpha2_IPAddressAllocationSpec(in *IPAddressAllocationSpec, out *v1alpha2.IPAddressAllocationSpec, s conversion.Scope) error {
+	out.PoolRef = in.PoolRef
+	out.RequestedIP = in.RequestedIP
+	return nil
+}
+
+// Convert_v1alpha1_IPAddressAllocationSpec_To_v1alpha2_IPAddressAllocationSpec is an autogenerated conversion function.
+func Convert_v1alpha1_IPAddressAllocationSpec_To_v1alpha2_IPAddressAllocationSpec(in *IPAddressAllocationSpec, out *v1alpha2.IPAddressAllocationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPAddressAllocationSpec_To_v1alpha2_IPAddressAllocationSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_IPAddressAllocationSpec_To_v1alpha1_IPAddressAllocationSpec(in *v1alpha2.IPAddressAllocationSpec, out *IPAddressAllocationSpec, s conversion.Scope) error {
+	out.PoolRef = in.PoolRef
+	out.RequestedIP = in.RequestedIP
+	return nil
+}
+
+// Convert_v1alpha2_IPAddressAllocationSpec_To_v1alpha1_IPAddressAllocationSpec is an autogenerated conversion function.
+func Convert_v1alpha2_IPAddressAllocationSpec_To_v1alpha1_IPAddressAllocationSpec(in *v1alpha2.IPAddressAllocationSpec, out *IPAddressAllocationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_IPAddressAllocationSpec_To_v1alpha1_IPAddressAllocationSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPAddressAllocationStatus_To_v1alpha2_IPAddressAllocationStatus(in *IPAddressAllocationStatus, out *v1alpha2.IPAddressAllocationStatus, s conversion.Scope) error {
+	out.IPAddress = in.IPAddress
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_IPAddressAllocationCondition_To_v1_Condition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_IPAddressAllocationStatus_To_v1alpha2_IPAddressAllocationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_IPAddressAllocationStatus_To_v1alpha2_IPAddressAllocationStatus(in *IPAddressAllocationStatus, out *v1alpha2.IPAddressAllocationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPAddressAllocationStatus_To_v1alpha2_IPAddressAllocationStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_IPAddressAllocationStatus_To_v1alpha1_IPAddressAllocationStatus(in *v1alpha2.IPAddressAllocationStatus, out *IPAddressAllocationStatus, s conversion.Scope) error {
+	out.IPAddress = in.IPAddress
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]IPAddressAllocationCondition, len(*in))
+		for i := range *in {
+			if err := Convert_v1_Condition_To_v1alpha1_IPAddressAllocationCondition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_IPAddressAllocationStatus_To_v1alpha1_IPAddressAllocationStatus is an autogenerated conversion function.
+func Convert_v1alpha2_IPAddressAllocationStatus_To_v1alpha1_IPAddressAllocationStatus(in *v1alpha2.IPAddressAllocationStatus, out *IPAddressAllocationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_IPAddressAllocationStatus_To_v1alpha1_IPAddressAllocationStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPConfig_To_v1alpha2_IPConfig(in *IPConfig, out *v1alpha2.IPConfig, s conversion.Scope) error {
+	out.IP = in.IP
+	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.Gateway = in.Gateway
+	out.SubnetMask = in.SubnetMask
+	out.Prefix = (*int32)(unsafe.Pointer(in.Prefix))
+	return nil
+}
+
+// Convert_v1alpha1_IPConfig_To_v1alpha2_IPConfig is an autogenerated conversion function.
+func Convert_v1alpha1_IPConfig_To_v1alpha2_IPConfig(in *IPConfig, out *v1alpha2.IPConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPConfig_To_v1alpha2_IPConfig(in, out, s)
+}
+
+func autoConvert_v1alpha2_IPConfig_To_v1alpha1_IPConfig(in *v1alpha2.IPConfig, out *IPConfig, s conversion.Scope) error {
+	out.IP = in.IP
+	out.IPFamily = corev1.IPFamily(in.IPFamily)
+	out.Gateway = in.Gateway
+	out.SubnetMask = in.SubnetMask
+	out.Prefix = (*int32)(unsafe.Pointer(in.Prefix))
+	return nil
+}
+
+// Convert_v1alpha2_IPConfig_To_v1alpha1_IPConfig is an autogenerated conversion function.
+func Convert_v1alpha2_IPConfig_To_v1alpha1_IPConfig(in *v1alpha2.IPConfig, out *IPConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_IPConfig_To_v1alpha1_IPConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPPool_To_v1alpha2_IPPool(in *IPPool, out *v1alpha2.IPPool, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_IPPoolSpec_To_v1alpha2_IPPoolSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_IPPoolStatus_To_v1alpha2_IPPoolStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha2_IPPool_To_v1alpha1_IPPool(in *v1alpha2.IPPool, out *IPPool, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_IPPoolSpec_To_v1alpha1_IPPoolSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_IPPoolStatus_To_v1alpha1_IPPoolStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_IPPoolList_To_v1alpha2_IPPoolList(in *IPPoolList, out *v1alpha2.IPPoolList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha2.IPPool, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_IPPool_To_v1alpha2_IPPool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_IPPoolList_To_v1alpha2_IPPoolList is an autogenerated conversion function.
+func Convert_v1alpha1_IPPoolList_To_v1alpha2_IPPoolList(in *IPPoolList, out *v1alpha2.IPPoolList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPPoolList_To_v1alpha2_IPPoolList(in, out, s)
+}
+
+func autoConvert_v1alpha2_IPPoolList_To_v1alpha1_IPPoolList(in *v1alpha2.IPPoolList, out *IPPoolList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPPool, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_IPPool_To_v1alpha1_IPPool(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_IPPoolList_To_v1alpha1_IPPoolList is an autogenerated conversion function.
+func Convert_v1alpha2_IPPoolList_To_v1alpha1_IPPoolList(in *v1alpha2.IPPoolList, out *IPPoolList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_IPPoolList_To_v1alpha1_IPPoolList(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPPoolPressurePolicy_To_v1alpha2_IPPoolPressurePolicy(in *IPPoolPressurePolicy, out *v1alpha2.IPPoolPressurePolicy, s conversion.Scope) error {
+	out.MinFreeIPs = in.MinFreeIPs
+	out.MinFreePercent = in.MinFreePercent
+	return nil
+}
+
+// Convert_v1alpha1_IPPoolPressurePolicy_To_v1alpha2_IPPoolPressurePolicy is an autogenerated conversion function.
+func Convert_v1alpha1_IPPoolPressurePolicy_To_v1alpha2_IPPoolPressurePolicy(in *IPPoolPressurePolicy, out *v1alpha2.IPPoolPressurePolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPPoolPressurePolicy_To_v1alpha2_IPPoolPressurePolicy(in, out, s)
+}
+
+func autoConvert_v1alpha2_IPPoolPressurePolicy_To_v1alpha1_IPPoolPressurePolicy(in *v1alpha2.IPPoolPressurePolicy, out *IPPoolPressurePolicy, s conversion.Scope) error {
+	out.MinFreeIPs = in.MinFreeIPs
+	out.MinFreePercent = in.MinFreePercent
+	return nil
+}
+
+// Convert_v1alpha2_IPPoolPressurePolicy_To_v1alpha1_IPPoolPressurePolicy is an autogenerated conversion function.
+func Convert_v1alpha2_IPPoolPressurePolicy_To_v1alpha1_IPPoolPressurePolicy(in *v1alpha2.IPPoolPressurePolicy, out *IPPoolPressurePolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha2_IPPoolPressurePolicy_To_v1alpha1_IPPoolPressurePolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPPoolReference_To_v1alpha2_IPPoolReference(in *IPPoolReference, out *v1alpha2.IPPoolReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.APIVersion = in.APIVersion
+	return nil
+}
+
+// Convert_v1alpha1_IPPoolReference_To_v1alpha2_IPPoolReference is an autogenerated conversion function.
+func Convert_v1alpha1_IPPoolReference_To_v1alpha2_IPPoolReference(in *IPPoolReference, out *v1alpha2.IPPoolReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPPoolReference_To_v1alpha2_IPPoolReference(in, out, s)
+}
+
+func autoConvert_v1alpha2_IPPoolReference_To_v1alpha1_IPPoolReference(in *v1alpha2.IPPoolReference, out *IPPoolReference, s conversion.Scope) error {
+	out.Name = in.Name
+	out.APIVersion = in.APIVersion
+	return nil
+}
+
+// Convert_v1alpha2_IPPoolReference_To_v1alpha1_IPPoolReference is an autogenerated conversion function.
+func Convert_v1alpha2_IPPoolReference_To_v1alpha1_IPPoolReference(in *v1alpha2.IPPoolReference, out *IPPoolReference, s conversion.Scope) error {
+	return autoConvert_v1alpha2_IPPoolReference_To_v1alpha1_IPPoolReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPPoolSpec_To_v1alpha2_IPPoolSpec(in *IPPoolSpec, out *v1alpha2.IPPoolSpec, s conversion.Scope) error {
+	out.StartingAddress = in.StartingAddress
+	out.AddressCount = in.AddressCount
+	return nil
+}
+
+// Convert_v1alpha1_IPPoolSpec_To_v1alpha2_IPPoolSpec is an autogenerated conversion function.
+func Convert_v1alpha1_IPPoolSpec_To_v1alpha2_IPPoolSpec(in *IPPoolSpec, out *v1alpha2.IPPoolSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPPoolSpec_To_v1alpha2_IPPoolSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_IPPoolSpec_To_v1alpha1_IPPoolSpec(in *v1alpha2.IPPoolSpec, out *IPPoolSpec, s conversion.Scope) error {
+	out.StartingAddress = in.StartingAddress
+	out.AddressCount = in.AddressCount
+	return nil
+}
+
+// Convert_v1alpha2_IPPoolSpec_To_v1alpha1_IPPoolSpec is an autogenerated conversion function.
+func Convert_v1alpha2_IPPoolSpec_To_v1alpha1_IPPoolSpec(in *v1alpha2.IPPoolSpec, out *IPPoolSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_IPPoolSpec_To_v1alpha1_IPPoolSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPPoolStatus_To_v1alpha2_IPPoolStatus(in *IPPoolStatus, out *v1alpha2.IPPoolStatus, s conversion.Scope) error {
+	out.Allocated = in.Allocated
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_IPPoolCondition_To_v1_Condition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_IPPoolStatus_To_v1alpha2_IPPoolStatus is an autogenerated conversion function.
+func Convert_v1alpha1_IPPoolStatus_To_v1alpha2_IPPoolStatus(in *IPPoolStatus, out *v1alpha2.IPPoolStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPPoolStatus_To_v1alpha2_IPPoolStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_IPPoolStatus_To_v1alpha1_IPPoolStatus(in *v1alpha2.IPPoolStatus, out *IPPoolStatus, s conversion.Scope) error {
+	out.Allocated = in.Allocated
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]IPPoolCondition, len(*in))
+		for i := range *in {
+			if err := Convert_v1_Condition_To_v1alpha1_IPPoolCondition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_IPPoolStatus_To_v1alpha1_IPPoolStatus is an autogenerated conversion function.
+func Convert_v1alpha2_IPPoolStatus_To_v1alpha1_IPPoolStatus(in *v1alpha2.IPPoolStatus, out *IPPoolStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_IPPoolStatus_To_v1alpha1_IPPoolStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPRange_To_v1alpha2_IPRange(in *IPRange, out *v1alpha2.IPRange, s conversion.Scope) error {
+	out.StartingAddress = in.StartingAddress
+	out.AddressCount = in.AddressCount
+	return nil
+}
+
+// Convert_v1alpha1_IPRange_To_v1alpha2_IPRange is an autogenerated conversion function.
+func Convert_v1alpha1_IPRange_To_v1alpha2_IPRange(in *IPRange, out *v1alpha2.IPRange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPRange_To_v1alpha2_IPRange(in, out, s)
+}
+
+func autoConvert_v1alpha2_IPRange_To_v1alpha1_IPRange(in *v1alpha2.IPRange, out *IPRange, s conversion.Scope) error {
+	out.StartingAddress = in.StartingAddress
+	out.AddressCount = in.AddressCount
+	return nil
+}
+
+// Convert_v1alpha2_IPRange_To_v1alpha1_IPRange is an autogenerated conversion function.
+func Convert_v1alpha2_IPRange_To_v1alpha1_IPRange(in *v1alpha2.IPRange, out *IPRange, s conversion.Scope) error {
+	return autoConvert_v1alpha2_IPRange_To_v1alpha1_IPRange(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerConfig_To_v1alpha2_LoadBalancerConfig(in *LoadBalancerConfig, out *v1alpha2.LoadBalancerConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_LoadBalancerConfigSpec_To_v1alpha2_LoadBalancerConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_LoadBalancerConfigStatus_To_v1alpha2_LoadBalancerConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha2_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *v1alpha2.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_LoadBalancerConfigSpec_To_v1alpha1_LoadBalancerConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_LoadBalancerConfigStatus_To_v1alpha1_LoadBalancerConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_LoadBalancerConfigList_To_v1alpha2_LoadBalancerConfigList(in *LoadBalancerConfigList, out *v1alpha2.LoadBalancerConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha2.LoadBalancerConfig, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_LoadBalancerConfig_To_v1alpha2_LoadBalancerConfig(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerConfigList_To_v1alpha2_LoadBalancerConfigList is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerConfigList_To_v1alpha2_LoadBalancerConfigList(in *LoadBalancerConfigList, out *v1alpha2.LoadBalancerConfigList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerConfigList_To_v1alpha2_LoadBalancerConfigList(in, out, s)
+}
+
+func autoConvert_v1alpha2_LoadBalancerConfigList_To_v1alpha1_LoadBalancerConfigList(in *v1alpha2.LoadBalancerConfigList, out *LoadBalancerConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoadBalancerConfig, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_LoadBalancerConfigList_To_v1alpha1_LoadBalancerConfigList is an autogenerated conversion function.
+func Convert_v1alpha2_LoadBalancerConfigList_To_v1alpha1_LoadBalancerConfigList(in *v1alpha2.LoadBalancerConfigList, out *LoadBalancerConfigList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_LoadBalancerConfigList_To_v1alpha1_LoadBalancerConfigList(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerConfigProviderReference_To_v1alpha2_LoadBalancerConfigProviderReference(in *LoadBalancerConfigProviderReference, out *v1alpha2.LoadBalancerConfigProviderReference, s conversion.Scope) error {
+	out.APIGroup = in.APIGroup
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.APIVersion = in.APIVersion
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerConfigProviderReference_To_v1alpha2_LoadBalancerConfigProviderReference is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerConfigProviderReference_To_v1alpha2_LoadBalancerConfigProviderReference(in *LoadBalancerConfigProviderReference, out *v1alpha2.LoadBalancerConfigProviderReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerConfigProviderReference_To_v1alpha2_LoadBalancerConfigProviderReference(in, out, s)
+}
+
+func autoConvert_v1alpha2_LoadBalancerConfigProviderReference_To_v1alpha1_LoadBalancerConfigProviderReference(in *v1alpha2.LoadBalancerConfigProviderReference, out *LoadBalancerConfigProviderReference, s conversion.Scope) error {
+	out.APIGroup = in.APIGroup
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.APIVersion = in.APIVersion
+	return nil
+}
+
+// Convert_v1alpha2_LoadBalancerConfigProviderReference_To_v1alpha1_LoadBalancerConfigProviderReference is an autogenerated conversion function.
+func Convert_v1alpha2_LoadBalancerConfigProviderReference_To_v1alpha1_LoadBalancerConfigProviderReference(in *v1alpha2.LoadBalancerConfigProviderReference, out *LoadBalancerConfigProviderReference, s conversion.Scope) error {
+	return autoConvert_v1alpha2_LoadBalancerConfigProviderReference_To_v1alpha1_LoadBalancerConfigProviderReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerConfigSpec_To_v1alpha2_LoadBalancerConfigSpec(in *LoadBalancerConfigSpec, out *v1alpha2.LoadBalancerConfigSpec, s conversion.Scope) error {
+	out.Type = v1alpha2.LoadBalancerConfigType(in.Type)
+	if err := Convert_v1alpha1_LoadBalancerConfigProviderReference_To_v1alpha2_LoadBalancerConfigProviderReference(&in.ProviderRef, &out.ProviderRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerConfigSpec_To_v1alpha2_LoadBalancerConfigSpec is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerConfigSpec_To_v1alpha2_LoadBalancerConfigSpec(in *LoadBalancerConfigSpec, out *v1alpha2.LoadBalancerConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerConfigSpec_To_v1alpha2_LoadBalancerConfigSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_LoadBalancerConfigSpec_To_v1alpha1_LoadBalancerConfigSpec(in *v1alpha2.LoadBalancerConfigSpec, out *LoadBalancerConfigSpec, s conversion.Scope) error {
+	out.Type = LoadBalancerConfigType(in.Type)
+	if err := Convert_v1alpha2_LoadBalancerConfigProviderReference_To_v1alpha1_LoadBalancerConfigProviderReference(&in.ProviderRef, &out.ProviderRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_LoadBalancerConfigSpec_To_v1alpha1_LoadBalancerConfigSpec is an autogenerated conversion function.
+func Convert_v1alpha2_LoadBalancerConfigSpec_To_v1alpha1_LoadBalancerConfigSpec(in *v1alpha2.LoadBalancerConfigSpec, out *LoadBalancerConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_LoadBalancerConfigSpec_To_v1alpha1_LoadBalancerConfigSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerConfigStatus_To_v1alpha2_LoadBalancerConfigStatus(in *LoadBalancerConfigStatus, out *v1alpha2.LoadBalancerConfigStatus, s conversion.Scope) error {
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_LoadBalancerConfigCondition_To_v1_Condition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerConfigStatus_To_v1alpha2_LoadBalancerConfigStatus is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerConfigStatus_To_v1alpha2_LoadBalancerConfigStatus(in *LoadBalancerConfigStatus, out *v1alpha2.LoadBalancerConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerConfigStatus_To_v1alpha2_LoadBalancerConfigStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_LoadBalancerConfigStatus_To_v1alpha1_LoadBalancerConfigStatus(in *v1alpha2.LoadBalancerConfigStatus, out *LoadBalancerConfigStatus, s conversion.Scope) error {
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]LoadBalancerConfigCondition, len(*in))
+		for i := range *in {
+			if err := Convert_v1_Condition_To_v1alpha1_LoadBalancerConfigCondition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_LoadBalancerConfigStatus_To_v1alpha1_LoadBalancerConfigStatus is an autogenerated conversion function.
+func Convert_v1alpha2_LoadBalancerConfigStatus_To_v1alpha1_LoadBalancerConfigStatus(in *v1alpha2.LoadBalancerConfigStatus, out *LoadBalancerConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_LoadBalancerConfigStatus_To_v1alpha1_LoadBalancerConfigStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_MacLearningPolicy_To_v1alpha2_MacLearningPolicy(in *MacLearningPolicy, out *v1alpha2.MacLearningPolicy, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.AllowUnicastFlooding = (*bool)(unsafe.Pointer(in.AllowUnicastFlooding))
+	out.Limit = (*int32)(unsafe.Pointer(in.Limit))
+	out.LimitPolicy = (*v1alpha2.MacLimitPolicyType)(unsafe.Pointer(in.LimitPolicy))
+	return nil
+}
+
+// Convert_v1alpha1_MacLearningPolicy_To_v1alpha2_MacLearningPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_MacLearningPolicy_To_v1alpha2_MacLearningPolicy(in *MacLearningPolicy, out *v1alpha2.MacLearningPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MacLearningPolicy_To_v1alpha2_MacLearningPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha2_MacLearningPolicy_To_v1alpha1_MacLearningPolicy(in *v1alpha2.MacLearningPolicy, out *MacLearningPolicy, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.AllowUnicastFlooding = (*bool)(unsafe.Pointer(in.AllowUnicastFlooding))
+	out.Limit = (*int32)(unsafe.Pointer(in.Limit))
+	out.LimitPolicy = (*MacLimitPolicyType)(unsafe.Pointer(in.LimitPolicy))
+	return nil
+}
+
+// Convert_v1alpha2_MacLearningPolicy_To_v1alpha1_MacLearningPolicy is an autogenerated conversion function.
+func Convert_v1alpha2_MacLearningPolicy_To_v1alpha1_MacLearningPolicy(in *v1alpha2.MacLearningPolicy, out *MacLearningPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha2_MacLearningPolicy_To_v1alpha1_MacLearningPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_MacManagementPolicy_To_v1alpha2_MacManagementPolicy(in *MacManagementPolicy, out *v1alpha2.MacManagementPolicy, s conversion.Scope) error {
+	out.AllowPromiscuous = (*bool)(unsafe.Pointer(in.AllowPromiscuous))
+	out.MacChanges = (*bool)(unsafe.Pointer(in.MacChanges))
+	out.ForgedTransmits = (*bool)(unsafe.Pointer(in.ForgedTransmits))
+	out.MacLearningPolicy = (*v1alpha2.MacLearningPolicy)(unsafe.Pointer(in.MacLearningPolicy))
+	return nil
+}
+
+// Convert_v1alpha1_MacManagementPolicy_To_v1alpha2_MacManagementPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_MacManagementPolicy_To_v1alpha2_MacManagementPolicy(in *MacManagementPolicy, out *v1alpha2.MacManagementPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MacManagementPolicy_To_v1alpha2_MacManagementPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha2_MacManagementPolicy_To_v1alpha1_MacManagementPolicy(in *v1alpha2.MacManagementPolicy, out *MacManagementPolicy, s conversion.Scope) error {
+	out.AllowPromiscuous = (*bool)(unsafe.Pointer(in.AllowPromiscuous))
+	out.MacChanges = (*bool)(unsafe.Pointer(in.MacChanges))
+	out.ForgedTransmits = (*bool)(unsafe.Pointer(in.ForgedTransmits))
+	out.MacLearningPolicy = (*MacLearningPolicy)(unsafe.Pointer(in.MacLearningPolicy))
+	return nil
+}
+
+// Convert_v1alpha2_MacManagementPolicy_To_v1alpha1_MacManagementPolicy is an autogenerated conversion function.
+func Convert_v1alpha2_MacManagementPolicy_To_v1alpha1_MacManagementPolicy(in *v1alpha2.MacManagementPolicy, out *MacManagementPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha2_MacManagementPolicy_To_v1alpha1_MacManagementPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_NSXTier1Config_To_v1alpha2_NSXTier1Config(in *NSXTier1Config, out *v1alpha2.NSXTier1Config, s conversion.Scope) error {
+	out.NamespaceCIDRs = *(*[]string)(unsafe.Pointer(&in.NamespaceCIDRs))
+	out.IngressCIDRs = *(*[]string)(unsafe.Pointer(&in.IngressCIDRs))
+	out.EgressCIDRs = *(*[]string)(unsafe.Pointer(&in.EgressCIDRs))
+	out.Tier0Gateway = in.Tier0Gateway
+	out.SubnetPrefixLength = in.SubnetPrefixLength
+	out.RoutingMode = v1alpha2.NSXTier1RoutingMode(in.RoutingMode)
+	out.LoadBalancerSize = v1alpha2.NSXLoadBalancerSize(in.LoadBalancerSize)
+	return nil
+}
+
+// Convert_v1alpha1_NSXTier1Config_To_v1alpha2_NSXTier1Config is an autogenerated conversion function.
+func Convert_v1alpha1_NSXTier1Config_To_v1alpha2_NSXTier1Config(in *NSXTier1Config, out *v1alpha2.NSXTier1Config, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NSXTier1Config_To_v1alpha2_NSXTier1Config(in, out, s)
+}
+
+func autoConvert_v1alpha2_NSXTier1Config_To_v1alpha1_NSXTier1Config(in *v1alpha2.NSXTier1Config, out *NSXTier1Config, s conversion.Scope) error {
+	out.NamespaceCIDRs = *(*[]string)(unsafe.Pointer(&in.NamespaceCIDRs))
+	out.IngressCIDRs = *(*[]string)(unsafe.Pointer(&in.IngressCIDRs))
+	out.EgressCIDRs = *(*[]string)(unsafe.Pointer(&in.EgressCIDRs))
+	out.Tier0Gateway = in.Tier0Gateway
+	out.SubnetPrefixLength = in.SubnetPrefixLength
+	out.RoutingMode = NSXTier1RoutingMode(in.RoutingMode)
+	out.LoadBalancerSize = NSXLoadBalancerSize(in.LoadBalancerSize)
+	return nil
+}
+
+// Convert_v1alpha2_NSXTier1Config_To_v1alpha1_NSXTier1Config is an autogenerated conversion function.
+func Convert_v1alpha2_NSXTier1Config_To_v1alpha1_NSXTier1Config(in *v1alpha2.NSXTier1Config, out *NSXTier1Config, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NSXTier1Config_To_v1alpha1_NSXTier1Config(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamespaceNetworkAssociation_To_v1alpha2_NamespaceNetworkAssociation(in *NamespaceNetworkAssociation, out *v1alpha2.NamespaceNetworkAssociation, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Status = v1alpha2.NamespaceNetworkReconciliationStatus(in.Status)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_NamespaceNetworkAssociation_To_v1alpha2_NamespaceNetworkAssociation is an autogenerated conversion function.
+func Convert_v1alpha1_NamespaceNetworkAssociation_To_v1alpha2_NamespaceNetworkAssociation(in *NamespaceNetworkAssociation, out *v1alpha2.NamespaceNetworkAssociation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamespaceNetworkAssociation_To_v1alpha2_NamespaceNetworkAssociation(in, out, s)
+}
+
+func autoConvert_v1alpha2_NamespaceNetworkAssociation_To_v1alpha1_NamespaceNetworkAssociation(in *v1alpha2.NamespaceNetworkAssociation, out *NamespaceNetworkAssociation, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Status = NamespaceNetworkReconciliationStatus(in.Status)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha2_NamespaceNetworkAssociation_To_v1alpha1_NamespaceNetworkAssociation is an autogenerated conversion function.
+func Convert_v1alpha2_NamespaceNetworkAssociation_To_v1alpha1_NamespaceNetworkAssociation(in *v1alpha2.NamespaceNetworkAssociation, out *NamespaceNetworkAssociation, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NamespaceNetworkAssociation_To_v1alpha1_NamespaceNetworkAssociation(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamespaceNetworkConfig_To_v1alpha2_NamespaceNetworkConfig(in *NamespaceNetworkConfig, out *v1alpha2.NamespaceNetworkConfig, s conversion.Scope) error {
+	if err := Convert_v1alpha1_VSphereDistributedConfig_To_v1alpha2_VSphereDistributedConfig(&in.VSphereDistributedConfig, &out.VSphereDistributedConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_VPCConfig_To_v1alpha2_VPCConfig(&in.VPCConfig, &out.VPCConfig, s); err != nil {
+		return err
+	}
+	out.NSXTier1Config = (*v1alpha2.NSXTier1Config)(unsafe.Pointer(in.NSXTier1Config))
+	return nil
+}
+
+// Convert_v1alpha1_NamespaceNetworkConfig_To_v1alpha2_NamespaceNetworkConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NamespaceNetworkConfig_To_v1alpha2_NamespaceNetworkConfig(in *NamespaceNetworkConfig, out *v1alpha2.NamespaceNetworkConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamespaceNetworkConfig_To_v1alpha2_NamespaceNetworkConfig(in, out, s)
+}
+
+func autoConvert_v1alpha2_NamespaceNetworkConfig_To_v1alpha1_NamespaceNetworkConfig(in *v1alpha2.NamespaceNetworkConfig, out *NamespaceNetworkConfig, s conversion.Scope) error {
+	if err := Convert_v1alpha2_VSphereDistributedConfig_To_v1alpha1_VSphereDistributedConfig(&in.VSphereDistributedConfig, &out.VSphereDistributedConfig, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_VPCConfig_To_v1alpha1_VPCConfig(&in.VPCConfig, &out.VPCConfig, s); err != nil {
+		return err
+	}
+	out.NSXTier1Config = (*NSXTier1Config)(unsafe.Pointer(in.NSXTier1Config))
+	return nil
+}
+
+// Convert_v1alpha2_NamespaceNetworkConfig_To_v1alpha1_NamespaceNetworkConfig is an autogenerated conversion function.
+func Convert_v1alpha2_NamespaceNetworkConfig_To_v1alpha1_NamespaceNetworkConfig(in *v1alpha2.NamespaceNetworkConfig, out *NamespaceNetworkConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NamespaceNetworkConfig_To_v1alpha1_NamespaceNetworkConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamespaceNetworkConfiguration_To_v1alpha2_NamespaceNetworkConfiguration(in *NamespaceNetworkConfiguration, out *v1alpha2.NamespaceNetworkConfiguration, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_NamespaceNetworkSpec_To_v1alpha2_NamespaceNetworkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	out.Status = (*v1alpha2.NamespaceNetworkStatus)(unsafe.Pointer(in.Status))
+	return nil
+}
+
+// Convert_v1alpha1_NamespaceNetworkConfiguration_To_v1alpha2_NamespaceNetworkConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_NamespaceNetworkConfiguration_To_v1alpha2_NamespaceNetworkConfiguration(in *NamespaceNetworkConfiguration, out *v1alpha2.NamespaceNetworkConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamespaceNetworkConfiguration_To_v1alpha2_NamespaceNetworkConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha2_NamespaceNetworkConfiguration_To_v1alpha1_NamespaceNetworkConfiguration(in *v1alpha2.NamespaceNetworkConfiguration, out *NamespaceNetworkConfiguration, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_NamespaceNetworkSpec_To_v1alpha1_NamespaceNetworkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	out.Status = (*NamespaceNetworkStatus)(unsafe.Pointer(in.Status))
+	return nil
+}
+
+// Convert_v1alpha2_NamespaceNetworkConfiguration_To_v1alpha1_NamespaceNetworkConfiguration is an autogenerated conversion function.
+func Convert_v1alpha2_NamespaceNetworkConfiguration_To_v1alpha1_NamespaceNetworkConfiguration(in *v1alpha2.NamespaceNetworkConfiguration, out *NamespaceNetworkConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NamespaceNetworkConfiguration_To_v1alpha1_NamespaceNetworkConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamespaceNetworkConfigurationList_To_v1alpha2_NamespaceNetworkConfigurationList(in *NamespaceNetworkConfigurationList, out *v1alpha2.NamespaceNetworkConfigurationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha2.NamespaceNetworkConfiguration)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_NamespaceNetworkConfigurationList_To_v1alpha2_NamespaceNetworkConfigurationList is an autogenerated conversion function.
+func Convert_v1alpha1_NamespaceNetworkConfigurationList_To_v1alpha2_NamespaceNetworkConfigurationList(in *NamespaceNetworkConfigurationList, out *v1alpha2.NamespaceNetworkConfigurationList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamespaceNetworkConfigurationList_To_v1alpha2_NamespaceNetworkConfigurationList(in, out, s)
+}
+
+func autoConvert_v1alpha2_NamespaceNetworkConfigurationList_To_v1alpha1_NamespaceNetworkConfigurationList(in *v1alpha2.NamespaceNetworkConfigurationList, out *NamespaceNetworkConfigurationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]NamespaceNetworkConfiguration)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha2_NamespaceNetworkConfigurationList_To_v1alpha1_NamespaceNetworkConfigurationList is an autogenerated conversion function.
+func Convert_v1alpha2_NamespaceNetworkConfigurationList_To_v1alpha1_NamespaceNetworkConfigurationList(in *v1alpha2.NamespaceNetworkConfigurationList, out *NamespaceNetworkConfigurationList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NamespaceNetworkConfigurationList_To_v1alpha1_NamespaceNetworkConfigurationList(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamespaceNetworkSpec_To_v1alpha2_NamespaceNetworkSpec(in *NamespaceNetworkSpec, out *v1alpha2.NamespaceNetworkSpec, s conversion.Scope) error {
+	out.Type = v1alpha2.NetworkProvider(in.Type)
+	if err := Convert_v1alpha1_NamespaceNetworkConfig_To_v1alpha2_NamespaceNetworkConfig(&in.NamespaceNetworkConfig, &out.NamespaceNetworkConfig, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_NamespaceNetworkSpec_To_v1alpha2_NamespaceNetworkSpec is an autogenerated conversion function.
+func Convert_v1alpha1_NamespaceNetworkSpec_To_v1alpha2_NamespaceNetworkSpec(in *NamespaceNetworkSpec, out *v1alpha2.NamespaceNetworkSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamespaceNetworkSpec_To_v1alpha2_NamespaceNetworkSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_NamespaceNetworkSpec_To_v1alpha1_NamespaceNetworkSpec(in *v1alpha2.NamespaceNetworkSpec, out *NamespaceNetworkSpec, s conversion.Scope) error {
+	out.Type = NetworkProvider(in.Type)
+	if err := Convert_v1alpha2_NamespaceNetworkConfig_To_v1alpha1_NamespaceNetworkConfig(&in.NamespaceNetworkConfig, &out.NamespaceNetworkConfig, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_NamespaceNetworkSpec_To_v1alpha1_NamespaceNetworkSpec is an autogenerated conversion function.
+func Convert_v1alpha2_NamespaceNetworkSpec_To_v1alpha1_NamespaceNetworkSpec(in *v1alpha2.NamespaceNetworkSpec, out *NamespaceNetworkSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NamespaceNetworkSpec_To_v1alpha1_NamespaceNetworkSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamespaceNetworkStatus_To_v1alpha2_NamespaceNetworkStatus(in *NamespaceNetworkStatus, out *v1alpha2.NamespaceNetworkStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.AssociatedNamespaces = *(*[]v1alpha2.NamespaceNetworkAssociation)(unsafe.Pointer(&in.AssociatedNamespaces))
+	return nil
+}
+
+// Convert_v1alpha1_NamespaceNetworkStatus_To_v1alpha2_NamespaceNetworkStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NamespaceNetworkStatus_To_v1alpha2_NamespaceNetworkStatus(in *NamespaceNetworkStatus, out *v1alpha2.NamespaceNetworkStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamespaceNetworkStatus_To_v1alpha2_NamespaceNetworkStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_NamespaceNetworkStatus_To_v1alpha1_NamespaceNetworkStatus(in *v1alpha2.NamespaceNetworkStatus, out *NamespaceNetworkStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	out.AssociatedNamespaces = *(*[]NamespaceNetworkAssociation)(unsafe.Pointer(&in.AssociatedNamespaces))
+	return nil
+}
+
+// Convert_v1alpha2_NamespaceNetworkStatus_To_v1alpha1_NamespaceNetworkStatus is an autogenerated conversion function.
+func Convert_v1alpha2_NamespaceNetworkStatus_To_v1alpha1_NamespaceNetworkStatus(in *v1alpha2.NamespaceNetworkStatus, out *NamespaceNetworkStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NamespaceNetworkStatus_To_v1alpha1_NamespaceNetworkStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_Network_To_v1alpha2_Network(in *Network, out *v1alpha2.Network, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_NetworkSpec_To_v1alpha2_NetworkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_NetworkStatus_To_v1alpha2_NetworkStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha2_Network_To_v1alpha1_Network(in *v1alpha2.Network, out *Network, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_NetworkSpec_To_v1alpha1_NetworkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_NetworkStatus_To_v1alpha1_NetworkStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_NetworkInterface_To_v1alpha2_NetworkInterface(in *NetworkInterface, out *v1alpha2.NetworkInterface, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_NetworkInterfaceSpec_To_v1alpha2_NetworkInterfaceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_NetworkInterfaceStatus_To_v1alpha2_NetworkInterfaceStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha2_NetworkInterface_To_v1alpha1_NetworkInterface(in *v1alpha2.NetworkInterface, out *NetworkInterface, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_NetworkInterfaceSpec_To_v1alpha1_NetworkInterfaceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_NetworkInterfaceList_To_v1alpha2_NetworkInterfaceList(in *NetworkInterfaceList, out *v1alpha2.NetworkInterfaceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha2.NetworkInterface, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_NetworkInterface_To_v1alpha2_NetworkInterface(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterfaceList_To_v1alpha2_NetworkInterfaceList is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkInterfaceList_To_v1alpha2_NetworkInterfaceList(in *NetworkInterfaceList, out *v1alpha2.NetworkInterfaceList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkInterfaceList_To_v1alpha2_NetworkInterfaceList(in, out, s)
+}
+
+func autoConvert_v1alpha2_NetworkInterfaceList_To_v1alpha1_NetworkInterfaceList(in *v1alpha2.NetworkInterfaceList, out *NetworkInterfaceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_NetworkInterface_To_v1alpha1_NetworkInterface(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_NetworkInterfaceList_To_v1alpha1_NetworkInterfaceList is an autogenerated conversion function.
+func Convert_v1alpha2_NetworkInterfaceList_To_v1alpha1_NetworkInterfaceList(in *v1alpha2.NetworkInterfaceList, out *NetworkInterfaceList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NetworkInterfaceList_To_v1alpha1_NetworkInterfaceList(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkInterfacePortAllocation_To_v1alpha2_NetworkInterfacePortAllocation(in *NetworkInterfacePortAllocation, out *v1alpha2.NetworkInterfacePortAllocation, s conversion.Scope) error {
+	out.NodeName = in.NodeName
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterfacePortAllocation_To_v1alpha2_NetworkInterfacePortAllocation is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkInterfacePortAllocation_To_v1alpha2_NetworkInterfacePortAllocation(in *NetworkInterfacePortAllocation, out *v1alpha2.NetworkInterfacePortAllocation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkInterfacePortAllocation_To_v1alpha2_NetworkInterfacePortAllocation(in, out, s)
+}
+
+func autoConvert_v1alpha2_NetworkInterfacePortAllocation_To_v1alpha1_NetworkInterfacePortAllocation(in *v1alpha2.NetworkInterfacePortAllocation, out *NetworkInterfacePortAllocation, s conversion.Scope) error {
+	out.NodeName = in.NodeName
+	return nil
+}
+
+// Convert_v1alpha2_NetworkInterfacePortAllocation_To_v1alpha1_NetworkInterfacePortAllocation is an autogenerated conversion function.
+func Convert_v1alpha2_NetworkInterfacePortAllocation_To_v1alpha1_NetworkInterfacePortAllocation(in *v1alpha2.NetworkInterfacePortAllocation, out *NetworkInterfacePortAllocation, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NetworkInterfacePortAllocation_To_v1alpha1_NetworkInterfacePortAllocation(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkInterfaceProviderReference_To_v1alpha2_NetworkInterfaceProviderReference(in *NetworkInterfaceProviderReference, out *v1alpha2.NetworkInterfaceProviderReference, s conversion.Scope) error {
+	out.APIGroup = in.APIGroup
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.APIVersion = in.APIVersion
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterfaceProviderReference_To_v1alpha2_NetworkInterfaceProviderReference is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkInterfaceProviderReference_To_v1alpha2_NetworkInterfaceProviderReference(in *NetworkInterfaceProviderReference, out *v1alpha2.NetworkInterfaceProviderReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkInterfaceProviderReference_To_v1alpha2_NetworkInterfaceProviderReference(in, out, s)
+}
+
+func autoConvert_v1alpha2_NetworkInterfaceProviderReference_To_v1alpha1_NetworkInterfaceProviderReference(in *v1alpha2.NetworkInterfaceProviderReference, out *NetworkInterfaceProviderReference, s conversion.Scope) error {
+	out.APIGroup = in.APIGroup
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.APIVersion = in.APIVersion
+	return nil
+}
+
+// Convert_v1alpha2_NetworkInterfaceProviderReference_To_v1alpha1_NetworkInterfaceProviderReference is an autogenerated conversion function.
+func Convert_v1alpha2_NetworkInterfaceProviderReference_To_v1alpha1_NetworkInterfaceProviderReference(in *v1alpha2.NetworkInterfaceProviderReference, out *NetworkInterfaceProviderReference, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NetworkInterfaceProviderReference_To_v1alpha1_NetworkInterfaceProviderReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkInterfaceReference_To_v1alpha2_NetworkInterfaceReference(in *NetworkInterfaceReference, out *v1alpha2.NetworkInterfaceReference, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.APIVersion = in.APIVersion
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterfaceReference_To_v1alpha2_NetworkInterfaceReference is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkInterfaceReference_To_v1alpha2_NetworkInterfaceReference(in *NetworkInterfaceReference, out *v1alpha2.NetworkInterfaceReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkInterfaceReference_To_v1alpha2_NetworkInterfaceReference(in, out, s)
+}
+
+func autoConvert_v1alpha2_NetworkInterfaceReference_To_v1alpha1_NetworkInterfaceReference(in *v1alpha2.NetworkInterfaceReference, out *NetworkInterfaceReference, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.APIVersion = in.APIVersion
+	return nil
+}
+
+// Convert_v1alpha2_NetworkInterfaceReference_To_v1alpha1_NetworkInterfaceReference is an autogenerated conversion function.
+func Convert_v1alpha2_NetworkInterfaceReference_To_v1alpha1_NetworkInterfaceReference(in *v1alpha2.NetworkInterfaceReference, out *NetworkInterfaceReference, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NetworkInterfaceReference_To_v1alpha1_NetworkInterfaceReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkInterfaceSpec_To_v1alpha2_NetworkInterfaceSpec(in *NetworkInterfaceSpec, out *v1alpha2.NetworkInterfaceSpec, s conversion.Scope) error {
+	out.NetworkName = in.NetworkName
+	out.Type = v1alpha2.NetworkInterfaceType(in.Type)
+	out.ProviderRef = (*v1alpha2.NetworkInterfaceProviderReference)(unsafe.Pointer(in.ProviderRef))
+	out.PortAllocation = (*v1alpha2.NetworkInterfacePortAllocation)(unsafe.Pointer(in.PortAllocation))
+	out.ExternalID = in.ExternalID
+	out.IPFamilyPolicy = v1alpha2.NetworkInterfaceIPFamilyPolicy(in.IPFamilyPolicy)
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterfaceSpec_To_v1alpha2_NetworkInterfaceSpec is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkInterfaceSpec_To_v1alpha2_NetworkInterfaceSpec(in *NetworkInterfaceSpec, out *v1alpha2.NetworkInterfaceSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkInterfaceSpec_To_v1alpha2_NetworkInterfaceSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_NetworkInterfaceSpec_To_v1alpha1_NetworkInterfaceSpec(in *v1alpha2.NetworkInterfaceSpec, out *NetworkInterfaceSpec, s conversion.Scope) error {
+	out.NetworkName = in.NetworkName
+	out.Type = NetworkInterfaceType(in.Type)
+	out.ProviderRef = (*NetworkInterfaceProviderReference)(unsafe.Pointer(in.ProviderRef))
+	out.PortAllocation = (*NetworkInterfacePortAllocation)(unsafe.Pointer(in.PortAllocation))
+	out.ExternalID = in.ExternalID
+	out.IPFamilyPolicy = NetworkInterfaceIPFamilyPolicy(in.IPFamilyPolicy)
+	return nil
+}
+
+// Convert_v1alpha2_NetworkInterfaceSpec_To_v1alpha1_NetworkInterfaceSpec is an autogenerated conversion function.
+func Convert_v1alpha2_NetworkInterfaceSpec_To_v1alpha1_NetworkInterfaceSpec(in *v1alpha2.NetworkInterfaceSpec, out *NetworkInterfaceSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NetworkInterfaceSpec_To_v1alpha1_NetworkInterfaceSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkInterfaceStatus_To_v1alpha2_NetworkInterfaceStatus(in *NetworkInterfaceStatus, out *v1alpha2.NetworkInterfaceStatus, s conversion.Scope) error {
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_NetworkInterfaceCondition_To_v1_Condition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.IPConfigs = *(*[]v1alpha2.IPConfig)(unsafe.Pointer(&in.IPConfigs))
+	out.MacAddress = in.MacAddress
+	out.ExternalID = in.ExternalID
+	out.NetworkID = in.NetworkID
+	out.PortID = in.PortID
+	out.ConnectionID = in.ConnectionID
+	out.IPAssignmentMode = v1alpha2.NetworkInterfaceIPAssignmentMode(in.IPAssignmentMode)
+	out.IPv6AssignmentMode = v1alpha2.NetworkInterfaceIPAssignmentMode(in.IPv6AssignmentMode)
+	return nil
+}
+
+// Convert_v1alpha1_NetworkInterfaceStatus_To_v1alpha2_NetworkInterfaceStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkInterfaceStatus_To_v1alpha2_NetworkInterfaceStatus(in *NetworkInterfaceStatus, out *v1alpha2.NetworkInterfaceStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkInterfaceStatus_To_v1alpha2_NetworkInterfaceStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStatus(in *v1alpha2.NetworkInterfaceStatus, out *NetworkInterfaceStatus, s conversion.Scope) error {
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]NetworkInterfaceCondition, len(*in))
+		for i := range *in {
+			if err := Convert_v1_Condition_To_v1alpha1_NetworkInterfaceCondition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.IPConfigs = *(*[]IPConfig)(unsafe.Pointer(&in.IPConfigs))
+	out.MacAddress = in.MacAddress
+	out.ExternalID = in.ExternalID
+	out.NetworkID = in.NetworkID
+	out.PortID = in.PortID
+	out.ConnectionID = in.ConnectionID
+	out.IPAssignmentMode = NetworkInterfaceIPAssignmentMode(in.IPAssignmentMode)
+	out.IPv6AssignmentMode = NetworkInterfaceIPAssignmentMode(in.IPv6AssignmentMode)
+	return nil
+}
+
+// Convert_v1alpha2_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStatus is an autogenerated conversion function.
+func Convert_v1alpha2_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStatus(in *v1alpha2.NetworkInterfaceStatus, out *NetworkInterfaceStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NetworkInterfaceStatus_To_v1alpha1_NetworkInterfaceStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkList_To_v1alpha2_NetworkList(in *NetworkList, out *v1alpha2.NetworkList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha2.Network, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Network_To_v1alpha2_Network(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_NetworkList_To_v1alpha2_NetworkList is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkList_To_v1alpha2_NetworkList(in *NetworkList, out *v1alpha2.NetworkList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkList_To_v1alpha2_NetworkList(in, out, s)
+}
+
+func autoConvert_v1alpha2_NetworkList_To_v1alpha1_NetworkList(in *v1alpha2.NetworkList, out *NetworkList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Network, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_Network_To_v1alpha1_Network(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_NetworkList_To_v1alpha1_NetworkList is an autogenerated conversion function.
+func Convert_v1alpha2_NetworkList_To_v1alpha1_NetworkList(in *v1alpha2.NetworkList, out *NetworkList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NetworkList_To_v1alpha1_NetworkList(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkProviderEntry_To_v1alpha2_NetworkProviderEntry(in *NetworkProviderEntry, out *v1alpha2.NetworkProviderEntry, s conversion.Scope) error {
+	out.Type = v1alpha2.NetworkProvider(in.Type)
+	out.SystemConfiguration = (*v1alpha2.NamespaceNetworkConfig)(unsafe.Pointer(in.SystemConfiguration))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkProviderEntry_To_v1alpha2_NetworkProviderEntry is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkProviderEntry_To_v1alpha2_NetworkProviderEntry(in *NetworkProviderEntry, out *v1alpha2.NetworkProviderEntry, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkProviderEntry_To_v1alpha2_NetworkProviderEntry(in, out, s)
+}
+
+func autoConvert_v1alpha2_NetworkProviderEntry_To_v1alpha1_NetworkProviderEntry(in *v1alpha2.NetworkProviderEntry, out *NetworkProviderEntry, s conversion.Scope) error {
+	out.Type = NetworkProvider(in.Type)
+	out.SystemConfiguration = (*NamespaceNetworkConfig)(unsafe.Pointer(in.SystemConfiguration))
+	return nil
+}
+
+// Convert_v1alpha2_NetworkProviderEntry_To_v1alpha1_NetworkProviderEntry is an autogenerated conversion function.
+func Convert_v1alpha2_NetworkProviderEntry_To_v1alpha1_NetworkProviderEntry(in *v1alpha2.NetworkProviderEntry, out *NetworkProviderEntry, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NetworkProviderEntry_To_v1alpha1_NetworkProviderEntry(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkProviderReference_To_v1alpha2_NetworkProviderReference(in *NetworkProviderReference, out *v1alpha2.NetworkProviderReference, s conversion.Scope) error {
+	out.APIGroup = in.APIGroup
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.APIVersion = in.APIVersion
+	return nil
+}
+
+// Convert_v1alpha1_NetworkProviderReference_To_v1alpha2_NetworkProviderReference is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkProviderReference_To_v1alpha2_NetworkProviderReference(in *NetworkProviderReference, out *v1alpha2.NetworkProviderReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkProviderReference_To_v1alpha2_NetworkProviderReference(in, out, s)
+}
+
+func autoConvert_v1alpha2_NetworkProviderReference_To_v1alpha1_NetworkProviderReference(in *v1alpha2.NetworkProviderReference, out *NetworkProviderReference, s conversion.Scope) error {
+	out.APIGroup = in.APIGroup
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.APIVersion = in.APIVersion
+	return nil
+}
+
+// Convert_v1alpha2_NetworkProviderReference_To_v1alpha1_NetworkProviderReference is an autogenerated conversion function.
+func Convert_v1alpha2_NetworkProviderReference_To_v1alpha1_NetworkProviderReference(in *v1alpha2.NetworkProviderReference, out *NetworkProviderReference, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NetworkProviderReference_To_v1alpha1_NetworkProviderReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkReference_To_v1alpha2_NetworkReference(in *NetworkReference, out *v1alpha2.NetworkReference, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.APIVersion = in.APIVersion
+	return nil
+}
+
+// Convert_v1alpha1_NetworkReference_To_v1alpha2_NetworkReference is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkReference_To_v1alpha2_NetworkReference(in *NetworkReference, out *v1alpha2.NetworkReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkReference_To_v1alpha2_NetworkReference(in, out, s)
+}
+
+func autoConvert_v1alpha2_NetworkReference_To_v1alpha1_NetworkReference(in *v1alpha2.NetworkReference, out *NetworkReference, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.APIVersion = in.APIVersion
+	return nil
+}
+
+// Convert_v1alpha2_NetworkReference_To_v1alpha1_NetworkReference is an autogenerated conversion function.
+func Convert_v1alpha2_NetworkReference_To_v1alpha1_NetworkReference(in *v1alpha2.NetworkReference, out *NetworkReference, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NetworkReference_To_v1alpha1_NetworkReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkSettings_To_v1alpha2_NetworkSettings(in *NetworkSettings, out *v1alpha2.NetworkSettings, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Provider = v1alpha2.NetworkProvider(in.Provider)
+	out.LegacyProvider = v1alpha2.NetworkProvider(in.LegacyProvider)
+	return nil
+}
+
+// Convert_v1alpha1_NetworkSettings_To_v1alpha2_NetworkSettings is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkSettings_To_v1alpha2_NetworkSettings(in *NetworkSettings, out *v1alpha2.NetworkSettings, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkSettings_To_v1alpha2_NetworkSettings(in, out, s)
+}
+
+func autoConvert_v1alpha2_NetworkSettings_To_v1alpha1_NetworkSettings(in *v1alpha2.NetworkSettings, out *NetworkSettings, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Provider = NetworkProvider(in.Provider)
+	out.LegacyProvider = NetworkProvider(in.LegacyProvider)
+	return nil
+}
+
+// Convert_v1alpha2_NetworkSettings_To_v1alpha1_NetworkSettings is an autogenerated conversion function.
+func Convert_v1alpha2_NetworkSettings_To_v1alpha1_NetworkSettings(in *v1alpha2.NetworkSettings, out *NetworkSettings, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NetworkSettings_To_v1alpha1_NetworkSettings(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkSettingsList_To_v1alpha2_NetworkSettingsList(in *NetworkSettingsList, out *v1alpha2.NetworkSettingsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha2.NetworkSettings)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkSettingsList_To_v1alpha2_NetworkSettingsList is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkSettingsList_To_v1alpha2_NetworkSettingsList(in *NetworkSettingsList, out *v1alpha2.NetworkSettingsList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkSettingsList_To_v1alpha2_NetworkSettingsList(in, out, s)
+}
+
+func autoConvert_v1alpha2_NetworkSettingsList_To_v1alpha1_NetworkSettingsList(in *v1alpha2.NetworkSettingsList, out *NetworkSettingsList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]NetworkSettings)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha2_NetworkSettingsList_To_v1alpha1_NetworkSettingsList is an autogenerated conversion function.
+func Convert_v1alpha2_NetworkSettingsList_To_v1alpha1_NetworkSettingsList(in *v1alpha2.NetworkSettingsList, out *NetworkSettingsList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NetworkSettingsList_To_v1alpha1_NetworkSettingsList(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkSpec_To_v1alpha2_NetworkSpec(in *NetworkSpec, out *v1alpha2.NetworkSpec, s conversion.Scope) error {
+	out.Type = v1alpha2.NetworkType(in.Type)
+	if err := Convert_v1alpha1_NetworkProviderReference_To_v1alpha2_NetworkProviderReference(&in.ProviderRef, &out.ProviderRef, s); err != nil {
+		return err
+	}
+	out.DNS = *(*[]string)(unsafe.Pointer(&in.DNS))
+	out.DNSSearchDomains = *(*[]string)(unsafe.Pointer(&in.DNSSearchDomains))
+	out.NTP = *(*[]string)(unsafe.Pointer(&in.NTP))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkSpec_To_v1alpha2_NetworkSpec is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkSpec_To_v1alpha2_NetworkSpec(in *NetworkSpec, out *v1alpha2.NetworkSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkSpec_To_v1alpha2_NetworkSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_NetworkSpec_To_v1alpha1_NetworkSpec(in *v1alpha2.NetworkSpec, out *NetworkSpec, s conversion.Scope) error {
+	out.Type = NetworkType(in.Type)
+	if err := Convert_v1alpha2_NetworkProviderReference_To_v1alpha1_NetworkProviderReference(&in.ProviderRef, &out.ProviderRef, s); err != nil {
+		return err
+	}
+	out.DNS = *(*[]string)(unsafe.Pointer(&in.DNS))
+	out.DNSSearchDomains = *(*[]string)(unsafe.Pointer(&in.DNSSearchDomains))
+	out.NTP = *(*[]string)(unsafe.Pointer(&in.NTP))
+	return nil
+}
+
+// Convert_v1alpha2_NetworkSpec_To_v1alpha1_NetworkSpec is an autogenerated conversion function.
+func Convert_v1alpha2_NetworkSpec_To_v1alpha1_NetworkSpec(in *v1alpha2.NetworkSpec, out *NetworkSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NetworkSpec_To_v1alpha1_NetworkSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_NetworkStatus_To_v1alpha2_NetworkStatus(in *NetworkStatus, out *v1alpha2.NetworkStatus, s conversion.Scope) error {
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_NetworkCondition_To_v1_Condition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.SupportedIPFamilies = *(*[]corev1.IPFamily)(unsafe.Pointer(&in.SupportedIPFamilies))
+	return nil
+}
+
+// Convert_v1alpha1_NetworkStatus_To_v1alpha2_NetworkStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NetworkStatus_To_v1alpha2_NetworkStatus(in *NetworkStatus, out *v1alpha2.NetworkStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NetworkStatus_To_v1alpha2_NetworkStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_NetworkStatus_To_v1alpha1_NetworkStatus(in *v1alpha2.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]NetworkCondition, len(*in))
+		for i := range *in {
+			if err := Convert_v1_Condition_To_v1alpha1_NetworkCondition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.SupportedIPFamilies = *(*[]corev1.IPFamily)(unsafe.Pointer(&in.SupportedIPFamilies))
+	return nil
+}
+
+// Convert_v1alpha2_NetworkStatus_To_v1alpha1_NetworkStatus is an autogenerated conversion function.
+func Convert_v1alpha2_NetworkStatus_To_v1alpha1_NetworkStatus(in *v1alpha2.NetworkStatus, out *NetworkStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_NetworkStatus_To_v1alpha1_NetworkStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_SharedSubnet_To_v1alpha2_SharedSubnet(in *SharedSubnet, out *v1alpha2.SharedSubnet, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Name = in.Name
+	out.PodDefault = v1alpha2.SharedSubnetDefault(in.PodDefault)
+	out.VMDefault = v1alpha2.SharedSubnetDefault(in.VMDefault)
+	return nil
+}
+
+// Convert_v1alpha1_SharedSubnet_To_v1alpha2_SharedSubnet is an autogenerated conversion function.
+func Convert_v1alpha1_SharedSubnet_To_v1alpha2_SharedSubnet(in *SharedSubnet, out *v1alpha2.SharedSubnet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SharedSubnet_To_v1alpha2_SharedSubnet(in, out, s)
+}
+
+func autoConvert_v1alpha2_SharedSubnet_To_v1alpha1_SharedSubnet(in *v1alpha2.SharedSubnet, out *SharedSubnet, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Name = in.Name
+	out.PodDefault = SharedSubnetDefault(in.PodDefault)
+	out.VMDefault = SharedSubnetDefault(in.VMDefault)
+	return nil
+}
+
+// Convert_v1alpha2_SharedSubnet_To_v1alpha1_SharedSubnet is an autogenerated conversion function.
+func Convert_v1alpha2_SharedSubnet_To_v1alpha1_SharedSubnet(in *v1alpha2.SharedSubnet, out *SharedSubnet, s conversion.Scope) error {
+	return autoConvert_v1alpha2_SharedSubnet_To_v1alpha1_SharedSubnet(in, out, s)
+}
+
+func autoConvert_v1alpha1_SingleNodeAvailabilityMode_To_v1alpha2_SingleNodeAvailabilityMode(in *SingleNodeAvailabilityMode, out *v1alpha2.SingleNodeAvailabilityMode, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	return nil
+}
+
+// Convert_v1alpha1_SingleNodeAvailabilityMode_To_v1alpha2_SingleNodeAvailabilityMode is an autogenerated conversion function.
+func Convert_v1alpha1_SingleNodeAvailabilityMode_To_v1alpha2_SingleNodeAvailabilityMode(in *SingleNodeAvailabilityMode, out *v1alpha2.SingleNodeAvailabilityMode, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SingleNodeAvailabilityMode_To_v1alpha2_SingleNodeAvailabilityMode(in, out, s)
+}
+
+func autoConvert_v1alpha2_SingleNodeAvailabilityMode_To_v1alpha1_SingleNodeAvailabilityMode(in *v1alpha2.SingleNodeAvailabilityMode, out *SingleNodeAvailabilityMode, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	return nil
+}
+
+// Convert_v1alpha2_SingleNodeAvailabilityMode_To_v1alpha1_SingleNodeAvailabilityMode is an autogenerated conversion function.
+func Convert_v1alpha2_SingleNodeAvailabilityMode_To_v1alpha1_SingleNodeAvailabilityMode(in *v1alpha2.SingleNodeAvailabilityMode, out *SingleNodeAvailabilityMode, s conversion.Scope) error {
+	return autoConvert_v1alpha2_SingleNodeAvailabilityMode_To_v1alpha1_SingleNodeAvailabilityMode(in, out, s)
+}
+
+func autoConvert_v1alpha1_VLANTrunkRange_To_v1alpha2_VLANTrunkRange(in *VLANTrunkRange, out *v1alpha2.VLANTrunkRange, s conversion.Scope) error {
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1alpha1_VLANTrunkRange_To_v1alpha2_VLANTrunkRange is an autogenerated conversion function.
+func Convert_v1alpha1_VLANTrunkRange_To_v1alpha2_VLANTrunkRange(in *VLANTrunkRange, out *v1alpha2.VLANTrunkRange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VLANTrunkRange_To_v1alpha2_VLANTrunkRange(in, out, s)
+}
+
+func autoConvert_v1alpha2_VLANTrunkRange_To_v1alpha1_VLANTrunkRange(in *v1alpha2.VLANTrunkRange, out *VLANTrunkRange, s conversion.Scope) error {
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1alpha2_VLANTrunkRange_To_v1alpha1_VLANTrunkRange is an autogenerated conversion function.
+func Convert_v1alpha2_VLANTrunkRange_To_v1alpha1_VLANTrunkRange(in *v1alpha2.VLANTrunkRange, out *VLANTrunkRange, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VLANTrunkRange_To_v1alpha1_VLANTrunkRange(in, out, s)
+}
+
+func autoConvert_v1alpha1_VMXNET3NetworkInterface_To_v1alpha2_VMXNET3NetworkInterface(in *VMXNET3NetworkInterface, out *v1alpha2.VMXNET3NetworkInterface, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_VMXNET3NetworkInterfaceSpec_To_v1alpha2_VMXNET3NetworkInterfaceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_VMXNET3NetworkInterfaceStatus_To_v1alpha2_VMXNET3NetworkInterfaceStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VMXNET3NetworkInterface_To_v1alpha2_VMXNET3NetworkInterface is an autogenerated conversion function.
+func Convert_v1alpha1_VMXNET3NetworkInterface_To_v1alpha2_VMXNET3NetworkInterface(in *VMXNET3NetworkInterface, out *v1alpha2.VMXNET3NetworkInterface, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VMXNET3NetworkInterface_To_v1alpha2_VMXNET3NetworkInterface(in, out, s)
+}
+
+func autoConvert_v1alpha2_VMXNET3NetworkInterface_To_v1alpha1_VMXNET3NetworkInterface(in *v1alpha2.VMXNET3NetworkInterface, out *VMXNET3NetworkInterface, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_VMXNET3NetworkInterfaceSpec_To_v1alpha1_VMXNET3NetworkInterfaceSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_VMXNET3NetworkInterfaceStatus_To_v1alpha1_VMXNET3NetworkInterfaceStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha2_VMXNET3NetworkInterface_To_v1alpha1_VMXNET3NetworkInterface is an autogenerated conversion function.
+func Convert_v1alpha2_VMXNET3NetworkInterface_To_v1alpha1_VMXNET3NetworkInterface(in *v1alpha2.VMXNET3NetworkInterface, out *VMXNET3NetworkInterface, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VMXNET3NetworkInterface_To_v1alpha1_VMXNET3NetworkInterface(in, out, s)
+}
+
+func autoConvert_v1alpha1_VMXNET3NetworkInterfaceList_To_v1alpha2_VMXNET3NetworkInterfaceList(in *VMXNET3NetworkInterfaceList, out *v1alpha2.VMXNET3NetworkInterfaceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha2.VMXNET3NetworkInterface)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_VMXNET3NetworkInterfaceList_To_v1alpha2_VMXNET3NetworkInterfaceList is an autogenerated conversion function.
+func Convert_v1alpha1_VMXNET3NetworkInterfaceList_To_v1alpha2_VMXNET3NetworkInterfaceList(in *VMXNET3NetworkInterfaceList, out *v1alpha2.VMXNET3NetworkInterfaceList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VMXNET3NetworkInterfaceList_To_v1alpha2_VMXNET3NetworkInterfaceList(in, out, s)
+}
+
+func autoConvert_v1alpha2_VMXNET3NetworkInterfaceList_To_v1alpha1_VMXNET3NetworkInterfaceList(in *v1alpha2.VMXNET3NetworkInterfaceList, out *VMXNET3NetworkInterfaceList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]VMXNET3NetworkInterface)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha2_VMXNET3NetworkInterfaceList_To_v1alpha1_VMXNET3NetworkInterfaceList is an autogenerated conversion function.
+func Convert_v1alpha2_VMXNET3NetworkInterfaceList_To_v1alpha1_VMXNET3NetworkInterfaceList(in *v1alpha2.VMXNET3NetworkInterfaceList, out *VMXNET3NetworkInterfaceList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VMXNET3NetworkInterfaceList_To_v1alpha1_VMXNET3NetworkInterfaceList(in, out, s)
+}
+
+func autoConvert_v1alpha1_VMXNET3NetworkInterfaceSpec_To_v1alpha2_VMXNET3NetworkInterfaceSpec(in *VMXNET3NetworkInterfaceSpec, out *v1alpha2.VMXNET3NetworkInterfaceSpec, s conversion.Scope) error {
+	out.UPTCompatibilityEnabled = in.UPTCompatibilityEnabled
+	out.WakeOnLanEnabled = in.WakeOnLanEnabled
+	return nil
+}
+
+// Convert_v1alpha1_VMXNET3NetworkInterfaceSpec_To_v1alpha2_VMXNET3NetworkInterfaceSpec is an autogenerated conversion function.
+func Convert_v1alpha1_VMXNET3NetworkInterfaceSpec_To_v1alpha2_VMXNET3NetworkInterfaceSpec(in *VMXNET3NetworkInterfaceSpec, out *v1alpha2.VMXNET3NetworkInterfaceSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VMXNET3NetworkInterfaceSpec_To_v1alpha2_VMXNET3NetworkInterfaceSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_VMXNET3NetworkInterfaceSpec_To_v1alpha1_VMXNET3NetworkInterfaceSpec(in *v1alpha2.VMXNET3NetworkInterfaceSpec, out *VMXNET3NetworkInterfaceSpec, s conversion.Scope) error {
+	out.UPTCompatibilityEnabled = in.UPTCompatibilityEnabled
+	out.WakeOnLanEnabled = in.WakeOnLanEnabled
+	return nil
+}
+
+// Convert_v1alpha2_VMXNET3NetworkInterfaceSpec_To_v1alpha1_VMXNET3NetworkInterfaceSpec is an autogenerated conversion function.
+func Convert_v1alpha2_VMXNET3NetworkInterfaceSpec_To_v1alpha1_VMXNET3NetworkInterfaceSpec(in *v1alpha2.VMXNET3NetworkInterfaceSpec, out *VMXNET3NetworkInterfaceSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VMXNET3NetworkInterfaceSpec_To_v1alpha1_VMXNET3NetworkInterfaceSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_VMXNET3NetworkInterfaceStatus_To_v1alpha2_VMXNET3NetworkInterfaceStatus(in *VMXNET3NetworkInterfaceStatus, out *v1alpha2.VMXNET3NetworkInterfaceStatus, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha1_VMXNET3NetworkInterfaceStatus_To_v1alpha2_VMXNET3NetworkInterfaceStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VMXNET3NetworkInterfaceStatus_To_v1alpha2_VMXNET3NetworkInterfaceStatus(in *VMXNET3NetworkInterfaceStatus, out *v1alpha2.VMXNET3NetworkInterfaceStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VMXNET3NetworkInterfaceStatus_To_v1alpha2_VMXNET3NetworkInterfaceStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_VMXNET3NetworkInterfaceStatus_To_v1alpha1_VMXNET3NetworkInterfaceStatus(in *v1alpha2.VMXNET3NetworkInterfaceStatus, out *VMXNET3NetworkInterfaceStatus, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1alpha2_VMXNET3NetworkInterfaceStatus_To_v1alpha1_VMXNET3NetworkInterfaceStatus is an autogenerated conversion function.
+func Convert_v1alpha2_VMXNET3NetworkInterfaceStatus_To_v1alpha1_VMXNET3NetworkInterfaceStatus(in *v1alpha2.VMXNET3NetworkInterfaceStatus, out *VMXNET3NetworkInterfaceStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VMXNET3NetworkInterfaceStatus_To_v1alpha1_VMXNET3NetworkInterfaceStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_VPCConfig_To_v1alpha2_VPCConfig(in *VPCConfig, out *v1alpha2.VPCConfig, s conversion.Scope) error {
+	out.VPC = in.VPC
+	if err := Convert_v1alpha1_AutoCreateVPCConfig_To_v1alpha2_AutoCreateVPCConfig(&in.AutoCreateConfig, &out.AutoCreateConfig, s); err != nil {
+		return err
+	}
+	out.SharedSubnets = *(*[]v1alpha2.SharedSubnet)(unsafe.Pointer(&in.SharedSubnets))
+	out.DefaultSubnetSize = in.DefaultSubnetSize
+	return nil
+}
+
+// Convert_v1alpha1_VPCConfig_To_v1alpha2_VPCConfig is an autogenerated conversion function.
+func Convert_v1alpha1_VPCConfig_To_v1alpha2_VPCConfig(in *VPCConfig, out *v1alpha2.VPCConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VPCConfig_To_v1alpha2_VPCConfig(in, out, s)
+}
+
+func autoConvert_v1alpha2_VPCConfig_To_v1alpha1_VPCConfig(in *v1alpha2.VPCConfig, out *VPCConfig, s conversion.Scope) error {
+	out.VPC = in.VPC
+	if err := Convert_v1alpha2_AutoCreateVPCConfig_To_v1alpha1_AutoCreateVPCConfig(&in.AutoCreateConfig, &out.AutoCreateConfig, s); err != nil {
+		return err
+	}
+	out.SharedSubnets = *(*[]SharedSubnet)(unsafe.Pointer(&in.SharedSubnets))
+	out.DefaultSubnetSize = in.DefaultSubnetSize
+	return nil
+}
+
+// Convert_v1alpha2_VPCConfig_To_v1alpha1_VPCConfig is an autogenerated conversion function.
+func Convert_v1alpha2_VPCConfig_To_v1alpha1_VPCConfig(in *v1alpha2.VPCConfig, out *VPCConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VPCConfig_To_v1alpha1_VPCConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_VSphereDistributedConfig_To_v1alpha2_VSphereDistributedConfig(in *VSphereDistributedConfig, out *v1alpha2.VSphereDistributedConfig, s conversion.Scope) error {
+	out.Networks = *(*[]v1alpha2.VSphereDistributedNetworkRef)(unsafe.Pointer(&in.Networks))
+	out.DefaultNetwork = in.DefaultNetwork
+	return nil
+}
+
+// Convert_v1alpha1_VSphereDistributedConfig_To_v1alpha2_VSphereDistributedConfig is an autogenerated conversion function.
+func Convert_v1alpha1_VSphereDistributedConfig_To_v1alpha2_VSphereDistributedConfig(in *VSphereDistributedConfig, out *v1alpha2.VSphereDistributedConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VSphereDistributedConfig_To_v1alpha2_VSphereDistributedConfig(in, out, s)
+}
+
+func autoConvert_v1alpha2_VSphereDistributedConfig_To_v1alpha1_VSphereDistributedConfig(in *v1alpha2.VSphereDistributedConfig, out *VSphereDistributedConfig, s conversion.Scope) error {
+	out.Networks = *(*[]VSphereDistributedNetworkRef)(unsafe.Pointer(&in.Networks))
+	out.DefaultNetwork = in.DefaultNetwork
+	return nil
+}
+
+// Convert_v1alpha2_VSphereDistributedConfig_To_v1alpha1_VSphereDistributedConfig is an autogenerated conversion function.
+func Convert_v1alpha2_VSphereDistributedConfig_To_v1alpha1_VSphereDistributedConfig(in *v1alpha2.VSphereDistributedConfig, out *VSphereDistributedConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VSphereDistributedConfig_To_v1alpha1_VSphereDistributedConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_VSphereDistributedNetwork_To_v1alpha2_VSphereDistributedNetwork(in *VSphereDistributedNetwork, out *v1alpha2.VSphereDistributedNetwork, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_VSphereDistributedNetworkSpec_To_v1alpha2_VSphereDistributedNetworkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_VSphereDistributedNetworkStatus_To_v1alpha2_VSphereDistributedNetworkStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha2_VSphereDistributedNetwork_To_v1alpha1_VSphereDistributedNetwork(in *v1alpha2.VSphereDistributedNetwork, out *VSphereDistributedNetwork, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_VSphereDistributedNetworkSpec_To_v1alpha1_VSphereDistributedNetworkSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha2_VSphereDistributedNetworkStatus_To_v1alpha1_VSphereDistributedNetworkStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_VSphereDistributedNetworkIPRange_To_v1alpha2_VSphereDistributedNetworkIPRange(in *VSphereDistributedNetworkIPRange, out *v1alpha2.VSphereDistributedNetworkIPRange, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_v1alpha1_VSphereDistributedNetworkIPRange_To_v1alpha2_VSphereDistributedNetworkIPRange is an autogenerated conversion function.
+func Convert_v1alpha1_VSphereDistributedNetworkIPRange_To_v1alpha2_VSphereDistributedNetworkIPRange(in *VSphereDistributedNetworkIPRange, out *v1alpha2.VSphereDistributedNetworkIPRange, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VSphereDistributedNetworkIPRange_To_v1alpha2_VSphereDistributedNetworkIPRange(in, out, s)
+}
+
+func autoConvert_v1alpha2_VSphereDistributedNetworkIPRange_To_v1alpha1_VSphereDistributedNetworkIPRange(in *v1alpha2.VSphereDistributedNetworkIPRange, out *VSphereDistributedNetworkIPRange, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_v1alpha2_VSphereDistributedNetworkIPRange_To_v1alpha1_VSphereDistributedNetworkIPRange is an autogenerated conversion function.
+func Convert_v1alpha2_VSphereDistributedNetworkIPRange_To_v1alpha1_VSphereDistributedNetworkIPRange(in *v1alpha2.VSphereDistributedNetworkIPRange, out *VSphereDistributedNetworkIPRange, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VSphereDistributedNetworkIPRange_To_v1alpha1_VSphereDistributedNetworkIPRange(in, out, s)
+}
+
+func autoConvert_v1alpha1_VSphereDistributedNetworkList_To_v1alpha2_VSphereDistributedNetworkList(in *VSphereDistributedNetworkList, out *v1alpha2.VSphereDistributedNetworkList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1alpha2.VSphereDistributedNetwork, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_VSphereDistributedNetwork_To_v1alpha2_VSphereDistributedNetwork(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_VSphereDistributedNetworkList_To_v1alpha2_VSphereDistributedNetworkList is an autogenerated conversion function.
+func Convert_v1alpha1_VSphereDistributedNetworkList_To_v1alpha2_VSphereDistributedNetworkList(in *VSphereDistributedNetworkList, out *v1alpha2.VSphereDistributedNetworkList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VSphereDistributedNetworkList_To_v1alpha2_VSphereDistributedNetworkList(in, out, s)
+}
+
+func autoConvert_v1alpha2_VSphereDistributedNetworkList_To_v1alpha1_VSphereDistributedNetworkList(in *v1alpha2.VSphereDistributedNetworkList, out *VSphereDistributedNetworkList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VSphereDistributedNetwork, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_VSphereDistributedNetwork_To_v1alpha1_VSphereDistributedNetwork(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_VSphereDistributedNetworkList_To_v1alpha1_VSphereDistributedNetworkList is an autogenerated conversion function.
+func Convert_v1alpha2_VSphereDistributedNetworkList_To_v1alpha1_VSphereDistributedNetworkList(in *v1alpha2.VSphereDistributedNetworkList, out *VSphereDistributedNetworkList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VSphereDistributedNetworkList_To_v1alpha1_VSphereDistributedNetworkList(in, out, s)
+}
+
+func autoConvert_v1alpha1_VSphereDistributedNetworkRef_To_v1alpha2_VSphereDistributedNetworkRef(in *VSphereDistributedNetworkRef, out *v1alpha2.VSphereDistributedNetworkRef, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_VSphereDistributedNetworkRef_To_v1alpha2_VSphereDistributedNetworkRef is an autogenerated conversion function.
+func Convert_v1alpha1_VSphereDistributedNetworkRef_To_v1alpha2_VSphereDistributedNetworkRef(in *VSphereDistributedNetworkRef, out *v1alpha2.VSphereDistributedNetworkRef, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VSphereDistributedNetworkRef_To_v1alpha2_VSphereDistributedNetworkRef(in, out, s)
+}
+
+func autoConvert_v1alpha2_VSphereDistributedNetworkRef_To_v1alpha1_VSphereDistributedNetworkRef(in *v1alpha2.VSphereDistributedNetworkRef, out *VSphereDistributedNetworkRef, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha2_VSphereDistributedNetworkRef_To_v1alpha1_VSphereDistributedNetworkRef is an autogenerated conversion function.
+func Convert_v1alpha2_VSphereDistributedNetworkRef_To_v1alpha1_VSphereDistributedNetworkRef(in *v1alpha2.VSphereDistributedNetworkRef, out *VSphereDistributedNetworkRef, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VSphereDistributedNetworkRef_To_v1alpha1_VSphereDistributedNetworkRef(in, out, s)
+}
+
+func autoConvert_v1alpha1_VSphereDistributedNetworkSpec_To_v1alpha2_VSphereDistributedNetworkSpec(in *VSphereDistributedNetworkSpec, out *v1alpha2.VSphereDistributedNetworkSpec, s conversion.Scope) error {
+	out.PortGroupID = in.PortGroupID
+	out.IPAssignmentMode = v1alpha2.IPAssignmentModeType(in.IPAssignmentMode)
+	out.IPv6AssignmentMode = v1alpha2.IPAssignmentModeType(in.IPv6AssignmentMode)
+	out.IPPools = *(*[]v1alpha2.IPPoolReference)(unsafe.Pointer(&in.IPPools))
+	out.Gateway = in.Gateway
+	out.SubnetMask = in.SubnetMask
+	out.IPv6Gateway = in.IPv6Gateway
+	out.IPv6Prefix = (*int32)(unsafe.Pointer(in.IPv6Prefix))
+	out.AddressRanges = *(*[]v1alpha2.VSphereDistributedNetworkIPRange)(unsafe.Pointer(&in.AddressRanges))
+	return nil
+}
+
+// Convert_v1alpha1_VSphereDistributedNetworkSpec_To_v1alpha2_VSphereDistributedNetworkSpec is an autogenerated conversion function.
+func Convert_v1alpha1_VSphereDistributedNetworkSpec_To_v1alpha2_VSphereDistributedNetworkSpec(in *VSphereDistributedNetworkSpec, out *v1alpha2.VSphereDistributedNetworkSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VSphereDistributedNetworkSpec_To_v1alpha2_VSphereDistributedNetworkSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_VSphereDistributedNetworkSpec_To_v1alpha1_VSphereDistributedNetworkSpec(in *v1alpha2.VSphereDistributedNetworkSpec, out *VSphereDistributedNetworkSpec, s conversion.Scope) error {
+	out.PortGroupID = in.PortGroupID
+	out.IPAssignmentMode = IPAssignmentModeType(in.IPAssignmentMode)
+	out.IPv6AssignmentMode = IPAssignmentModeType(in.IPv6AssignmentMode)
+	out.IPPools = *(*[]IPPoolReference)(unsafe.Pointer(&in.IPPools))
+	out.Gateway = in.Gateway
+	out.SubnetMask = in.SubnetMask
+	out.IPv6Gateway = in.IPv6Gateway
+	out.IPv6Prefix = (*int32)(unsafe.Pointer(in.IPv6Prefix))
+	out.AddressRanges = *(*[]VSphereDistributedNetworkIPRange)(unsafe.Pointer(&in.AddressRanges))
+	return nil
+}
+
+// Convert_v1alpha2_VSphereDistributedNetworkSpec_To_v1alpha1_VSphereDistributedNetworkSpec is an autogenerated conversion function.
+func Convert_v1alpha2_VSphereDistributedNetworkSpec_To_v1alpha1_VSphereDistributedNetworkSpec(in *v1alpha2.VSphereDistributedNetworkSpec, out *VSphereDistributedNetworkSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VSphereDistributedNetworkSpec_To_v1alpha1_VSphereDistributedNetworkSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_VSphereDistributedNetworkStatus_To_v1alpha2_VSphereDistributedNetworkStatus(in *VSphereDistributedNetworkStatus, out *v1alpha2.VSphereDistributedNetworkStatus, s conversion.Scope) error {
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_VSphereDistributedNetworkCondition_To_v1_Condition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.DefaultPortConfig = (*v1alpha2.VSphereDistributedPortConfig)(unsafe.Pointer(in.DefaultPortConfig))
+	return nil
+}
+
+// Convert_v1alpha1_VSphereDistributedNetworkStatus_To_v1alpha2_VSphereDistributedNetworkStatus is an autogenerated conversion function.
+func Convert_v1alpha1_VSphereDistributedNetworkStatus_To_v1alpha2_VSphereDistributedNetworkStatus(in *VSphereDistributedNetworkStatus, out *v1alpha2.VSphereDistributedNetworkStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VSphereDistributedNetworkStatus_To_v1alpha2_VSphereDistributedNetworkStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_VSphereDistributedNetworkStatus_To_v1alpha1_VSphereDistributedNetworkStatus(in *v1alpha2.VSphereDistributedNetworkStatus, out *VSphereDistributedNetworkStatus, s conversion.Scope) error {
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]VSphereDistributedNetworkCondition, len(*in))
+		for i := range *in {
+			if err := Convert_v1_Condition_To_v1alpha1_VSphereDistributedNetworkCondition(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.DefaultPortConfig = (*VSphereDistributedPortConfig)(unsafe.Pointer(in.DefaultPortConfig))
+	return nil
+}
+
+// Convert_v1alpha2_VSphereDistributedNetworkStatus_To_v1alpha1_VSphereDistributedNetworkStatus is an autogenerated conversion function.
+func Convert_v1alpha2_VSphereDistributedNetworkStatus_To_v1alpha1_VSphereDistributedNetworkStatus(in *v1alpha2.VSphereDistributedNetworkStatus, out *VSphereDistributedNetworkStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VSphereDistributedNetworkStatus_To_v1alpha1_VSphereDistributedNetworkStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_VSphereDistributedPortConfig_To_v1alpha2_VSphereDistributedPortConfig(in *VSphereDistributedPortConfig, out *v1alpha2.VSphereDistributedPortConfig, s conversion.Scope) error {
+	out.Vlan = (*v1alpha2.VlanSpec)(unsafe.Pointer(in.Vlan))
+	out.MacManagementPolicy = (*v1alpha2.MacManagementPolicy)(unsafe.Pointer(in.MacManagementPolicy))
+	return nil
+}
+
+// Convert_v1alpha1_VSphereDistributedPortConfig_To_v1alpha2_VSphereDistributedPortConfig is an autogenerated conversion function.
+func Convert_v1alpha1_VSphereDistributedPortConfig_To_v1alpha2_VSphereDistributedPortConfig(in *VSphereDistributedPortConfig, out *v1alpha2.VSphereDistributedPortConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VSphereDistributedPortConfig_To_v1alpha2_VSphereDistributedPortConfig(in, out, s)
+}
+
+func autoConvert_v1alpha2_VSphereDistributedPortConfig_To_v1alpha1_VSphereDistributedPortConfig(in *v1alpha2.VSphereDistributedPortConfig, out *VSphereDistributedPortConfig, s conversion.Scope) error {
+	out.Vlan = (*VlanSpec)(unsafe.Pointer(in.Vlan))
+	out.MacManagementPolicy = (*MacManagementPolicy)(unsafe.Pointer(in.MacManagementPolicy))
+	return nil
+}
+
+// Convert_v1alpha2_VSphereDistributedPortConfig_To_v1alpha1_VSphereDistributedPortConfig is an autogenerated conversion function.
+func Convert_v1alpha2_VSphereDistributedPortConfig_To_v1alpha1_VSphereDistributedPortConfig(in *v1alpha2.VSphereDistributedPortConfig, out *VSphereDistributedPortConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VSphereDistributedPortConfig_To_v1alpha1_VSphereDistributedPortConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_VirtualIPPoolsUtilization_To_v1alpha2_VirtualIPPoolsUtilization(in *VirtualIPPoolsUtilization, out *v1alpha2.VirtualIPPoolsUtilization, s conversion.Scope) error {
+	out.IPsAllocated = in.IPsAllocated
+	out.IPsAvailable = in.IPsAvailable
+	return nil
+}
+
+// Convert_v1alpha1_VirtualIPPoolsUtilization_To_v1alpha2_VirtualIPPoolsUtilization is an autogenerated conversion function.
+func Convert_v1alpha1_VirtualIPPoolsUtilization_To_v1alpha2_VirtualIPPoolsUtilization(in *VirtualIPPoolsUtilization, out *v1alpha2.VirtualIPPoolsUtilization, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VirtualIPPoolsUtilization_To_v1alpha2_VirtualIPPoolsUtilization(in, out, s)
+}
+
+func autoConvert_v1alpha2_VirtualIPPoolsUtilization_To_v1alpha1_VirtualIPPoolsUtilization(in *v1alpha2.VirtualIPPoolsUtilization, out *VirtualIPPoolsUtilization, s conversion.Scope) error {
+	out.IPsAllocated = in.IPsAllocated
+	out.IPsAvailable = in.IPsAvailable
+	return nil
+}
+
+// Convert_v1alpha2_VirtualIPPoolsUtilization_To_v1alpha1_VirtualIPPoolsUtilization is an autogenerated conversion function.
+func Convert_v1alpha2_VirtualIPPoolsUtilization_To_v1alpha1_VirtualIPPoolsUtilization(in *v1alpha2.VirtualIPPoolsUtilization, out *VirtualIPPoolsUtilization, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VirtualIPPoolsUtilization_To_v1alpha1_VirtualIPPoolsUtilization(in, out, s)
+}
+
+func autoConvert_v1alpha1_VlanSpec_To_v1alpha2_VlanSpec(in *VlanSpec, out *v1alpha2.VlanSpec, s conversion.Scope) error {
+	out.Type = v1alpha2.VLANType(in.Type)
+	out.VlanID = (*int32)(unsafe.Pointer(in.VlanID))
+	out.TrunkRange = *(*[]v1alpha2.VLANTrunkRange)(unsafe.Pointer(&in.TrunkRange))
+	out.PrivateVlanID = (*int32)(unsafe.Pointer(in.PrivateVlanID))
+	return nil
+}
+
+// Convert_v1alpha1_VlanSpec_To_v1alpha2_VlanSpec is an autogenerated conversion function.
+func Convert_v1alpha1_VlanSpec_To_v1alpha2_VlanSpec(in *VlanSpec, out *v1alpha2.VlanSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VlanSpec_To_v1alpha2_VlanSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_VlanSpec_To_v1alpha1_VlanSpec(in *v1alpha2.VlanSpec, out *VlanSpec, s conversion.Scope) error {
+	out.Type = VLANType(in.Type)
+	out.VlanID = (*int32)(unsafe.Pointer(in.VlanID))
+	out.TrunkRange = *(*[]VLANTrunkRange)(unsafe.Pointer(&in.TrunkRange))
+	out.PrivateVlanID = (*int32)(unsafe.Pointer(in.PrivateVlanID))
+	return nil
+}
+
+// Convert_v1alpha2_VlanSpec_To_v1alpha1_VlanSpec is an autogenerated conversion function.
+func Convert_v1alpha2_VlanSpec_To_v1alpha1_VlanSpec(in *v1alpha2.VlanSpec, out *VlanSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_VlanSpec_To_v1alpha1_VlanSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkloadNetworkConfiguration_To_v1alpha2_WorkloadNetworkConfiguration(in *WorkloadNetworkConfiguration, out *v1alpha2.WorkloadNetworkConfiguration, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_WorkloadNetworkConfigurationSpec_To_v1alpha2_WorkloadNetworkConfigurationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	out.Status = (*v1alpha2.WorkloadNetworkConfigurationStatus)(unsafe.Pointer(in.Status))
+	return nil
+}
+
+// Convert_v1alpha1_WorkloadNetworkConfiguration_To_v1alpha2_WorkloadNetworkConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_WorkloadNetworkConfiguration_To_v1alpha2_WorkloadNetworkConfiguration(in *WorkloadNetworkConfiguration, out *v1alpha2.WorkloadNetworkConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkloadNetworkConfiguration_To_v1alpha2_WorkloadNetworkConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha2_WorkloadNetworkConfiguration_To_v1alpha1_WorkloadNetworkConfiguration(in *v1alpha2.WorkloadNetworkConfiguration, out *WorkloadNetworkConfiguration, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha2_WorkloadNetworkConfigurationSpec_To_v1alpha1_WorkloadNetworkConfigurationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	out.Status = (*WorkloadNetworkConfigurationStatus)(unsafe.Pointer(in.Status))
+	return nil
+}
+
+// Convert_v1alpha2_WorkloadNetworkConfiguration_To_v1alpha1_WorkloadNetworkConfiguration is an autogenerated conversion function.
+func Convert_v1alpha2_WorkloadNetworkConfiguration_To_v1alpha1_WorkloadNetworkConfiguration(in *v1alpha2.WorkloadNetworkConfiguration, out *WorkloadNetworkConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha2_WorkloadNetworkConfiguration_To_v1alpha1_WorkloadNetworkConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkloadNetworkConfigurationList_To_v1alpha2_WorkloadNetworkConfigurationList(in *WorkloadNetworkConfigurationList, out *v1alpha2.WorkloadNetworkConfigurationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1alpha2.WorkloadNetworkConfiguration)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_WorkloadNetworkConfigurationList_To_v1alpha2_WorkloadNetworkConfigurationList is an autogenerated conversion function.
+func Convert_v1alpha1_WorkloadNetworkConfigurationList_To_v1alpha2_WorkloadNetworkConfigurationList(in *WorkloadNetworkConfigurationList, out *v1alpha2.WorkloadNetworkConfigurationList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkloadNetworkConfigurationList_To_v1alpha2_WorkloadNetworkConfigurationList(in, out, s)
+}
+
+func autoConvert_v1alpha2_WorkloadNetworkConfigurationList_To_v1alpha1_WorkloadNetworkConfigurationList(in *v1alpha2.WorkloadNetworkConfigurationList, out *WorkloadNetworkConfigurationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]WorkloadNetworkConfiguration)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha2_WorkloadNetworkConfigurationList_To_v1alpha1_WorkloadNetworkConfigurationList is an autogenerated conversion function.
+func Convert_v1alpha2_WorkloadNetworkConfigurationList_To_v1alpha1_WorkloadNetworkConfigurationList(in *v1alpha2.WorkloadNetworkConfigurationList, out *WorkloadNetworkConfigurationList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_WorkloadNetworkConfigurationList_To_v1alpha1_WorkloadNetworkConfigurationList(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkloadNetworkConfigurationSpec_To_v1alpha2_WorkloadNetworkConfigurationSpec(in *WorkloadNetworkConfigurationSpec, out *v1alpha2.WorkloadNetworkConfigurationSpec, s conversion.Scope) error {
+	out.Providers = *(*[]v1alpha2.NetworkProviderEntry)(unsafe.Pointer(&in.Providers))
+	out.ActiveSystemProvider = v1alpha2.NetworkProvider(in.ActiveSystemProvider)
+	return nil
+}
+
+// Convert_v1alpha1_WorkloadNetworkConfigurationSpec_To_v1alpha2_WorkloadNetworkConfigurationSpec is an autogenerated conversion function.
+func Convert_v1alpha1_WorkloadNetworkConfigurationSpec_To_v1alpha2_WorkloadNetworkConfigurationSpec(in *WorkloadNetworkConfigurationSpec, out *v1alpha2.WorkloadNetworkConfigurationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkloadNetworkConfigurationSpec_To_v1alpha2_WorkloadNetworkConfigurationSpec(in, out, s)
+}
+
+func autoConvert_v1alpha2_WorkloadNetworkConfigurationSpec_To_v1alpha1_WorkloadNetworkConfigurationSpec(in *v1alpha2.WorkloadNetworkConfigurationSpec, out *WorkloadNetworkConfigurationSpec, s conversion.Scope) error {
+	out.Providers = *(*[]NetworkProviderEntry)(unsafe.Pointer(&in.Providers))
+	out.ActiveSystemProvider = NetworkProvider(in.ActiveSystemProvider)
+	return nil
+}
+
+// Convert_v1alpha2_WorkloadNetworkConfigurationSpec_To_v1alpha1_WorkloadNetworkConfigurationSpec is an autogenerated conversion function.
+func Convert_v1alpha2_WorkloadNetworkConfigurationSpec_To_v1alpha1_WorkloadNetworkConfigurationSpec(in *v1alpha2.WorkloadNetworkConfigurationSpec, out *WorkloadNetworkConfigurationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha2_WorkloadNetworkConfigurationSpec_To_v1alpha1_WorkloadNetworkConfigurationSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkloadNetworkConfigurationStatus_To_v1alpha2_WorkloadNetworkConfigurationStatus(in *WorkloadNetworkConfigurationStatus, out *v1alpha2.WorkloadNetworkConfigurationStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha1_WorkloadNetworkConfigurationStatus_To_v1alpha2_WorkloadNetworkConfigurationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_WorkloadNetworkConfigurationStatus_To_v1alpha2_WorkloadNetworkConfigurationStatus(in *WorkloadNetworkConfigurationStatus, out *v1alpha2.WorkloadNetworkConfigurationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkloadNetworkConfigurationStatus_To_v1alpha2_WorkloadNetworkConfigurationStatus(in, out, s)
+}
+
+func autoConvert_v1alpha2_WorkloadNetworkConfigurationStatus_To_v1alpha1_WorkloadNetworkConfigurationStatus(in *v1alpha2.WorkloadNetworkConfigurationStatus, out *WorkloadNetworkConfigurationStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]v1.Condition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1alpha2_WorkloadNetworkConfigurationStatus_To_v1alpha1_WorkloadNetworkConfigurationStatus is an autogenerated conversion function.
+func Convert_v1alpha2_WorkloadNetworkConfigurationStatus_To_v1alpha1_WorkloadNetworkConfigurationStatus(in *v1alpha2.WorkloadNetworkConfigurationStatus, out *WorkloadNetworkConfigurationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_WorkloadNetworkConfigurationStatus_To_v1alpha1_WorkloadNetworkConfigurationStatus(in, out, s)
+}
@@ -680,6 +680,21 @@ func (in *IPPoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolPressurePolicy) DeepCopyInto(out *IPPoolPressurePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolPressurePolicy.
+func (in *IPPoolPressurePolicy) DeepCopy() *IPPoolPressurePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolPressurePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPPoolReference) DeepCopyInto(out *IPPoolReference) {
 	*out = *in
@@ -0,0 +1,147 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Getter is implemented by every netoperator.vmware.com kind. It returns the
+// conditions of the status of the object, which are nil for the kinds that have
+// none.
+// +kubebuilder:object:generate=false
+type Getter interface {
+	GetConditions() []metav1.Condition
+}
+
+// Setter is implemented by the netoperator.vmware.com kinds whose status has
+// conditions.
+// +kubebuilder:object:generate=false
+type Setter interface {
+	Getter
+	SetConditions(conditions []metav1.Condition)
+}
+
+// Conditioned is an object of a netoperator.vmware.com kind whose status has
+// conditions, for reconcilers and status helpers that work with any of them.
+// +kubebuilder:object:generate=false
+type Conditioned interface {
+	metav1.Object
+	runtime.Object
+	Setter
+}
+
+var (
+	_ Conditioned = &FoundationLoadBalancerConfig{}
+	_ Conditioned = &IPAddressAllocation{}
+	_ Conditioned = &IPPool{}
+	_ Conditioned = &LoadBalancerConfig{}
+	_ Conditioned = &NamespaceNetworkConfiguration{}
+	_ Conditioned = &Network{}
+	_ Conditioned = &NetworkInterface{}
+	_ Conditioned = &VSphereDistributedNetwork{}
+	_ Conditioned = &WorkloadNetworkConfiguration{}
+	_ Getter      = &AviLoadBalancerConfig{}
+	_ Getter      = &HAProxyLoadBalancerConfig{}
+	_ Getter      = &NetworkSettings{}
+	_ Getter      = &VMXNET3NetworkInterface{}
+)
+
+// GetConditions returns nil, since the AviLoadBalancerConfig has no conditions.
+func (*AviLoadBalancerConfig) GetConditions() []metav1.Condition {
+	return nil
+}
+
+// GetConditions returns nil, since the HAProxyLoadBalancerConfig has no conditions.
+func (*HAProxyLoadBalancerConfig) GetConditions() []metav1.Condition {
+	return nil
+}
+
+// GetConditions returns the status conditions of the IPAddressAllocation.
+func (in *IPAddressAllocation) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the IPAddressAllocation.
+func (in *IPAddressAllocation) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the IPPool.
+func (in *IPPool) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the IPPool.
+func (in *IPPool) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the LoadBalancerConfig.
+func (in *LoadBalancerConfig) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the LoadBalancerConfig.
+func (in *LoadBalancerConfig) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the Network.
+func (in *Network) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the Network.
+func (in *Network) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the NetworkInterface.
+func (in *NetworkInterface) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the NetworkInterface.
+func (in *NetworkInterface) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns nil, since the NetworkSettings has no conditions.
+func (*NetworkSettings) GetConditions() []metav1.Condition {
+	return nil
+}
+
+// GetConditions returns nil, since the VMXNET3NetworkInterface has no conditions.
+func (*VMXNET3NetworkInterface) GetConditions() []metav1.Condition {
+	return nil
+}
+
+// GetConditions returns the status conditions of the VSphereDistributedNetwork.
+func (in *VSphereDistributedNetwork) GetConditions() []metav1.Condition {
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the VSphereDistributedNetwork.
+func (in *VSphereDistributedNetwork) SetConditions(conditions []metav1.Condition) {
+	in.Status.Conditions = conditions
+}
+
+// GetConditions returns the status conditions of the WorkloadNetworkConfiguration.
+func (in *WorkloadNetworkConfiguration) GetConditions() []metav1.Condition {
+	if in.Status == nil {
+		return nil
+	}
+	return in.Status.Conditions
+}
+
+// SetConditions sets the status conditions of the WorkloadNetworkConfiguration.
+func (in *WorkloadNetworkConfiguration) SetConditions(conditions []metav1.Condition) {
+	if in.Status == nil {
+		in.Status = &WorkloadNetworkConfigurationStatus{}
+	}
+	in.Status.Conditions = conditions
+}
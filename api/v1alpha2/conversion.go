@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha2
+
+// The types in this package are the conversion hub for the netoperator.vmware.com
+// group. Every other version implements conversion.Convertible against them.
+
+// Hub marks AviLoadBalancerConfig as a conversion hub.
+func (*AviLoadBalancerConfig) Hub() {}
+
+// Hub marks AviLoadBalancerConfigList as a conversion hub.
+func (*AviLoadBalancerConfigList) Hub() {}
+
+// Hub marks FoundationLoadBalancerConfig as a conversion hub.
+func (*FoundationLoadBalancerConfig) Hub() {}
+
+// Hub marks FoundationLoadBalancerConfigList as a conversion hub.
+func (*FoundationLoadBalancerConfigList) Hub() {}
+
+// Hub marks HAProxyLoadBalancerConfig as a conversion hub.
+func (*HAProxyLoadBalancerConfig) Hub() {}
+
+// Hub marks HAProxyLoadBalancerConfigList as a conversion hub.
+func (*HAProxyLoadBalancerConfigList) Hub() {}
+
+// Hub marks IPAddressAllocation as a conversion hub.
+func (*IPAddressAllocation) Hub() {}
+
+// Hub marks IPAddressAllocationList as a conversion hub.
+func (*IPAddressAllocationList) Hub() {}
+
+// Hub marks IPPool as a conversion hub.
+func (*IPPool) Hub() {}
+
+// Hub marks IPPoolList as a conversion hub.
+func (*IPPoolList) Hub() {}
+
+// Hub marks LoadBalancerConfig as a conversion hub.
+func (*LoadBalancerConfig) Hub() {}
+
+// Hub marks LoadBalancerConfigList as a conversion hub.
+func (*LoadBalancerConfigList) Hub() {}
+
+// Hub marks NamespaceNetworkConfiguration as a conversion hub.
+func (*NamespaceNetworkConfiguration) Hub() {}
+
+// Hub marks NamespaceNetworkConfigurationList as a conversion hub.
+func (*NamespaceNetworkConfigurationList) Hub() {}
+
+// Hub marks Network as a conversion hub.
+func (*Network) Hub() {}
+
+// Hub marks NetworkList as a conversion hub.
+func (*NetworkList) Hub() {}
+
+// Hub marks NetworkInterface as a conversion hub.
+func (*NetworkInterface) Hub() {}
+
+// Hub marks NetworkInterfaceList as a conversion hub.
+func (*NetworkInterfaceList) Hub() {}
+
+// Hub marks NetworkSettings as a conversion hub.
+func (*NetworkSettings) Hub() {}
+
+// Hub marks NetworkSettingsList as a conversion hub.
+func (*NetworkSettingsList) Hub() {}
+
+// Hub marks VMXNET3NetworkInterface as a conversion hub.
+func (*VMXNET3NetworkInterface) Hub() {}
+
+// Hub marks VMXNET3NetworkInterfaceList as a conversion hub.
+func (*VMXNET3NetworkInterfaceList) Hub() {}
+
+// Hub marks VSphereDistributedNetwork as a conversion hub.
+func (*VSphereDistributedNetwork) Hub() {}
+
+// Hub marks VSphereDistributedNetworkList as a conversion hub.
+func (*VSphereDistributedNetworkList) Hub() {}
+
+// Hub marks WorkloadNetworkConfiguration as a conversion hub.
+func (*WorkloadNetworkConfiguration) Hub() {}
+
+// Hub marks WorkloadNetworkConfigurationList as a conversion hub.
+func (*WorkloadNetworkConfigurationList) Hub() {}
@@ -0,0 +1,12 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Package v1alpha2 contains the hub version of the netoperator.vmware.com API
+// group. It is the storage version for every kind, and older versions such as
+// v1alpha1 convert to and from the types in this package.
+//
+// +k8s:openapi-gen=true
+// +kubebuilder:object:generate=true
+// +groupName=netoperator.vmware.com
+package v1alpha2
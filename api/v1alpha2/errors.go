@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha2
+
+import (
+	"errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Errors for the reasons of the Failure condition of a NetworkInterface or an
+// IPAddressAllocation. The errors returned by ErrorFromConditions wrap them, so
+// callers can tell the failures apart with errors.Is.
+var (
+	// ErrCannotAllocIP is the error for the CannotAllocIP reason, which is used
+	// when the IPPool of the network has no free address left. Growing the
+	// pool and retrying may succeed.
+	ErrCannotAllocIP = errors.New("cannot allocate IP")
+	// ErrCannotAllocPort is the error for the CannotAllocPort reason.
+	ErrCannotAllocPort = errors.New("cannot allocate port")
+	// ErrNetworkDeleted is the error for the NetworkDeleted reason.
+	ErrNetworkDeleted = errors.New("network deleted")
+	// ErrUnsupportedIPFamilyPolicy is the error for the
+	// UnsupportedIPFamilyPolicy reason. The NetworkInterface must be changed
+	// for it to succeed.
+	ErrUnsupportedIPFamilyPolicy = errors.New("unsupported IP family policy")
+	// ErrInvalidRequestedIP is the error for the InvalidRequestedIP reason. The
+	// IPAddressAllocation must be changed for it to succeed.
+	ErrInvalidRequestedIP = errors.New("invalid requested IP")
+	// ErrIPPoolRefRetrievalFailed is the error for the IPPoolRefRetrievalFailed
+	// reason.
+	ErrIPPoolRefRetrievalFailed = errors.New("IPPool retrieval failed")
+)
+
+var networkInterfaceReasonErrors = map[string]error{
+	NetworkInterfaceFailureReasonCannotAllocIP:             ErrCannotAllocIP,
+	NetworkInterfaceFailureReasonCannotAllocPort:           ErrCannotAllocPort,
+	NetworkInterfaceFailureReasonNetworkDeleted:            ErrNetworkDeleted,
+	NetworkInterfaceFailureReasonUnsupportedIPFamilyPolicy: ErrUnsupportedIPFamilyPolicy,
+}
+
+var ipAddressAllocationReasonErrors = map[string]error{
+	IPAddressAllocationConditionInvalidRequestedIP:                    ErrInvalidRequestedIP,
+	IPAddressAllocationConditionFailureReasonCannotAllocIP:            ErrCannotAllocIP,
+	IPAddressAllocationConditionFailureReasonIPPoolRefRetrievalFailed: ErrIPPoolRefRetrievalFailed,
+}
+
+// ConditionError is the error for a Failure condition.
+//
+// +kubebuilder:object:generate=false
+type ConditionError struct {
+	// Reason and Message are those of the Failure condition.
+	Reason  string
+	Message string
+
+	// err is the error for Reason, nil if the reason is not known.
+	err error
+}
+
+func (e *ConditionError) Error() string {
+	if e.Message == "" {
+		return e.Reason
+	}
+	return e.Reason + ": " + e.Message
+}
+
+// Unwrap returns the error for the reason of the condition, such as
+// ErrCannotAllocIP.
+func (e *ConditionError) Unwrap() error {
+	return e.err
+}
+
+// ErrorFromConditions returns a *ConditionError if status has a Failure
+// condition with status True, and nil otherwise. The error wraps the error for
+// the reason of the condition, such as ErrCannotAllocIP, if the reason is one
+// of those defined by this package.
+func ErrorFromConditions[S *NetworkInterfaceStatus | *IPAddressAllocationStatus](status S) error {
+	switch s := any(status).(type) {
+	case *NetworkInterfaceStatus:
+		if s == nil {
+			return nil
+		}
+		for _, c := range s.Conditions {
+			if c.Type == NetworkInterfaceFailure && c.Status == metav1.ConditionTrue {
+				return &ConditionError{Reason: c.Reason, Message: c.Message, err: networkInterfaceReasonErrors[c.Reason]}
+			}
+		}
+	case *IPAddressAllocationStatus:
+		if s == nil {
+			return nil
+		}
+		for _, c := range s.Conditions {
+			if c.Type == IPAddressAllocationFail && c.Status == metav1.ConditionTrue {
+				return &ConditionError{Reason: c.Reason, Message: c.Message, err: ipAddressAllocationReasonErrors[c.Reason]}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha2_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestErrorFromConditions_NetworkInterface(t *testing.T) {
+	tests := []struct {
+		reason string
+		want   error
+	}{
+		{v1alpha2.NetworkInterfaceFailureReasonCannotAllocIP, v1alpha2.ErrCannotAllocIP},
+		{v1alpha2.NetworkInterfaceFailureReasonCannotAllocPort, v1alpha2.ErrCannotAllocPort},
+		{v1alpha2.NetworkInterfaceFailureReasonNetworkDeleted, v1alpha2.ErrNetworkDeleted},
+		{v1alpha2.NetworkInterfaceFailureReasonUnsupportedIPFamilyPolicy, v1alpha2.ErrUnsupportedIPFamilyPolicy},
+	}
+	for _, tt := range tests {
+		t.Run(tt.reason, func(t *testing.T) {
+			status := &v1alpha2.NetworkInterfaceStatus{Conditions: []metav1.Condition{
+				{Type: v1alpha2.NetworkInterfaceReady, Status: metav1.ConditionFalse},
+				{Type: v1alpha2.NetworkInterfaceFailure, Status: metav1.ConditionTrue, Reason: tt.reason, Message: "details"},
+			}}
+			err := v1alpha2.ErrorFromConditions(status)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+			if want := tt.reason + ": details"; err.Error() != want {
+				t.Errorf("expected message %q, got %q", want, err.Error())
+			}
+		})
+	}
+}
+
+func TestErrorFromConditions_IPAddressAllocation(t *testing.T) {
+	tests := []struct {
+		reason string
+		want   error
+	}{
+		{v1alpha2.IPAddressAllocationConditionInvalidRequestedIP, v1alpha2.ErrInvalidRequestedIP},
+		{v1alpha2.IPAddressAllocationConditionFailureReasonCannotAllocIP, v1alpha2.ErrCannotAllocIP},
+		{v1alpha2.IPAddressAllocationConditionFailureReasonIPPoolRefRetrievalFailed, v1alpha2.ErrIPPoolRefRetrievalFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.reason, func(t *testing.T) {
+			status := &v1alpha2.IPAddressAllocationStatus{Conditions: []metav1.Condition{
+				{Type: v1alpha2.IPAddressAllocationFail, Status: metav1.ConditionTrue, Reason: tt.reason},
+			}}
+			if err := v1alpha2.ErrorFromConditions(status); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestErrorFromConditions_NoFailure(t *testing.T) {
+	if err := v1alpha2.ErrorFromConditions((*v1alpha2.NetworkInterfaceStatus)(nil)); err != nil {
+		t.Errorf("expected no error for a nil status, got %v", err)
+	}
+	status := &v1alpha2.NetworkInterfaceStatus{Conditions: []metav1.Condition{
+		{Type: v1alpha2.NetworkInterfaceFailure, Status: metav1.ConditionFalse, Reason: v1alpha2.NetworkInterfaceFailureReasonCannotAllocIP},
+	}}
+	if err := v1alpha2.ErrorFromConditions(status); err != nil {
+		t.Errorf("expected no error for a False Failure condition, got %v", err)
+	}
+}
+
+func TestErrorFromConditions_UnknownReason(t *testing.T) {
+	status := &v1alpha2.IPAddressAllocationStatus{Conditions: []metav1.Condition{
+		{Type: v1alpha2.IPAddressAllocationFail, Status: metav1.ConditionTrue, Reason: "SomethingNew"},
+	}}
+	err := v1alpha2.ErrorFromConditions(status)
+	var conditionErr *v1alpha2.ConditionError
+	if !errors.As(err, &conditionErr) || conditionErr.Reason != "SomethingNew" {
+		t.Fatalf("expected a ConditionError with the reason, got %v", err)
+	}
+	if errors.Unwrap(err) != nil {
+		t.Errorf("expected an unknown reason to wrap no error, got %v", errors.Unwrap(err))
+	}
+}
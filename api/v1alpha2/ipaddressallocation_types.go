@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IPAddressAllocationFinalizer is a finalizer that allows the controller to perform cleanup
+// of resources associated with an IPAddressAllocation before it is removed from the API Server.
+const IPAddressAllocationFinalizer = "ipaddressallocation.netoperator.vmware.com"
+
+// Condition types that may be set in IPAddressAllocationStatus.Conditions.
+const (
+	// IPAddressAllocationReady indicates the IP has been successfully allocated.
+	IPAddressAllocationReady = "Ready"
+	// IPAddressAllocationFail indicates an error was encountered during allocation.
+	IPAddressAllocationFail = "Failure"
+)
+
+// Condition reasons that may be set on IPAddressAllocation conditions.
+const (
+	// IPAddressAllocationConditionInvalidRequestedIP is used when the IPAddressAllocation fails due to an invalid RequestedIP.
+	IPAddressAllocationConditionInvalidRequestedIP = "InvalidRequestedIP"
+	// IPAddressAllocationConditionFailureReasonCannotAllocIP is used when the IPAddressAllocation fails because an IP cannot be allocated.
+	IPAddressAllocationConditionFailureReasonCannotAllocIP = "CannotAllocIP"
+	// IPAddressAllocationConditionFailureReasonIPPoolRefRetrievalFailed is used when retrieval of the IPPoolRef has failed.
+	IPAddressAllocationConditionFailureReasonIPPoolRefRetrievalFailed = "IPPoolRefRetrievalFailed"
+)
+
+// IPAddressAllocationSpec defines the desired state of an IPAddressAllocation, including the pool reference and an optional requested IP.
+type IPAddressAllocationSpec struct {
+	// PoolRef is the reference to the network's IP pool within the namespace.
+	// It currently only supports reference to a Network.
+	PoolRef corev1.TypedLocalObjectReference `json:"poolRef"`
+	// RequestedIP is an optional field for a user to specify a particular IP they want to request.
+	// If omitted, the system will allocate a single IP address.
+	RequestedIP string `json:"requestedIP,omitempty"`
+}
+
+// IPAddressAllocationStatus contains the current status of an IPAddressAllocation, including the allocated IP address and conditions.
+type IPAddressAllocationStatus struct {
+	// IPAddress is the actually allocated IP address.
+	IPAddress string `json:"ipaddress,omitempty"`
+	// Conditions provide detailed information about the status of the allocation.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:shortName=ipalloc,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Pool",type="string",JSONPath=".spec.poolRef.name"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.ipaddress"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// IPAddressAllocation represents a request for IP address allocation, including the desired state and current status.
+type IPAddressAllocation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              IPAddressAllocationSpec   `json:"spec,omitempty"`
+	Status            IPAddressAllocationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IPAddressAllocationList is a list of IPAddressAllocation objects.
+type IPAddressAllocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPAddressAllocation `json:"items"`
+}
+
+// init function registers the IPAddressAllocation type with the scheme.
+func init() {
+	RegisterTypeWithScheme(&IPAddressAllocation{}, &IPAddressAllocationList{})
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha2
+
+import (
+	"fmt"
+	"net/netip"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Addr parses IP. It fails if IP is not a valid address, or if IPFamily is
+// set and does not match the family of IP.
+func (in *IPConfig) Addr() (netip.Addr, error) {
+	addr, err := netip.ParseAddr(in.IP)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid ip %q: %w", in.IP, err)
+	}
+	if family := ipFamilyOf(addr); in.IPFamily != "" && in.IPFamily != family {
+		return netip.Addr{}, fmt.Errorf("ip %q is %s but ipFamily is %s", in.IP, family, in.IPFamily)
+	}
+	return addr, nil
+}
+
+// IPPrefix returns IP together with its prefix length, such as 192.168.1.10/24.
+// Use Masked on the result for the subnet itself.
+//
+// The prefix length is Prefix if it is set, and is derived from SubnetMask
+// otherwise. For an IPv4 address SubnetMask is a dotted-quad mask such as
+// 255.255.255.0, and for an IPv6 address it is a mask in IPv6 notation such as
+// ffff:ffff:ffff:ffff::. IPPrefix fails if neither is set, if the mask is not
+// contiguous or not of the family of IP, or if the prefix length is too long
+// for the family of IP.
+func (in *IPConfig) IPPrefix() (netip.Prefix, error) {
+	addr, err := in.Addr()
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	// A zone does not belong in a prefix.
+	addr = addr.WithZone("")
+
+	var bits int
+	switch {
+	case in.Prefix != nil:
+		bits = int(*in.Prefix)
+		if bits < 0 || bits > addr.BitLen() {
+			return netip.Prefix{}, fmt.Errorf("invalid prefix %d for %s ip %q", bits, ipFamilyOf(addr), in.IP)
+		}
+	case in.SubnetMask != "":
+		if bits, err = maskBits(in.SubnetMask, addr); err != nil {
+			return netip.Prefix{}, err
+		}
+	default:
+		return netip.Prefix{}, fmt.Errorf("neither prefix nor subnetMask is set for ip %q", in.IP)
+	}
+	return netip.PrefixFrom(addr, bits), nil
+}
+
+// GatewayAddr parses Gateway. It returns the zero netip.Addr, which is not
+// valid, if Gateway is empty. It fails if Gateway is not a valid address or is
+// not of the family of IP.
+func (in *IPConfig) GatewayAddr() (netip.Addr, error) {
+	if in.Gateway == "" {
+		return netip.Addr{}, nil
+	}
+	gateway, err := netip.ParseAddr(in.Gateway)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("invalid gateway %q: %w", in.Gateway, err)
+	}
+	if addr, err := netip.ParseAddr(in.IP); err == nil && addr.Is4() != gateway.Is4() {
+		return netip.Addr{}, fmt.Errorf("gateway %q is not of the family of ip %q", in.Gateway, in.IP)
+	}
+	return gateway, nil
+}
+
+// maskBits returns the length of the contiguous mask for addr.
+func maskBits(mask string, addr netip.Addr) (int, error) {
+	m, err := netip.ParseAddr(mask)
+	if err != nil {
+		return 0, fmt.Errorf("invalid subnetMask %q: %w", mask, err)
+	}
+	if m.Is4() != addr.Is4() {
+		return 0, fmt.Errorf("subnetMask %q is not of the family of ip %s", mask, addr)
+	}
+	b := m.AsSlice()
+	bits := 0
+	for bits < len(b)*8 && b[bits/8]&(0x80>>(bits%8)) != 0 {
+		bits++
+	}
+	for i := bits; i < len(b)*8; i++ {
+		if b[i/8]&(0x80>>(i%8)) != 0 {
+			return 0, fmt.Errorf("subnetMask %q is not contiguous", mask)
+		}
+	}
+	return bits, nil
+}
+
+func ipFamilyOf(addr netip.Addr) corev1.IPFamily {
+	if addr.Is4() {
+		return corev1.IPv4Protocol
+	}
+	return corev1.IPv6Protocol
+}
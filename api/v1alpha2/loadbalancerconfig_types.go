@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClientSecretReference contains info to locate an object of Kind Secret
+// which contains credential specifications for a load balancer.
+type ClientSecretReference struct {
+	// Name is the name of resource being referenced.
+	Name string `json:"name"`
+	// Namespace of the resource being referenced. If empty, cluster scoped resource is assumed.
+	// +kubebuilder:default:=default
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// Condition types that may be set in LoadBalancerConfigStatus.Conditions.
+const (
+	// LoadBalancerConfigReady is added when the LoadBalancerConfig object has been successfully realized
+	LoadBalancerConfigReady = "Ready"
+	// LoadBalancerConfigFailure is added if any failure is encountered while realizing LoadBalancerConfig object
+	LoadBalancerConfigFailure = "Failure"
+	// LoadBalancerConfigIPPoolPressure condition status is set to True when IPPool is low on free IPs.
+	LoadBalancerConfigIPPoolPressure = "IPPoolPressure"
+)
+
+// LoadBalancerConfigProviderReference represents the specific load balancer instance that needs to be configured
+type LoadBalancerConfigProviderReference struct {
+	// APIGroup is the group for the resource being referenced
+	APIGroup string `json:"apiGroup"`
+	// Kind is the type of resource being referenced
+	Kind string `json:"kind"`
+	// Name is the name of resource being referenced
+	Name string `json:"name"`
+	// API version of the referent
+	APIVersion string `json:"apiVersion,omitempty"`
+}
+
+type LoadBalancerConfigType string
+
+const (
+	// LoadBalancerConfigTypeHAProxy is the LoadBalancerConfigType for HAProxy.
+	LoadBalancerConfigTypeHAProxy LoadBalancerConfigType = "haproxy"
+
+	// LoadBalancerConfigTypeAvi is the LoadBalancerConfigType for Avi.
+	LoadBalancerConfigTypeAvi LoadBalancerConfigType = "avi"
+
+	// LoadBalancerConfigTypeFoundation is the FoundationLoadBalancerConfigType for VCF Foundation Load Balancer.
+	LoadBalancerConfigTypeFoundation LoadBalancerConfigType = "foundation"
+)
+
+// LoadBalancerConfigSpec defines the desired state of LoadBalancerConfig
+type LoadBalancerConfigSpec struct {
+	// Type describes type of load balancer.
+	// +kubebuilder:validation:Enum=haproxy;avi;foundation
+	Type LoadBalancerConfigType `json:"type"`
+	// ProviderRef is reference to a load balancer provider object that provides the details for this type of load balancer
+	ProviderRef LoadBalancerConfigProviderReference `json:"providerRef"`
+}
+
+// LoadBalancerConfigStatus defines the observed state of LoadBalancerConfig
+type LoadBalancerConfigStatus struct {
+	// Conditions is an array of current observed load balancer conditions
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,shortName=lbc,categories=netop
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.providerRef.name",priority=1
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// LoadBalancerConfig is the Schema for the LoadBalancerConfigs API
+type LoadBalancerConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoadBalancerConfigSpec   `json:"spec,omitempty"`
+	Status LoadBalancerConfigStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoadBalancerConfigList contains a list of LoadBalancerConfig
+type LoadBalancerConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoadBalancerConfig `json:"items"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&LoadBalancerConfig{}, &LoadBalancerConfigList{})
+}
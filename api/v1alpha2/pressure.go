@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha2
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Reasons of the IPPoolFull and IPPoolPressure conditions.
+const (
+	// IPPoolReasonExhausted is used when no IP is free.
+	IPPoolReasonExhausted = "Exhausted"
+	// IPPoolReasonLowOnFreeIPs is used when fewer IPs are free than the
+	// IPPoolPressurePolicy requires.
+	IPPoolReasonLowOnFreeIPs = "LowOnFreeIPs"
+	// IPPoolReasonSufficientFreeIPs is used when enough IPs are free.
+	IPPoolReasonSufficientFreeIPs = "SufficientFreeIPs"
+)
+
+// IPPoolPressurePolicy defines when IPPools are low on free IPs. The IPPools
+// are under pressure if either threshold is crossed. A threshold of zero is
+// disabled.
+type IPPoolPressurePolicy struct {
+	// MinFreeIPs is the number of free IPs below which the IPPools are under
+	// pressure.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinFreeIPs int64 `json:"minFreeIPs,omitempty"`
+	// MinFreePercent is the percentage of free IPs below which the IPPools are
+	// under pressure.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MinFreePercent int32 `json:"minFreePercent,omitempty"`
+}
+
+// DefaultIPPoolPressurePolicy is the policy used when none is configured: the
+// IPPools are under pressure when less than 10% of their IPs are free.
+var DefaultIPPoolPressurePolicy = IPPoolPressurePolicy{MinFreePercent: 10}
+
+// UnderPressure reports whether free IPs out of total cross a threshold of p.
+func (p IPPoolPressurePolicy) UnderPressure(free, total *big.Int) bool {
+	if free.Sign() <= 0 {
+		return total.Sign() > 0
+	}
+	if p.MinFreeIPs > 0 && free.Cmp(big.NewInt(p.MinFreeIPs)) < 0 {
+		return true
+	}
+	if p.MinFreePercent > 0 {
+		// free / total < percent / 100
+		left := new(big.Int).Mul(free, big.NewInt(100))
+		right := new(big.Int).Mul(total, big.NewInt(int64(p.MinFreePercent)))
+		return left.Cmp(right) < 0
+	}
+	return false
+}
+
+// IPPoolFullCondition computes the IPPoolFull condition of pool from its
+// AddressCount and Allocated: True with reason IPPoolReasonExhausted if no IP
+// is free, and False with reason IPPoolReasonSufficientFreeIPs otherwise. The
+// ObservedGeneration and LastTransitionTime are left for the caller to set.
+func IPPoolFullCondition(pool *IPPool) metav1.Condition {
+	free := pool.Spec.AddressCount - pool.Status.Allocated
+	if free <= 0 {
+		return metav1.Condition{
+			Type:    IPPoolFull,
+			Status:  metav1.ConditionTrue,
+			Reason:  IPPoolReasonExhausted,
+			Message: fmt.Sprintf("all %d IPs are allocated", pool.Spec.AddressCount),
+		}
+	}
+	return metav1.Condition{
+		Type:    IPPoolFull,
+		Status:  metav1.ConditionFalse,
+		Reason:  IPPoolReasonSufficientFreeIPs,
+		Message: fmt.Sprintf("%d of %d IPs are free", free, pool.Spec.AddressCount),
+	}
+}
+
+// VSphereDistributedNetworkIPPoolPressureCondition computes the
+// VsphereDistributedNetworkIPPoolPressure condition from pools, the IPPools
+// referenced by the VSphereDistributedNetwork. See IPPoolPressureCondition.
+func VSphereDistributedNetworkIPPoolPressureCondition(policy IPPoolPressurePolicy, pools []IPPool) metav1.Condition {
+	return IPPoolPressureCondition(VsphereDistributedNetworkIPPoolPressure, policy, pools)
+}
+
+// LoadBalancerConfigIPPoolPressureCondition computes the
+// LoadBalancerConfigIPPoolPressure condition from pools, the IPPools
+// referenced by the LoadBalancerConfig. See IPPoolPressureCondition.
+func LoadBalancerConfigIPPoolPressureCondition(policy IPPoolPressurePolicy, pools []IPPool) metav1.Condition {
+	return IPPoolPressureCondition(LoadBalancerConfigIPPoolPressure, policy, pools)
+}
+
+// IPPoolPressureCondition computes a condition of type conditionType that
+// reports whether pools are low on free IPs according to policy. The free and
+// total IPs are summed over the pools of each IP family, and the condition is:
+//
+//   - True with reason IPPoolReasonExhausted if no IP of a family is free.
+//   - True with reason IPPoolReasonLowOnFreeIPs if a family crosses a
+//     threshold of policy.
+//   - False with reason IPPoolReasonSufficientFreeIPs otherwise, including
+//     when there are no pools.
+//
+// Families are considered separately so that a large IPv6 pool does not hide
+// an exhausted IPv4 one. Pools with an invalid StartingAddress are ignored.
+// The ObservedGeneration and LastTransitionTime are left for the caller to
+// set.
+func IPPoolPressureCondition(conditionType string, policy IPPoolPressurePolicy, pools []IPPool) metav1.Condition {
+	type usage struct {
+		family      string
+		free, total *big.Int
+	}
+	var families []*usage
+	byFamily := map[string]*usage{}
+	for i := range pools {
+		addr, err := netip.ParseAddr(pools[i].Spec.StartingAddress)
+		if err != nil {
+			continue
+		}
+		family := "IPv6"
+		if addr.Is4() {
+			family = "IPv4"
+		}
+		u, ok := byFamily[family]
+		if !ok {
+			u = &usage{family: family, free: new(big.Int), total: new(big.Int)}
+			byFamily[family] = u
+			families = append(families, u)
+		}
+		u.total.Add(u.total, big.NewInt(pools[i].Spec.AddressCount))
+		if free := pools[i].Spec.AddressCount - pools[i].Status.Allocated; free > 0 {
+			u.free.Add(u.free, big.NewInt(free))
+		}
+	}
+
+	c := metav1.Condition{Type: conditionType, Status: metav1.ConditionFalse, Reason: IPPoolReasonSufficientFreeIPs}
+	var messages []string
+	for _, u := range families {
+		messages = append(messages, fmt.Sprintf("%s: %s of %s IPs free", u.family, u.free, u.total))
+		switch {
+		case u.free.Sign() <= 0 && u.total.Sign() > 0:
+			c.Status, c.Reason = metav1.ConditionTrue, IPPoolReasonExhausted
+		case policy.UnderPressure(u.free, u.total) && c.Reason != IPPoolReasonExhausted:
+			c.Status, c.Reason = metav1.ConditionTrue, IPPoolReasonLowOnFreeIPs
+		}
+	}
+	if len(messages) == 0 {
+		c.Message = "no IPPools"
+	} else {
+		c.Message = strings.Join(messages, "; ")
+	}
+	return c
+}
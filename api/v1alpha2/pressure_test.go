@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha2_test
+
+import (
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func pool(start string, count, allocated int64) v1alpha2.IPPool {
+	return v1alpha2.IPPool{
+		Spec:   v1alpha2.IPPoolSpec{StartingAddress: start, AddressCount: count},
+		Status: v1alpha2.IPPoolStatus{Allocated: allocated},
+	}
+}
+
+func TestIPPoolFullCondition(t *testing.T) {
+	tests := []struct {
+		pool       v1alpha2.IPPool
+		wantStatus metav1.ConditionStatus
+		wantReason string
+		wantMsg    string
+	}{
+		{pool("10.0.0.0", 16, 16), metav1.ConditionTrue, v1alpha2.IPPoolReasonExhausted, "all 16 IPs are allocated"},
+		{pool("10.0.0.0", 16, 17), metav1.ConditionTrue, v1alpha2.IPPoolReasonExhausted, "all 16 IPs are allocated"},
+		{pool("10.0.0.0", 16, 15), metav1.ConditionFalse, v1alpha2.IPPoolReasonSufficientFreeIPs, "1 of 16 IPs are free"},
+	}
+	for _, tt := range tests {
+		got := v1alpha2.IPPoolFullCondition(&tt.pool)
+		checkCondition(t, got, v1alpha2.IPPoolFull, tt.wantStatus, tt.wantReason, tt.wantMsg)
+	}
+}
+
+func TestIPPoolPressureCondition(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     v1alpha2.IPPoolPressurePolicy
+		pools      []v1alpha2.IPPool
+		wantStatus metav1.ConditionStatus
+		wantReason string
+		wantMsg    string
+	}{
+		{
+			name:       "no pools",
+			policy:     v1alpha2.DefaultIPPoolPressurePolicy,
+			wantStatus: metav1.ConditionFalse,
+			wantReason: v1alpha2.IPPoolReasonSufficientFreeIPs,
+			wantMsg:    "no IPPools",
+		},
+		{
+			name:       "summed over pools",
+			policy:     v1alpha2.DefaultIPPoolPressurePolicy,
+			pools:      []v1alpha2.IPPool{pool("10.0.0.0", 100, 100), pool("10.0.1.0", 100, 80)},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: v1alpha2.IPPoolReasonSufficientFreeIPs,
+			wantMsg:    "IPv4: 20 of 200 IPs free",
+		},
+		{
+			name:       "below the default percentage",
+			policy:     v1alpha2.DefaultIPPoolPressurePolicy,
+			pools:      []v1alpha2.IPPool{pool("10.0.0.0", 100, 100), pool("10.0.1.0", 100, 81)},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: v1alpha2.IPPoolReasonLowOnFreeIPs,
+			wantMsg:    "IPv4: 19 of 200 IPs free",
+		},
+		{
+			name:       "below the minimum count",
+			policy:     v1alpha2.IPPoolPressurePolicy{MinFreeIPs: 32},
+			pools:      []v1alpha2.IPPool{pool("10.0.0.0", 256, 225)},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: v1alpha2.IPPoolReasonLowOnFreeIPs,
+			wantMsg:    "IPv4: 31 of 256 IPs free",
+		},
+		{
+			name:       "disabled thresholds",
+			policy:     v1alpha2.IPPoolPressurePolicy{},
+			pools:      []v1alpha2.IPPool{pool("10.0.0.0", 256, 255)},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: v1alpha2.IPPoolReasonSufficientFreeIPs,
+			wantMsg:    "IPv4: 1 of 256 IPs free",
+		},
+		{
+			name:       "exhausted even with disabled thresholds",
+			policy:     v1alpha2.IPPoolPressurePolicy{},
+			pools:      []v1alpha2.IPPool{pool("10.0.0.0", 256, 256)},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: v1alpha2.IPPoolReasonExhausted,
+			wantMsg:    "IPv4: 0 of 256 IPs free",
+		},
+		{
+			name:   "IPv6 does not hide an exhausted IPv4 family",
+			policy: v1alpha2.DefaultIPPoolPressurePolicy,
+			pools: []v1alpha2.IPPool{
+				pool("fd00::", 1<<62, 0),
+				pool("fd01::", 1<<62, 0),
+				pool("10.0.0.0", 16, 16),
+				pool("fd02::", 1<<62, 0),
+			},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: v1alpha2.IPPoolReasonExhausted,
+			wantMsg:    "IPv6: 13835058055282163712 of 13835058055282163712 IPs free; IPv4: 0 of 16 IPs free",
+		},
+		{
+			name:       "invalid pools are ignored",
+			policy:     v1alpha2.DefaultIPPoolPressurePolicy,
+			pools:      []v1alpha2.IPPool{pool("bogus", 16, 16), pool("10.0.0.0", 16, 0)},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: v1alpha2.IPPoolReasonSufficientFreeIPs,
+			wantMsg:    "IPv4: 16 of 16 IPs free",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := v1alpha2.IPPoolPressureCondition("Pressure", tt.policy, tt.pools)
+			checkCondition(t, got, "Pressure", tt.wantStatus, tt.wantReason, tt.wantMsg)
+		})
+	}
+}
+
+func TestOwnerIPPoolPressureConditions(t *testing.T) {
+	pools := []v1alpha2.IPPool{pool("10.0.0.0", 16, 16)}
+	vds := v1alpha2.VSphereDistributedNetworkIPPoolPressureCondition(v1alpha2.DefaultIPPoolPressurePolicy, pools)
+	checkCondition(t, vds, v1alpha2.VsphereDistributedNetworkIPPoolPressure, metav1.ConditionTrue, v1alpha2.IPPoolReasonExhausted, "IPv4: 0 of 16 IPs free")
+	lbc := v1alpha2.LoadBalancerConfigIPPoolPressureCondition(v1alpha2.DefaultIPPoolPressurePolicy, pools)
+	checkCondition(t, lbc, v1alpha2.LoadBalancerConfigIPPoolPressure, metav1.ConditionTrue, v1alpha2.IPPoolReasonExhausted, "IPv4: 0 of 16 IPs free")
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha2
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// WorkloadNetworkReadyCondition computes the WorkloadNetworkConditionReady
+// condition from the other conditions in status, its sub-conditions:
+//
+//   - False with reason WorkloadNetworkReasonFailed if any sub-condition is
+//     False with that reason.
+//   - False with reason WorkloadNetworkReasonPending if any other sub-condition
+//     is not True, or if WorkloadNetworkConditionSystemReady is missing.
+//   - True with reason WorkloadNetworkReasonReconciled otherwise.
+//
+// The message merges the messages of the sub-conditions that are not True.
+// The ObservedGeneration and LastTransitionTime are left for the caller to
+// set.
+func WorkloadNetworkReadyCondition(status *WorkloadNetworkConfigurationStatus) metav1.Condition {
+	r := readiness{}
+	systemReported := false
+	if status != nil {
+		for _, c := range status.Conditions {
+			if c.Type == WorkloadNetworkConditionReady {
+				continue
+			}
+			systemReported = systemReported || c.Type == WorkloadNetworkConditionSystemReady
+			r.addCondition(c, WorkloadNetworkReasonFailed)
+		}
+	}
+	if !systemReported {
+		r.pending = append(r.pending, WorkloadNetworkConditionSystemReady+": not yet reported")
+	}
+	return r.condition(WorkloadNetworkConditionReady,
+		WorkloadNetworkReasonReconciled, WorkloadNetworkReasonPending, WorkloadNetworkReasonFailed)
+}
+
+// NamespaceNetworkReadyCondition computes the NamespaceNetworkConditionReady
+// condition from the other conditions in status, its sub-conditions, and from
+// the AssociatedNamespaces:
+//
+//   - False with reason NamespaceNetworkReasonFailed if any sub-condition is
+//     False with that reason.
+//   - False with reason NamespaceNetworkReasonPending if any other
+//     sub-condition is not True, or if any associated Namespace is not
+//     NamespaceNetworkReconciled.
+//   - True with reason NamespaceNetworkReasonReconciled otherwise, including
+//     when there are no sub-conditions and no associated Namespaces.
+//
+// The message merges the messages of the sub-conditions that are not True
+// and of the Namespaces that are not reconciled. The ObservedGeneration and
+// LastTransitionTime are left for the caller to set.
+func NamespaceNetworkReadyCondition(status *NamespaceNetworkStatus) metav1.Condition {
+	r := readiness{}
+	if status != nil {
+		for _, c := range status.Conditions {
+			if c.Type != NamespaceNetworkConditionReady {
+				r.addCondition(c, NamespaceNetworkReasonFailed)
+			}
+		}
+		for _, ns := range status.AssociatedNamespaces {
+			if ns.Status == NamespaceNetworkReconciled {
+				continue
+			}
+			message := ns.Message
+			if message == "" && ns.Status == "" {
+				message = "not yet reconciled"
+			} else if message == "" {
+				message = string(NamespaceNetworkReconciling)
+			}
+			r.pending = append(r.pending, fmt.Sprintf("namespace %s: %s", ns.Name, message))
+		}
+	}
+	return r.condition(NamespaceNetworkConditionReady,
+		NamespaceNetworkReasonReconciled, NamespaceNetworkReasonPending, NamespaceNetworkReasonFailed)
+}
+
+// readiness collects the messages of the parts of an aggregate condition that
+// are not ready.
+type readiness struct {
+	failed  []string
+	pending []string
+}
+
+// addCondition records c if it is not True. c is failed if it is False with
+// the failed reason.
+func (r *readiness) addCondition(c metav1.Condition, failedReason string) {
+	if c.Status == metav1.ConditionTrue {
+		return
+	}
+	message := c.Message
+	if message == "" {
+		message = c.Reason
+	}
+	if message == "" {
+		message = "status " + string(c.Status)
+	}
+	message = c.Type + ": " + message
+	if c.Status == metav1.ConditionFalse && c.Reason == failedReason {
+		r.failed = append(r.failed, message)
+		return
+	}
+	r.pending = append(r.pending, message)
+}
+
+func (r *readiness) condition(conditionType, readyReason, pendingReason, failedReason string) metav1.Condition {
+	c := metav1.Condition{Type: conditionType}
+	switch {
+	case len(r.failed) > 0:
+		c.Status = metav1.ConditionFalse
+		c.Reason = failedReason
+		c.Message = strings.Join(append(r.failed, r.pending...), "; ")
+	case len(r.pending) > 0:
+		c.Status = metav1.ConditionFalse
+		c.Reason = pendingReason
+		c.Message = strings.Join(r.pending, "; ")
+	default:
+		c.Status = metav1.ConditionTrue
+		c.Reason = readyReason
+	}
+	return c
+}
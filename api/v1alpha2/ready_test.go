@@ -0,0 +1,142 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha2_test
+
+import (
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func condition(conditionType string, status metav1.ConditionStatus, reason, message string) metav1.Condition {
+	return metav1.Condition{Type: conditionType, Status: status, Reason: reason, Message: message}
+}
+
+func checkCondition(t *testing.T, got metav1.Condition, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	t.Helper()
+	want := condition(conditionType, status, reason, message)
+	if got != want {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestWorkloadNetworkReadyCondition(t *testing.T) {
+	systemReady := v1alpha2.WorkloadNetworkConditionSystemReady
+	tests := []struct {
+		name       string
+		status     *v1alpha2.WorkloadNetworkConfigurationStatus
+		wantStatus metav1.ConditionStatus
+		wantReason string
+		wantMsg    string
+	}{
+		{
+			name:       "no status",
+			wantStatus: metav1.ConditionFalse,
+			wantReason: v1alpha2.WorkloadNetworkReasonPending,
+			wantMsg:    systemReady + ": not yet reported",
+		},
+		{
+			name: "system ready",
+			status: &v1alpha2.WorkloadNetworkConfigurationStatus{Conditions: []metav1.Condition{
+				condition(v1alpha2.WorkloadNetworkConditionReady, metav1.ConditionFalse, v1alpha2.WorkloadNetworkReasonPending, "stale"),
+				condition(systemReady, metav1.ConditionTrue, "Reconciled", ""),
+			}},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: v1alpha2.WorkloadNetworkReasonReconciled,
+		},
+		{
+			name: "system pending",
+			status: &v1alpha2.WorkloadNetworkConfigurationStatus{Conditions: []metav1.Condition{
+				condition(systemReady, metav1.ConditionFalse, v1alpha2.WorkloadNetworkReasonPending, "creating the VPC"),
+			}},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: v1alpha2.WorkloadNetworkReasonPending,
+			wantMsg:    systemReady + ": creating the VPC",
+		},
+		{
+			name: "failure wins over pending",
+			status: &v1alpha2.WorkloadNetworkConfigurationStatus{Conditions: []metav1.Condition{
+				condition("Other", metav1.ConditionUnknown, "", ""),
+				condition(systemReady, metav1.ConditionFalse, v1alpha2.WorkloadNetworkReasonFailed, "no such project"),
+			}},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: v1alpha2.WorkloadNetworkReasonFailed,
+			wantMsg:    systemReady + ": no such project; Other: status Unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := v1alpha2.WorkloadNetworkReadyCondition(tt.status)
+			checkCondition(t, got, v1alpha2.WorkloadNetworkConditionReady, tt.wantStatus, tt.wantReason, tt.wantMsg)
+		})
+	}
+}
+
+func TestNamespaceNetworkReadyCondition(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     *v1alpha2.NamespaceNetworkStatus
+		wantStatus metav1.ConditionStatus
+		wantReason string
+		wantMsg    string
+	}{
+		{
+			name:       "no status",
+			wantStatus: metav1.ConditionTrue,
+			wantReason: v1alpha2.NamespaceNetworkReasonReconciled,
+		},
+		{
+			name: "all namespaces reconciled",
+			status: &v1alpha2.NamespaceNetworkStatus{AssociatedNamespaces: []v1alpha2.NamespaceNetworkAssociation{
+				{Name: "ns1", Status: v1alpha2.NamespaceNetworkReconciled},
+				{Name: "ns2", Status: v1alpha2.NamespaceNetworkReconciled},
+			}},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: v1alpha2.NamespaceNetworkReasonReconciled,
+		},
+		{
+			name: "namespaces reconciling",
+			status: &v1alpha2.NamespaceNetworkStatus{AssociatedNamespaces: []v1alpha2.NamespaceNetworkAssociation{
+				{Name: "ns1", Status: v1alpha2.NamespaceNetworkReconciling, Message: "creating subnets"},
+				{Name: "ns2", Status: v1alpha2.NamespaceNetworkReconciled},
+				{Name: "ns3", Status: v1alpha2.NamespaceNetworkReconciling},
+			}},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: v1alpha2.NamespaceNetworkReasonPending,
+			wantMsg:    "namespace ns1: creating subnets; namespace ns3: Reconciling",
+		},
+		{
+			name: "namespaces not yet reconciled",
+			status: &v1alpha2.NamespaceNetworkStatus{AssociatedNamespaces: []v1alpha2.NamespaceNetworkAssociation{
+				{Name: "ns1"},
+				{Name: "ns2", Message: "waiting for the gateway"},
+			}},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: v1alpha2.NamespaceNetworkReasonPending,
+			wantMsg:    "namespace ns1: not yet reconciled; namespace ns2: waiting for the gateway",
+		},
+		{
+			name: "failed sub-condition",
+			status: &v1alpha2.NamespaceNetworkStatus{
+				Conditions: []metav1.Condition{
+					condition("GatewayReady", metav1.ConditionFalse, v1alpha2.NamespaceNetworkReasonFailed, "tier-0 not found"),
+				},
+				AssociatedNamespaces: []v1alpha2.NamespaceNetworkAssociation{
+					{Name: "ns1", Status: v1alpha2.NamespaceNetworkReconciling},
+				},
+			},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: v1alpha2.NamespaceNetworkReasonFailed,
+			wantMsg:    "GatewayReady: tier-0 not found; namespace ns1: Reconciling",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := v1alpha2.NamespaceNetworkReadyCondition(tt.status)
+			checkCondition(t, got, v1alpha2.NamespaceNetworkConditionReady, tt.wantStatus, tt.wantReason, tt.wantMsg)
+		})
+	}
+}
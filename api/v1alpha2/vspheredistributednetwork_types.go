@@ -0,0 +1,327 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition types that may be set in VSphereDistributedNetworkStatus.Conditions.
+const (
+	// VSphereDistributedNetworkPortGroupFailure is added when PortGroupID specified either doesn't exist, or
+	// there was an error in communicating with vCenter Server.
+	VSphereDistributedNetworkPortGroupFailure = "PortGroupFailure"
+	// VSphereDistributedNetworkIPPoolInvalid is added when no valid IPPool references exists.
+	VSphereDistributedNetworkIPPoolInvalid = "IPPoolInvalid"
+	// VsphereDistributedNetworkIPPoolPressure condition status is set to True when IPPool is low on free IPs.
+	VsphereDistributedNetworkIPPoolPressure = "IPPoolPressure"
+)
+
+type IPAssignmentModeType string
+
+const (
+	// IPAssignmentModeDHCP indicates IP address is assigned dynamically using DHCP.
+	IPAssignmentModeDHCP IPAssignmentModeType = "dhcp"
+	// IPAssignmentModeStaticPool indicates IP address is assigned from a static pool of IP addresses.
+	IPAssignmentModeStaticPool IPAssignmentModeType = "staticpool"
+	// IPAssignmentModeNone indicates that no IP assignment will be performed.
+	// The operator will not assign an IP and no DHCP client will be configured.
+	IPAssignmentModeNone IPAssignmentModeType = "none"
+)
+
+// VSphereDistributedNetworkIPRange is the static IP range for a VSphereDistributedNetwork.
+type VSphereDistributedNetworkIPRange struct {
+	// address is the starting IPv4 or IPv6 address of the range.
+	// The items of the atomic addressRanges list cannot be compared to the stored
+	// object, so an address that the earlier patterns accepted, such as one with
+	// leading zeros, stays valid.
+	// +kubebuilder:validation:XValidation:rule="isIP(self) || self.matches('^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\\\\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)$') || self.matches('^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:))$')",message="Address must be a valid IPv4 or IPv6 address"
+	// +kubebuilder:validation:MinLength=2
+	// +kubebuilder:validation:MaxLength=45
+	// +required
+	Address string `json:"address,omitempty"`
+
+	// count is the number of addresses in the range when using static range assignment.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	Count int64 `json:"count,omitempty"`
+}
+
+// +kubebuilder:validation:XValidation:rule="(has(self.ipAssignmentMode) && (self.ipAssignmentMode == 'dhcp' || self.ipAssignmentMode == 'none')) ? (!has(self.gateway) || self.gateway == '') : true",message="Gateway must be empty when IpAssignmentMode is dhcp or none"
+// +kubebuilder:validation:XValidation:rule="(has(self.ipAssignmentMode) && (self.ipAssignmentMode == 'dhcp' || self.ipAssignmentMode == 'none')) ? (!has(self.subnetMask) || self.subnetMask == '') : true",message="SubnetMask must be empty when IpAssignmentMode is dhcp or none"
+// +kubebuilder:validation:XValidation:rule="(has(self.ipAssignmentMode) && (self.ipAssignmentMode == 'dhcp' || self.ipAssignmentMode == 'none')) ? (!has(self.addressRanges) || size(self.addressRanges) == 0) : true",message="AddressRanges must be empty when IpAssignmentMode is dhcp or none"
+// +kubebuilder:validation:XValidation:rule="(has(self.ipAssignmentMode) && (self.ipAssignmentMode == 'dhcp' || self.ipAssignmentMode == 'none')) ? (!has(self.ipPools) || size(self.ipPools) == 0) : true",message="IPPools must be empty when IpAssignmentMode is dhcp or none"
+// +kubebuilder:validation:XValidation:rule="(!has(self.ipAssignmentMode) || self.ipAssignmentMode == 'staticpool') ? (has(self.gateway) && self.gateway != '') : true",message="Gateway is required when IpAssignmentMode is staticpool or unset"
+// +kubebuilder:validation:XValidation:rule="(!has(self.ipAssignmentMode) || self.ipAssignmentMode == 'staticpool') ? (has(self.subnetMask) && self.subnetMask != '') : true",message="SubnetMask is required when IpAssignmentMode is staticpool or unset"
+// +kubebuilder:validation:XValidation:rule="(oldSelf.hasValue() && has(oldSelf.value().ipv6AssignmentMode) == has(self.ipv6AssignmentMode) && (!has(self.ipv6AssignmentMode) || oldSelf.value().ipv6AssignmentMode == self.ipv6AssignmentMode) && has(oldSelf.value().ipv6Gateway) == has(self.ipv6Gateway) && (!has(self.ipv6Gateway) || oldSelf.value().ipv6Gateway == self.ipv6Gateway)) || ((!has(self.ipv6AssignmentMode) || self.ipv6AssignmentMode == 'dhcp' || self.ipv6AssignmentMode == 'none') ? (!has(self.ipv6Gateway) || self.ipv6Gateway == '') : true)",message="IPv6Gateway must be empty when IPv6AssignmentMode is dhcp, none or unset",optionalOldSelf=true
+// +kubebuilder:validation:XValidation:rule="(oldSelf.hasValue() && has(oldSelf.value().ipv6AssignmentMode) == has(self.ipv6AssignmentMode) && (!has(self.ipv6AssignmentMode) || oldSelf.value().ipv6AssignmentMode == self.ipv6AssignmentMode) && has(oldSelf.value().ipv6Prefix) == has(self.ipv6Prefix) && (!has(self.ipv6Prefix) || oldSelf.value().ipv6Prefix == self.ipv6Prefix)) || ((!has(self.ipv6AssignmentMode) || self.ipv6AssignmentMode == 'dhcp' || self.ipv6AssignmentMode == 'none') ? !has(self.ipv6Prefix) : true)",message="IPv6Prefix must be unset when IPv6AssignmentMode is dhcp, none or unset",optionalOldSelf=true
+// +kubebuilder:validation:XValidation:rule="(oldSelf.hasValue() && has(oldSelf.value().ipv6AssignmentMode) == has(self.ipv6AssignmentMode) && (!has(self.ipv6AssignmentMode) || oldSelf.value().ipv6AssignmentMode == self.ipv6AssignmentMode) && has(oldSelf.value().ipv6Gateway) == has(self.ipv6Gateway) && (!has(self.ipv6Gateway) || oldSelf.value().ipv6Gateway == self.ipv6Gateway)) || ((has(self.ipv6AssignmentMode) && self.ipv6AssignmentMode == 'staticpool') ? (has(self.ipv6Gateway) && self.ipv6Gateway != '') : true)",message="IPv6Gateway is required when IPv6AssignmentMode is staticpool",optionalOldSelf=true
+// +kubebuilder:validation:XValidation:rule="(oldSelf.hasValue() && has(oldSelf.value().ipv6AssignmentMode) == has(self.ipv6AssignmentMode) && (!has(self.ipv6AssignmentMode) || oldSelf.value().ipv6AssignmentMode == self.ipv6AssignmentMode) && has(oldSelf.value().ipv6Prefix) == has(self.ipv6Prefix) && (!has(self.ipv6Prefix) || oldSelf.value().ipv6Prefix == self.ipv6Prefix)) || ((has(self.ipv6AssignmentMode) && self.ipv6AssignmentMode == 'staticpool') ? has(self.ipv6Prefix) : true)",message="IPv6Prefix is required when IPv6AssignmentMode is staticpool",optionalOldSelf=true
+// VSphereDistributedNetworkSpec defines the desired state of VSphereDistributedNetwork.
+type VSphereDistributedNetworkSpec struct {
+	// PortGroupID is an existing vSphere Distributed PortGroup identifier.
+	PortGroupID string `json:"portGroupID"`
+
+	// ipAssignmentMode to use for IPv4 addresses on network interfaces.
+	// For IPAssignmentModeDHCP and IPAssignmentModeNone, the IPv4 IPPools, Gateway and SubnetMask
+	// fields should be empty/unset. When using IPAssignmentModeNone, no IPv4 IP will be assigned
+	// and no DHCP client will be configured.
+	// Note: For IPv6 address assignment, see IPv6AssignmentMode.
+	// +kubebuilder:validation:Enum=dhcp;staticpool;none
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="ipAssignmentMode is immutable"
+	// +optional
+	IPAssignmentMode IPAssignmentModeType `json:"ipAssignmentMode,omitempty"`
+
+	// IPv6AssignmentMode to use for IPv6 addresses on network interfaces. If unset, defaults to
+	// IPAssignmentModeNone (IPv6 disabled) for backward compatibility with existing IPv4-only
+	// deployments. To enable IPv6 support, explicitly set this field to IPAssignmentModeStaticPool
+	// or IPAssignmentModeDHCP. This allows different assignment modes for IPv4 and IPv6, for
+	// example static IPv4 pool assignment combined with DHCPv6 for IPv6.
+	// When unset, IPAssignmentModeDHCP or IPAssignmentModeNone, IPv6Gateway and IPv6Prefix must be unset.
+	// For IPAssignmentModeStaticPool, they are required.
+	// +kubebuilder:validation:XValidation:rule="self in ['dhcp', 'staticpool', 'none'] || (oldSelf.hasValue() && oldSelf.value() == self)",message="IPv6AssignmentMode must be dhcp, staticpool or none",optionalOldSelf=true
+	// +optional
+	IPv6AssignmentMode IPAssignmentModeType `json:"ipv6AssignmentMode,omitempty"`
+
+	// IPPools references list of IPPool objects. This field should only be set when using
+	// IPAssignmentModeStaticPool. For all other modes (IPAssignmentModeDHCP, IPAssignmentModeNone), this should be set
+	// to an empty list.
+	// When addressRanges is non-empty, the operator reconciles ipPools against those ranges:
+	// references that do not correspond to any address range are removed, new references are added
+	// where needed, and every retained reference (including ones that already matched a range) is reconciled.
+	// +kubebuilder:default:={}
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	IPPools []IPPoolReference `json:"ipPools"`
+
+	// Gateway setting to use for network interfaces. This field should only be set when using
+	// IPAssignmentModeStaticPool. For all other modes (IPAssignmentModeDHCP, IPAssignmentModeNone), this should be set
+	// to an empty string.
+	// Note: The value must be a valid IPv4 address, but an empty string is allowed for backward compatibility.
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:XValidation:rule="self == '' || (isIP(self) && ip(self).family() == 4) || (oldSelf.hasValue() && oldSelf.value() == self)",message="Gateway must be empty or a valid IPv4 address",optionalOldSelf=true
+	// +kubebuilder:default:=""
+	// +optional
+	Gateway string `json:"gateway"`
+
+	// SubnetMask setting to use for network interfaces. This field should only be set when using
+	// IPAssignmentModeStaticPool. For all other modes (IPAssignmentModeDHCP, IPAssignmentModeNone), this should be set
+	// to an empty string.
+	// Note: The value must be a valid IPv4 address, but an empty string is allowed for backward compatibility.
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:XValidation:rule="self == '' || (isIP(self) && ip(self).family() == 4) || (oldSelf.hasValue() && oldSelf.value() == self)",message="SubnetMask must be empty or a valid IPv4 address",optionalOldSelf=true
+	// +kubebuilder:default:=""
+	// +optional
+	SubnetMask string `json:"subnetMask"`
+
+	// IPv6Gateway setting to use for IPv6 addresses on network interfaces. This field should only
+	// be set when using IPv6AssignmentMode IPAssignmentModeStaticPool. For all other modes
+	// (IPAssignmentModeDHCP, IPAssignmentModeNone), this should be empty/unset.
+	// +kubebuilder:validation:MaxLength=45
+	// +kubebuilder:validation:XValidation:rule="self == '' || (isIP(self) && ip(self).family() == 6) || (oldSelf.hasValue() && oldSelf.value() == self)",message="IPv6Gateway must be a valid IPv6 address",optionalOldSelf=true
+	// +optional
+	IPv6Gateway string `json:"ipv6Gateway,omitempty"`
+
+	// IPv6Prefix is the prefix length for IPv6 addresses assigned to network interfaces (e.g. 64
+	// for a /64 network). This field should only be set when using IPv6AssignmentMode
+	// IPAssignmentModeStaticPool. For all other modes (IPAssignmentModeDHCP, IPAssignmentModeNone),
+	// this should be unset.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=128
+	IPv6Prefix *int32 `json:"ipv6Prefix,omitempty"`
+
+	// addressRanges is a list of IP ranges for static IP assignment.
+	// When non-empty, the operator reconciles ipPools against this list: IPPool references that do
+	// not map to any range here are removed, new references are added for ranges that require them,
+	// and all references that remain are reconciled (including those that already mapped to a range).
+	// +optional
+	// +kubebuilder:validation:MaxItems=1024
+	// +listType=atomic
+	AddressRanges []VSphereDistributedNetworkIPRange `json:"addressRanges,omitempty"`
+}
+
+// VLANType represents the type of VLAN configuration
+type VLANType string
+
+const (
+	// VLANTypeStandard represents a standard VLAN configuration with a single VLAN ID
+	VLANTypeStandard VLANType = "standard"
+	// VLANTypeTrunk represents a VLAN trunk configuration that allows multiple VLANs
+	VLANTypeTrunk VLANType = "trunk"
+	// VLANTypePrivate represents a private VLAN configuration
+	VLANTypePrivate VLANType = "private"
+)
+
+// VLANTrunkRange represents a range of VLAN IDs for trunk configuration
+type VLANTrunkRange struct {
+	// Start represents the beginning of the VLAN ID range (inclusive).
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4094
+	Start int32 `json:"start"`
+
+	// End represents the end of the VLAN ID range (inclusive).
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4094
+	End int32 `json:"end"`
+}
+
+// VlanSpec represents the VLAN configuration.
+type VlanSpec struct {
+	// Type indicates the type of VLAN configuration (standard, trunk, or private).
+	// +kubebuilder:validation:Enum=standard;trunk;private
+	Type VLANType `json:"type"`
+
+	// VlanID specifies the VLAN ID when Type is VLANTypeStandard.
+	// This field is ignored for other VLAN types.
+	// Possible values:
+	// - A value of 0 indicates there is no VLAN configuration for the port.
+	// - A value from 1 to 4094 specifies a VLAN ID for the port.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4094
+	VlanID *int32 `json:"vlanID,omitempty"`
+
+	// TrunkRange specifies the ranges of allowed VLANs when Type is VLANTypeTrunk.
+	// This field is ignored for other VLAN types.
+	// Each range's Start and End values must be between 0 and 4094 inclusive.
+	// Overlapping ranges are allowed.
+	// +optional
+	// +listType=atomic
+	TrunkRange []VLANTrunkRange `json:"trunkRange,omitempty"`
+
+	// PrivateVlanID specifies the private VLAN ID when Type is VLANTypePrivate.
+	// This field is ignored for other VLAN types.
+	// +optional
+	PrivateVlanID *int32 `json:"privateVlanID,omitempty"`
+}
+
+// MacLimitPolicyType represents the policy type to be used when the MAC address limit is exceeded.
+type MacLimitPolicyType string
+
+const (
+	// MacLimitPolicyAllow indicates that new MAC addresses should still be allowed when the limit is exceeded.
+	MacLimitPolicyAllow MacLimitPolicyType = "allow"
+	// MacLimitPolicyDrop indicates that new MAC addresses should be dropped when the limit is exceeded.
+	MacLimitPolicyDrop MacLimitPolicyType = "drop"
+)
+
+// MacLearningPolicy represents the MAC learning policy configuration.
+type MacLearningPolicy struct {
+	// Enabled indicates whether MAC learning is enabled.
+	Enabled bool `json:"enabled"`
+
+	// AllowUnicastFlooding indicates whether to allow flooding of unlearned MAC for ingress traffic.
+	// +optional
+	AllowUnicastFlooding *bool `json:"allowUnicastFlooding,omitempty"`
+
+	// Limit represents the maximum number of MAC addresses that can be learned.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=4096
+	Limit *int32 `json:"limit,omitempty"`
+
+	// LimitPolicy represents the policy to be used when the limit is exceeded.
+	// +optional
+	// +kubebuilder:validation:Enum=allow;drop
+	LimitPolicy *MacLimitPolicyType `json:"limitPolicy,omitempty"`
+}
+
+// MacManagementPolicy represents the MAC management policy configuration.
+type MacManagementPolicy struct {
+	// AllowPromiscuous indicates whether promiscuous mode is enabled. Determines whether or not all
+	// traffic is seen on the port.
+	// +optional
+	AllowPromiscuous *bool `json:"allowPromiscuous,omitempty"`
+
+	// MacChanges specifies whether virtual machines can receive frames with a Mac Address that is different from the one configured in the VMX.
+	// +optional
+	MacChanges *bool `json:"macChanges,omitempty"`
+
+	// ForgedTransmits indicates whether or not the virtual network adapter should be allowed to send
+	// network traffic with a different MAC address than the one assigned to it.
+	// +optional
+	ForgedTransmits *bool `json:"forgedTransmits,omitempty"`
+
+	// MacLearningPolicy represents the MAC learning policy configuration.
+	// +optional
+	MacLearningPolicy *MacLearningPolicy `json:"macLearningPolicy,omitempty"`
+}
+
+// VSphereDistributedPortConfig represents the port-level configuration for a vSphere Distributed Network's ports.
+type VSphereDistributedPortConfig struct {
+	// Vlan represents the VLAN configuration.
+	// +optional
+	Vlan *VlanSpec `json:"vlan,omitempty"`
+
+	// MacManagementPolicy represents the MAC management policy configuration.
+	// +optional
+	MacManagementPolicy *MacManagementPolicy `json:"macManagementPolicy,omitempty"`
+}
+
+// VSphereDistributedNetworkStatus defines the observed state of VSphereDistributedNetwork.
+type VSphereDistributedNetworkStatus struct {
+	// Conditions is an array of current observed vSphere Distributed network conditions.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// DefaultPortConfig represents the default port-level configuration that applies to all ports
+	// unless overridden at the individual port level.
+	// If unset, indicates that no default port-level configuration has been retrieved yet for this network.
+	// +optional
+	DefaultPortConfig *VSphereDistributedPortConfig `json:"defaultPortConfig,omitempty"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,shortName=vdsnet,categories=netop
+// +kubebuilder:validation:XValidation:rule="size(self.metadata.name) <= 253 && self.metadata.name.matches('^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$')",message="metadata.name must be a lowercase RFC 1123 DNS subdomain (alphanumeric or '-' or '.', each segment starting/ending with alphanumeric; max 253 characters)"
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="PortGroup",type="string",JSONPath=".spec.portGroupID"
+// +kubebuilder:printcolumn:name="IPv4 Mode",type="string",JSONPath=".spec.ipAssignmentMode"
+// +kubebuilder:printcolumn:name="IPv6 Mode",type="string",JSONPath=".spec.ipv6AssignmentMode"
+// +kubebuilder:printcolumn:name="Gateway",type="string",JSONPath=".spec.gateway",priority=1
+// +kubebuilder:printcolumn:name="IPv6 Gateway",type="string",JSONPath=".spec.ipv6Gateway",priority=1
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// VSphereDistributedNetwork represents schema for a network backed by a vSphere Distributed PortGroup on vSphere
+// Distributed switch.
+type VSphereDistributedNetwork struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VSphereDistributedNetworkSpec   `json:"spec,omitempty"`
+	Status VSphereDistributedNetworkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VSphereDistributedNetworkList contains a list of VSphereDistributedNetwork
+type VSphereDistributedNetworkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VSphereDistributedNetwork `json:"items"`
+}
+
+// VSphereDistributedNetworkRef is a reference to a VSphereDistributedNetwork
+// resource by name.
+type VSphereDistributedNetworkRef struct {
+	// name is the name of the VSphereDistributedNetwork resource.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	Name string `json:"name,omitempty"`
+}
+
+func init() {
+	RegisterTypeWithScheme(&VSphereDistributedNetwork{}, &VSphereDistributedNetworkList{})
+}
@@ -0,0 +1,1962 @@
+//go:build !ignore_autogenerated
+
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by controller-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActivePassiveAvailabilityMode) DeepCopyInto(out *ActivePassiveAvailabilityMode) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivePassiveAvailabilityMode.
+func (in *ActivePassiveAvailabilityMode) DeepCopy() *ActivePassiveAvailabilityMode {
+	if in == nil {
+		return nil
+	}
+	out := new(ActivePassiveAvailabilityMode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoCreateVPCConfig) DeepCopyInto(out *AutoCreateVPCConfig) {
+	*out = *in
+	if in.PrivateCIDRs != nil {
+		in, out := &in.PrivateCIDRs, &out.PrivateCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoCreateVPCConfig.
+func (in *AutoCreateVPCConfig) DeepCopy() *AutoCreateVPCConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoCreateVPCConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AviLoadBalancerConfig) DeepCopyInto(out *AviLoadBalancerConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AviLoadBalancerConfig.
+func (in *AviLoadBalancerConfig) DeepCopy() *AviLoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AviLoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AviLoadBalancerConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AviLoadBalancerConfigList) DeepCopyInto(out *AviLoadBalancerConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AviLoadBalancerConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AviLoadBalancerConfigList.
+func (in *AviLoadBalancerConfigList) DeepCopy() *AviLoadBalancerConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(AviLoadBalancerConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AviLoadBalancerConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AviLoadBalancerConfigSpec) DeepCopyInto(out *AviLoadBalancerConfigSpec) {
+	*out = *in
+	if in.AdvancedL4 != nil {
+		in, out := &in.AdvancedL4, &out.AdvancedL4
+		*out = new(bool)
+		**out = **in
+	}
+	out.CredentialSecretRef = in.CredentialSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AviLoadBalancerConfigSpec.
+func (in *AviLoadBalancerConfigSpec) DeepCopy() *AviLoadBalancerConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AviLoadBalancerConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AviLoadBalancerConfigStatus) DeepCopyInto(out *AviLoadBalancerConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AviLoadBalancerConfigStatus.
+func (in *AviLoadBalancerConfigStatus) DeepCopy() *AviLoadBalancerConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AviLoadBalancerConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientSecretReference) DeepCopyInto(out *ClientSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientSecretReference.
+func (in *ClientSecretReference) DeepCopy() *ClientSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationLoadBalancerConfig) DeepCopyInto(out *FoundationLoadBalancerConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationLoadBalancerConfig.
+func (in *FoundationLoadBalancerConfig) DeepCopy() *FoundationLoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationLoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FoundationLoadBalancerConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationLoadBalancerConfigList) DeepCopyInto(out *FoundationLoadBalancerConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FoundationLoadBalancerConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationLoadBalancerConfigList.
+func (in *FoundationLoadBalancerConfigList) DeepCopy() *FoundationLoadBalancerConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationLoadBalancerConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FoundationLoadBalancerConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationLoadBalancerConfigSpec) DeepCopyInto(out *FoundationLoadBalancerConfigSpec) {
+	*out = *in
+	in.DeploymentSpec.DeepCopyInto(&out.DeploymentSpec)
+	if in.ManagementNetwork != nil {
+		in, out := &in.ManagementNetwork, &out.ManagementNetwork
+		*out = new(NetworkReference)
+		**out = **in
+	}
+	if in.WorkloadNetworks != nil {
+		in, out := &in.WorkloadNetworks, &out.WorkloadNetworks
+		*out = make([]NetworkReference, len(*in))
+		copy(*out, *in)
+	}
+	out.VirtualIPNetwork = in.VirtualIPNetwork
+	in.NetworkSpec.DeepCopyInto(&out.NetworkSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationLoadBalancerConfigSpec.
+func (in *FoundationLoadBalancerConfigSpec) DeepCopy() *FoundationLoadBalancerConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationLoadBalancerConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationLoadBalancerConfigStatus) DeepCopyInto(out *FoundationLoadBalancerConfigStatus) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]FoundationLoadBalancerNodeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.VirtualServerIPPoolsUtilization = in.VirtualServerIPPoolsUtilization
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EffectiveVirtualServerIPPools != nil {
+		in, out := &in.EffectiveVirtualServerIPPools, &out.EffectiveVirtualServerIPPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationLoadBalancerConfigStatus.
+func (in *FoundationLoadBalancerConfigStatus) DeepCopy() *FoundationLoadBalancerConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationLoadBalancerConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationLoadBalancerDeploymentSpec) DeepCopyInto(out *FoundationLoadBalancerDeploymentSpec) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ActivePassiveAvailabilityMode != nil {
+		in, out := &in.ActivePassiveAvailabilityMode, &out.ActivePassiveAvailabilityMode
+		*out = new(ActivePassiveAvailabilityMode)
+		**out = **in
+	}
+	if in.SingleNodeAvailabilityMode != nil {
+		in, out := &in.SingleNodeAvailabilityMode, &out.SingleNodeAvailabilityMode
+		*out = new(SingleNodeAvailabilityMode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationLoadBalancerDeploymentSpec.
+func (in *FoundationLoadBalancerDeploymentSpec) DeepCopy() *FoundationLoadBalancerDeploymentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationLoadBalancerDeploymentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationLoadBalancerNetworkConfigSpec) DeepCopyInto(out *FoundationLoadBalancerNetworkConfigSpec) {
+	*out = *in
+	if in.VirtualServerIPPools != nil {
+		in, out := &in.VirtualServerIPPools, &out.VirtualServerIPPools
+		*out = make([]IPPoolReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.VirtualServerIPRanges != nil {
+		in, out := &in.VirtualServerIPRanges, &out.VirtualServerIPRanges
+		*out = make([]IPRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.VirtualServerSubnets != nil {
+		in, out := &in.VirtualServerSubnets, &out.VirtualServerSubnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNSSearchDomains != nil {
+		in, out := &in.DNSSearchDomains, &out.DNSSearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationLoadBalancerNetworkConfigSpec.
+func (in *FoundationLoadBalancerNetworkConfigSpec) DeepCopy() *FoundationLoadBalancerNetworkConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationLoadBalancerNetworkConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FoundationLoadBalancerNodeStatus) DeepCopyInto(out *FoundationLoadBalancerNodeStatus) {
+	*out = *in
+	out.ManagementNetworkInterface = in.ManagementNetworkInterface
+	if in.WorkloadNetworkInterfaces != nil {
+		in, out := &in.WorkloadNetworkInterfaces, &out.WorkloadNetworkInterfaces
+		*out = make([]NetworkInterfaceReference, len(*in))
+		copy(*out, *in)
+	}
+	out.VIPNetworkInterface = in.VIPNetworkInterface
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FoundationLoadBalancerNodeStatus.
+func (in *FoundationLoadBalancerNodeStatus) DeepCopy() *FoundationLoadBalancerNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FoundationLoadBalancerNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HAProxyLoadBalancerConfig) DeepCopyInto(out *HAProxyLoadBalancerConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HAProxyLoadBalancerConfig.
+func (in *HAProxyLoadBalancerConfig) DeepCopy() *HAProxyLoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(HAProxyLoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HAProxyLoadBalancerConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HAProxyLoadBalancerConfigList) DeepCopyInto(out *HAProxyLoadBalancerConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HAProxyLoadBalancerConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HAProxyLoadBalancerConfigList.
+func (in *HAProxyLoadBalancerConfigList) DeepCopy() *HAProxyLoadBalancerConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(HAProxyLoadBalancerConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HAProxyLoadBalancerConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HAProxyLoadBalancerConfigSpec) DeepCopyInto(out *HAProxyLoadBalancerConfigSpec) {
+	*out = *in
+	if in.EndPointURLs != nil {
+		in, out := &in.EndPointURLs, &out.EndPointURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.CredentialSecretRef = in.CredentialSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HAProxyLoadBalancerConfigSpec.
+func (in *HAProxyLoadBalancerConfigSpec) DeepCopy() *HAProxyLoadBalancerConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HAProxyLoadBalancerConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HAProxyLoadBalancerConfigStatus) DeepCopyInto(out *HAProxyLoadBalancerConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HAProxyLoadBalancerConfigStatus.
+func (in *HAProxyLoadBalancerConfigStatus) DeepCopy() *HAProxyLoadBalancerConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HAProxyLoadBalancerConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressAllocation) DeepCopyInto(out *IPAddressAllocation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressAllocation.
+func (in *IPAddressAllocation) DeepCopy() *IPAddressAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAddressAllocation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressAllocationList) DeepCopyInto(out *IPAddressAllocationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAddressAllocation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressAllocationList.
+func (in *IPAddressAllocationList) DeepCopy() *IPAddressAllocationList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressAllocationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAddressAllocationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressAllocationSpec) DeepCopyInto(out *IPAddressAllocationSpec) {
+	*out = *in
+	in.PoolRef.DeepCopyInto(&out.PoolRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressAllocationSpec.
+func (in *IPAddressAllocationSpec) DeepCopy() *IPAddressAllocationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressAllocationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAddressAllocationStatus) DeepCopyInto(out *IPAddressAllocationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAddressAllocationStatus.
+func (in *IPAddressAllocationStatus) DeepCopy() *IPAddressAllocationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAddressAllocationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPConfig) DeepCopyInto(out *IPConfig) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPConfig.
+func (in *IPConfig) DeepCopy() *IPConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(IPConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPool) DeepCopyInto(out *IPPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPool.
+func (in *IPPool) DeepCopy() *IPPool {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolList) DeepCopyInto(out *IPPoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolList.
+func (in *IPPoolList) DeepCopy() *IPPoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolPressurePolicy) DeepCopyInto(out *IPPoolPressurePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolPressurePolicy.
+func (in *IPPoolPressurePolicy) DeepCopy() *IPPoolPressurePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolPressurePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolReference) DeepCopyInto(out *IPPoolReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolReference.
+func (in *IPPoolReference) DeepCopy() *IPPoolReference {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolSpec) DeepCopyInto(out *IPPoolSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolSpec.
+func (in *IPPoolSpec) DeepCopy() *IPPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolStatus) DeepCopyInto(out *IPPoolStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolStatus.
+func (in *IPPoolStatus) DeepCopy() *IPPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPRange) DeepCopyInto(out *IPRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPRange.
+func (in *IPRange) DeepCopy() *IPRange {
+	if in == nil {
+		return nil
+	}
+	out := new(IPRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfig) DeepCopyInto(out *LoadBalancerConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfig.
+func (in *LoadBalancerConfig) DeepCopy() *LoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfigList) DeepCopyInto(out *LoadBalancerConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LoadBalancerConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfigList.
+func (in *LoadBalancerConfigList) DeepCopy() *LoadBalancerConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LoadBalancerConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfigProviderReference) DeepCopyInto(out *LoadBalancerConfigProviderReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfigProviderReference.
+func (in *LoadBalancerConfigProviderReference) DeepCopy() *LoadBalancerConfigProviderReference {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfigProviderReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfigSpec) DeepCopyInto(out *LoadBalancerConfigSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfigSpec.
+func (in *LoadBalancerConfigSpec) DeepCopy() *LoadBalancerConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfigStatus) DeepCopyInto(out *LoadBalancerConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfigStatus.
+func (in *LoadBalancerConfigStatus) DeepCopy() *LoadBalancerConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MacLearningPolicy) DeepCopyInto(out *MacLearningPolicy) {
+	*out = *in
+	if in.AllowUnicastFlooding != nil {
+		in, out := &in.AllowUnicastFlooding, &out.AllowUnicastFlooding
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LimitPolicy != nil {
+		in, out := &in.LimitPolicy, &out.LimitPolicy
+		*out = new(MacLimitPolicyType)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MacLearningPolicy.
+func (in *MacLearningPolicy) DeepCopy() *MacLearningPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MacLearningPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MacManagementPolicy) DeepCopyInto(out *MacManagementPolicy) {
+	*out = *in
+	if in.AllowPromiscuous != nil {
+		in, out := &in.AllowPromiscuous, &out.AllowPromiscuous
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MacChanges != nil {
+		in, out := &in.MacChanges, &out.MacChanges
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ForgedTransmits != nil {
+		in, out := &in.ForgedTransmits, &out.ForgedTransmits
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MacLearningPolicy != nil {
+		in, out := &in.MacLearningPolicy, &out.MacLearningPolicy
+		*out = new(MacLearningPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MacManagementPolicy.
+func (in *MacManagementPolicy) DeepCopy() *MacManagementPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MacManagementPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NSXTier1Config) DeepCopyInto(out *NSXTier1Config) {
+	*out = *in
+	if in.NamespaceCIDRs != nil {
+		in, out := &in.NamespaceCIDRs, &out.NamespaceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IngressCIDRs != nil {
+		in, out := &in.IngressCIDRs, &out.IngressCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EgressCIDRs != nil {
+		in, out := &in.EgressCIDRs, &out.EgressCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NSXTier1Config.
+func (in *NSXTier1Config) DeepCopy() *NSXTier1Config {
+	if in == nil {
+		return nil
+	}
+	out := new(NSXTier1Config)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceNetworkAssociation) DeepCopyInto(out *NamespaceNetworkAssociation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceNetworkAssociation.
+func (in *NamespaceNetworkAssociation) DeepCopy() *NamespaceNetworkAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceNetworkAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceNetworkConfig) DeepCopyInto(out *NamespaceNetworkConfig) {
+	*out = *in
+	in.VSphereDistributedConfig.DeepCopyInto(&out.VSphereDistributedConfig)
+	in.VPCConfig.DeepCopyInto(&out.VPCConfig)
+	if in.NSXTier1Config != nil {
+		in, out := &in.NSXTier1Config, &out.NSXTier1Config
+		*out = new(NSXTier1Config)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceNetworkConfig.
+func (in *NamespaceNetworkConfig) DeepCopy() *NamespaceNetworkConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceNetworkConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceNetworkConfiguration) DeepCopyInto(out *NamespaceNetworkConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(NamespaceNetworkStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceNetworkConfiguration.
+func (in *NamespaceNetworkConfiguration) DeepCopy() *NamespaceNetworkConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceNetworkConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespaceNetworkConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceNetworkConfigurationList) DeepCopyInto(out *NamespaceNetworkConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NamespaceNetworkConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceNetworkConfigurationList.
+func (in *NamespaceNetworkConfigurationList) DeepCopy() *NamespaceNetworkConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceNetworkConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NamespaceNetworkConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceNetworkSpec) DeepCopyInto(out *NamespaceNetworkSpec) {
+	*out = *in
+	in.NamespaceNetworkConfig.DeepCopyInto(&out.NamespaceNetworkConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceNetworkSpec.
+func (in *NamespaceNetworkSpec) DeepCopy() *NamespaceNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceNetworkStatus) DeepCopyInto(out *NamespaceNetworkStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AssociatedNamespaces != nil {
+		in, out := &in.AssociatedNamespaces, &out.AssociatedNamespaces
+		*out = make([]NamespaceNetworkAssociation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceNetworkStatus.
+func (in *NamespaceNetworkStatus) DeepCopy() *NamespaceNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Network) DeepCopyInto(out *Network) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Network.
+func (in *Network) DeepCopy() *Network {
+	if in == nil {
+		return nil
+	}
+	out := new(Network)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Network) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface.
+func (in *NetworkInterface) DeepCopy() *NetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkInterface) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceList) DeepCopyInto(out *NetworkInterfaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceList.
+func (in *NetworkInterfaceList) DeepCopy() *NetworkInterfaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkInterfaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfacePortAllocation) DeepCopyInto(out *NetworkInterfacePortAllocation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfacePortAllocation.
+func (in *NetworkInterfacePortAllocation) DeepCopy() *NetworkInterfacePortAllocation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfacePortAllocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceProviderReference) DeepCopyInto(out *NetworkInterfaceProviderReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceProviderReference.
+func (in *NetworkInterfaceProviderReference) DeepCopy() *NetworkInterfaceProviderReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceProviderReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceReference) DeepCopyInto(out *NetworkInterfaceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceReference.
+func (in *NetworkInterfaceReference) DeepCopy() *NetworkInterfaceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceSpec) DeepCopyInto(out *NetworkInterfaceSpec) {
+	*out = *in
+	if in.ProviderRef != nil {
+		in, out := &in.ProviderRef, &out.ProviderRef
+		*out = new(NetworkInterfaceProviderReference)
+		**out = **in
+	}
+	if in.PortAllocation != nil {
+		in, out := &in.PortAllocation, &out.PortAllocation
+		*out = new(NetworkInterfacePortAllocation)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceSpec.
+func (in *NetworkInterfaceSpec) DeepCopy() *NetworkInterfaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkInterfaceStatus) DeepCopyInto(out *NetworkInterfaceStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IPConfigs != nil {
+		in, out := &in.IPConfigs, &out.IPConfigs
+		*out = make([]IPConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterfaceStatus.
+func (in *NetworkInterfaceStatus) DeepCopy() *NetworkInterfaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkInterfaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkList) DeepCopyInto(out *NetworkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Network, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkList.
+func (in *NetworkList) DeepCopy() *NetworkList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkProviderEntry) DeepCopyInto(out *NetworkProviderEntry) {
+	*out = *in
+	if in.SystemConfiguration != nil {
+		in, out := &in.SystemConfiguration, &out.SystemConfiguration
+		*out = new(NamespaceNetworkConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkProviderEntry.
+func (in *NetworkProviderEntry) DeepCopy() *NetworkProviderEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkProviderEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkProviderReference) DeepCopyInto(out *NetworkProviderReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkProviderReference.
+func (in *NetworkProviderReference) DeepCopy() *NetworkProviderReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkProviderReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkReference) DeepCopyInto(out *NetworkReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkReference.
+func (in *NetworkReference) DeepCopy() *NetworkReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkSettings) DeepCopyInto(out *NetworkSettings) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSettings.
+func (in *NetworkSettings) DeepCopy() *NetworkSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkSettings) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkSettingsList) DeepCopyInto(out *NetworkSettingsList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkSettings, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSettingsList.
+func (in *NetworkSettingsList) DeepCopy() *NetworkSettingsList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkSettingsList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkSettingsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
+	*out = *in
+	out.ProviderRef = in.ProviderRef
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNSSearchDomains != nil {
+		in, out := &in.DNSSearchDomains, &out.DNSSearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NTP != nil {
+		in, out := &in.NTP, &out.NTP
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
+func (in *NetworkSpec) DeepCopy() *NetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SupportedIPFamilies != nil {
+		in, out := &in.SupportedIPFamilies, &out.SupportedIPFamilies
+		*out = make([]corev1.IPFamily, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
+func (in *NetworkStatus) DeepCopy() *NetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SharedSubnet) DeepCopyInto(out *SharedSubnet) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SharedSubnet.
+func (in *SharedSubnet) DeepCopy() *SharedSubnet {
+	if in == nil {
+		return nil
+	}
+	out := new(SharedSubnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SingleNodeAvailabilityMode) DeepCopyInto(out *SingleNodeAvailabilityMode) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SingleNodeAvailabilityMode.
+func (in *SingleNodeAvailabilityMode) DeepCopy() *SingleNodeAvailabilityMode {
+	if in == nil {
+		return nil
+	}
+	out := new(SingleNodeAvailabilityMode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VLANTrunkRange) DeepCopyInto(out *VLANTrunkRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VLANTrunkRange.
+func (in *VLANTrunkRange) DeepCopy() *VLANTrunkRange {
+	if in == nil {
+		return nil
+	}
+	out := new(VLANTrunkRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMXNET3NetworkInterface) DeepCopyInto(out *VMXNET3NetworkInterface) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMXNET3NetworkInterface.
+func (in *VMXNET3NetworkInterface) DeepCopy() *VMXNET3NetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(VMXNET3NetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMXNET3NetworkInterface) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMXNET3NetworkInterfaceList) DeepCopyInto(out *VMXNET3NetworkInterfaceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VMXNET3NetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMXNET3NetworkInterfaceList.
+func (in *VMXNET3NetworkInterfaceList) DeepCopy() *VMXNET3NetworkInterfaceList {
+	if in == nil {
+		return nil
+	}
+	out := new(VMXNET3NetworkInterfaceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VMXNET3NetworkInterfaceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMXNET3NetworkInterfaceSpec) DeepCopyInto(out *VMXNET3NetworkInterfaceSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMXNET3NetworkInterfaceSpec.
+func (in *VMXNET3NetworkInterfaceSpec) DeepCopy() *VMXNET3NetworkInterfaceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VMXNET3NetworkInterfaceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMXNET3NetworkInterfaceStatus) DeepCopyInto(out *VMXNET3NetworkInterfaceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMXNET3NetworkInterfaceStatus.
+func (in *VMXNET3NetworkInterfaceStatus) DeepCopy() *VMXNET3NetworkInterfaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VMXNET3NetworkInterfaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCConfig) DeepCopyInto(out *VPCConfig) {
+	*out = *in
+	in.AutoCreateConfig.DeepCopyInto(&out.AutoCreateConfig)
+	if in.SharedSubnets != nil {
+		in, out := &in.SharedSubnets, &out.SharedSubnets
+		*out = make([]SharedSubnet, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCConfig.
+func (in *VPCConfig) DeepCopy() *VPCConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereDistributedConfig) DeepCopyInto(out *VSphereDistributedConfig) {
+	*out = *in
+	if in.Networks != nil {
+		in, out := &in.Networks, &out.Networks
+		*out = make([]VSphereDistributedNetworkRef, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereDistributedConfig.
+func (in *VSphereDistributedConfig) DeepCopy() *VSphereDistributedConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereDistributedConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereDistributedNetwork) DeepCopyInto(out *VSphereDistributedNetwork) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereDistributedNetwork.
+func (in *VSphereDistributedNetwork) DeepCopy() *VSphereDistributedNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereDistributedNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VSphereDistributedNetwork) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereDistributedNetworkIPRange) DeepCopyInto(out *VSphereDistributedNetworkIPRange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereDistributedNetworkIPRange.
+func (in *VSphereDistributedNetworkIPRange) DeepCopy() *VSphereDistributedNetworkIPRange {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereDistributedNetworkIPRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereDistributedNetworkList) DeepCopyInto(out *VSphereDistributedNetworkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VSphereDistributedNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereDistributedNetworkList.
+func (in *VSphereDistributedNetworkList) DeepCopy() *VSphereDistributedNetworkList {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereDistributedNetworkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VSphereDistributedNetworkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereDistributedNetworkRef) DeepCopyInto(out *VSphereDistributedNetworkRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereDistributedNetworkRef.
+func (in *VSphereDistributedNetworkRef) DeepCopy() *VSphereDistributedNetworkRef {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereDistributedNetworkRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereDistributedNetworkSpec) DeepCopyInto(out *VSphereDistributedNetworkSpec) {
+	*out = *in
+	if in.IPPools != nil {
+		in, out := &in.IPPools, &out.IPPools
+		*out = make([]IPPoolReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv6Prefix != nil {
+		in, out := &in.IPv6Prefix, &out.IPv6Prefix
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AddressRanges != nil {
+		in, out := &in.AddressRanges, &out.AddressRanges
+		*out = make([]VSphereDistributedNetworkIPRange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereDistributedNetworkSpec.
+func (in *VSphereDistributedNetworkSpec) DeepCopy() *VSphereDistributedNetworkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereDistributedNetworkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereDistributedNetworkStatus) DeepCopyInto(out *VSphereDistributedNetworkStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultPortConfig != nil {
+		in, out := &in.DefaultPortConfig, &out.DefaultPortConfig
+		*out = new(VSphereDistributedPortConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereDistributedNetworkStatus.
+func (in *VSphereDistributedNetworkStatus) DeepCopy() *VSphereDistributedNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereDistributedNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VSphereDistributedPortConfig) DeepCopyInto(out *VSphereDistributedPortConfig) {
+	*out = *in
+	if in.Vlan != nil {
+		in, out := &in.Vlan, &out.Vlan
+		*out = new(VlanSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MacManagementPolicy != nil {
+		in, out := &in.MacManagementPolicy, &out.MacManagementPolicy
+		*out = new(MacManagementPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VSphereDistributedPortConfig.
+func (in *VSphereDistributedPortConfig) DeepCopy() *VSphereDistributedPortConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VSphereDistributedPortConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualIPPoolsUtilization) DeepCopyInto(out *VirtualIPPoolsUtilization) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualIPPoolsUtilization.
+func (in *VirtualIPPoolsUtilization) DeepCopy() *VirtualIPPoolsUtilization {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualIPPoolsUtilization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VlanSpec) DeepCopyInto(out *VlanSpec) {
+	*out = *in
+	if in.VlanID != nil {
+		in, out := &in.VlanID, &out.VlanID
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TrunkRange != nil {
+		in, out := &in.TrunkRange, &out.TrunkRange
+		*out = make([]VLANTrunkRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateVlanID != nil {
+		in, out := &in.PrivateVlanID, &out.PrivateVlanID
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VlanSpec.
+func (in *VlanSpec) DeepCopy() *VlanSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VlanSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadNetworkConfiguration) DeepCopyInto(out *WorkloadNetworkConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(WorkloadNetworkConfigurationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadNetworkConfiguration.
+func (in *WorkloadNetworkConfiguration) DeepCopy() *WorkloadNetworkConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadNetworkConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkloadNetworkConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadNetworkConfigurationList) DeepCopyInto(out *WorkloadNetworkConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkloadNetworkConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadNetworkConfigurationList.
+func (in *WorkloadNetworkConfigurationList) DeepCopy() *WorkloadNetworkConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadNetworkConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkloadNetworkConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadNetworkConfigurationSpec) DeepCopyInto(out *WorkloadNetworkConfigurationSpec) {
+	*out = *in
+	if in.Providers != nil {
+		in, out := &in.Providers, &out.Providers
+		*out = make([]NetworkProviderEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadNetworkConfigurationSpec.
+func (in *WorkloadNetworkConfigurationSpec) DeepCopy() *WorkloadNetworkConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadNetworkConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadNetworkConfigurationStatus) DeepCopyInto(out *WorkloadNetworkConfigurationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadNetworkConfigurationStatus.
+func (in *WorkloadNetworkConfigurationStatus) DeepCopy() *WorkloadNetworkConfigurationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadNetworkConfigurationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -8,27 +8,40 @@ TOPLEVEL="$(git rev-parse --show-toplevel)"
 TOOLS_PATH=hack/tools/bin
 PKG=github.com/vmware-tanzu/net-operator-api
 
-VERSION=v1alpha1
+VERSIONS=(v1alpha1 v1alpha2)
 
 CLIENTSET_NAME=clientset
-CLIENT_OUT=$TOPLEVEL/pkg/client
+# CLIENT_OUT may be overridden to generate into another directory, as
+# hack/verify-client.sh does.
+CLIENT_OUT=${CLIENT_OUT:-$TOPLEVEL/pkg/client}
 CLIENTGEN_PATH=$PKG/pkg/client/clientset_generated
 LISTERGEN_PATH=$PKG/pkg/client/listers_generated
 INFORMERGEN_PATH=$PKG/pkg/client/informers_generated
+APPLYCONFIGURATIONGEN_PATH=$PKG/pkg/client/applyconfiguration_generated
 HEADER_FILE=hack/boilerplate/boilerplate.go.txt
 
-$TOOLS_PATH/client-gen --go-header-file $HEADER_FILE --input-base $PKG/api --input /$VERSION \
- --output-dir $CLIENT_OUT/clientset_generated/$CLIENTSET_NAME \
- --output-pkg $CLIENTGEN_PATH/$CLIENTSET_NAME
+CLIENTGEN_INPUTS=$(printf "api/%s," "${VERSIONS[@]}")
+INPUT_PKGS=$(printf "$PKG/api/%s " "${VERSIONS[@]}")
+
+$TOOLS_PATH/applyconfiguration-gen --go-header-file $HEADER_FILE \
+ --output-dir $CLIENT_OUT/applyconfiguration_generated \
+ --output-pkg $APPLYCONFIGURATIONGEN_PATH \
+ $INPUT_PKGS
+
+$TOOLS_PATH/client-gen --go-header-file $HEADER_FILE --input-base $PKG --input "${CLIENTGEN_INPUTS%,}" \
+ --output-dir $CLIENT_OUT/clientset_generated \
+ --output-pkg $CLIENTGEN_PATH \
+ --clientset-name $CLIENTSET_NAME \
+ --apply-configuration-package $APPLYCONFIGURATIONGEN_PATH
 
 $TOOLS_PATH/lister-gen --go-header-file $HEADER_FILE \
  --output-dir $CLIENT_OUT/listers_generated \
  --output-pkg $LISTERGEN_PATH \
- $PKG/api/$VERSION
+ $INPUT_PKGS
 
 $TOOLS_PATH/informer-gen --single-directory --go-header-file $HEADER_FILE \
  --output-dir $CLIENT_OUT/informers_generated \
  --output-pkg $INFORMERGEN_PATH \
  --listers-package $LISTERGEN_PATH \
  --versioned-clientset-package $CLIENTGEN_PATH/$CLIENTSET_NAME \
- $PKG/api/$VERSION
+ $INPUT_PKGS
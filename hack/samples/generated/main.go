@@ -8,7 +8,7 @@ import (
 	"path/filepath"
 
 	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
-	netopv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/v1alpha1"
+	netopv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -0,0 +1,26 @@
+#!/usr/bin/env bash
+
+# Regenerates the clients into a temporary directory and fails if they differ
+# from the ones checked in under pkg/client.
+
+set -o errexit
+set -o pipefail
+set -o nounset
+
+TOPLEVEL="$(git rev-parse --show-toplevel)"
+cd "$TOPLEVEL"
+
+GENERATED_DIRS=(applyconfiguration_generated clientset_generated informers_generated listers_generated)
+
+CLIENT_OUT="$(mktemp -d)"
+trap 'rm -rf "$CLIENT_OUT"' EXIT
+export CLIENT_OUT
+
+hack/client-gen.sh
+
+for dir in "${GENERATED_DIRS[@]}"; do
+  if ! diff -ruN "pkg/client/$dir" "$CLIENT_OUT/$dir"; then
+    echo "pkg/client/$dir is out of date, run 'make generate-client'" >&2
+    exit 1
+  fi
+done
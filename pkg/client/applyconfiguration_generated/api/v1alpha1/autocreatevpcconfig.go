@@ -0,0 +1,73 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// AutoCreateVPCConfigApplyConfiguration represents a declarative configuration of the AutoCreateVPCConfig type for use
+// with apply.
+//
+// AutoCreateVPCConfig specifies the configuration used to automatically create
+// a namespace-scoped VPC.
+type AutoCreateVPCConfigApplyConfiguration struct {
+	// nsxProject is the NSX policy path of the Project the namespace is
+	// associated with. This field is immutable once set.
+	//
+	// NSX Projects provide multi-tenancy by partitioning networking and
+	// security configurations within a single NSX deployment.
+	//
+	NSXProject *string `json:"nsxProject,omitempty"`
+	// vpcConnectivityProfile is the NSX policy path of the VPC Connectivity
+	// Profile. This profile defines northbound connectivity configuration
+	// for VPCs including:
+	// - Transit Gateway attachment
+	// - External IP blocks (for public subnets and external IP bindings)
+	// - Private Transit Gateway IP blocks (for inter-VPC communication)
+	//
+	// This field is immutable once set.
+	//
+	VPCConnectivityProfile *string `json:"vpcConnectivityProfile,omitempty"`
+	// privateCIDRs specifies CIDR blocks from which private Subnets are
+	// allocated for this namespace. These ranges should not overlap with:
+	// - CIDRs in the VPC connectivity profile
+	// - Kubernetes service CIDRs
+	// - Other services running in the datacenter
+	//
+	// This field is append-only; existing entries may not be removed.
+	//
+	PrivateCIDRs []string `json:"privateCIDRs,omitempty"`
+}
+
+// AutoCreateVPCConfigApplyConfiguration constructs a declarative configuration of the AutoCreateVPCConfig type for use with
+// apply.
+func AutoCreateVPCConfig() *AutoCreateVPCConfigApplyConfiguration {
+	return &AutoCreateVPCConfigApplyConfiguration{}
+}
+
+// WithNSXProject sets the NSXProject field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NSXProject field is set to the value of the last call.
+func (b *AutoCreateVPCConfigApplyConfiguration) WithNSXProject(value string) *AutoCreateVPCConfigApplyConfiguration {
+	b.NSXProject = &value
+	return b
+}
+
+// WithVPCConnectivityProfile sets the VPCConnectivityProfile field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VPCConnectivityProfile field is set to the value of the last call.
+func (b *AutoCreateVPCConfigApplyConfiguration) WithVPCConnectivityProfile(value string) *AutoCreateVPCConfigApplyConfiguration {
+	b.VPCConnectivityProfile = &value
+	return b
+}
+
+// WithPrivateCIDRs adds the given value to the PrivateCIDRs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PrivateCIDRs field.
+func (b *AutoCreateVPCConfigApplyConfiguration) WithPrivateCIDRs(values ...string) *AutoCreateVPCConfigApplyConfiguration {
+	for i := range values {
+		b.PrivateCIDRs = append(b.PrivateCIDRs, values[i])
+	}
+	return b
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ClientSecretReferenceApplyConfiguration represents a declarative configuration of the ClientSecretReference type for use
+// with apply.
+//
+// ClientSecretReference contains info to locate an object of Kind Secret
+// which contains credential specifications for a load balancer.
+type ClientSecretReferenceApplyConfiguration struct {
+	// Name is the name of resource being referenced.
+	Name *string `json:"name,omitempty"`
+	// Namespace of the resource being referenced. If empty, cluster scoped resource is assumed.
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+// ClientSecretReferenceApplyConfiguration constructs a declarative configuration of the ClientSecretReference type for use with
+// apply.
+func ClientSecretReference() *ClientSecretReferenceApplyConfiguration {
+	return &ClientSecretReferenceApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *ClientSecretReferenceApplyConfiguration) WithName(value string) *ClientSecretReferenceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *ClientSecretReferenceApplyConfiguration) WithNamespace(value string) *ClientSecretReferenceApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
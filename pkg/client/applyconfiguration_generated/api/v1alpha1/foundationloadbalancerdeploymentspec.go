@@ -0,0 +1,117 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+// FoundationLoadBalancerDeploymentSpecApplyConfiguration represents a declarative configuration of the FoundationLoadBalancerDeploymentSpec type for use
+// with apply.
+//
+// Spec objects. Input for FLB deployment.
+// FoundationLoadBalancerDeploymentSpec describes how to deploy the load balancer.
+type FoundationLoadBalancerDeploymentSpecApplyConfiguration struct {
+	// Size describes the node form factor.
+	//
+	Size *apiv1alpha1.FoundationLoadBalancerSize `json:"size,omitempty"`
+	// StoragePolicy is a vSphere Storage Policy ID which defines node storage placement.
+	// If unset, it will be defaulted to the Supervisor Control Plane's Storage Policy.
+	//
+	StoragePolicy *string `json:"storagePolicy,omitempty"`
+	// Version number desired by the operator.
+	//
+	// Defaults to the latest available.
+	//
+	Version *string `json:"version,omitempty"`
+	// Zones contains the names of zones eligible for placing nodes. Zones must be one of the
+	// AvailabilityZones defined and eligible for placement on the cluster.
+	//
+	// Currently only management zones are eligible for Foundation Load Balancer placements.
+	// If none of the zones specified is a management zone, placement will fail.
+	// When empty, all management zones are eligible.
+	//
+	Zones []string `json:"zones,omitempty"`
+	// AvailabilityMode defines how the availability of the solution is deployed and configured.
+	AvailabilityMode *apiv1alpha1.FoundationLoadBalancerAvailabilityMode `json:"availabilityMode,omitempty"`
+	// ActivePassiveAvailabilityMode configures the load balancer in active-passive configuration.
+	// Active-passive configuration consists of a two node deployment with one node configured to
+	// actively service traffic with the second node in standby mode. When the service detects the
+	// active node is unhealthy, traffic will be moved to the passive node after a short delay.
+	// Connections may be dropped on fail-over.
+	//
+	ActivePassiveAvailabilityMode *ActivePassiveAvailabilityModeApplyConfiguration `json:"activePassiveSpec,omitempty"`
+	// SingleNodeAvailabilityMode deploys a single node to serve load balancer traffic. If the node
+	// fails, the service will attempt to redeploy it, but redeployment is best-effort and depends on
+	// the health of the underlying infrastructure.
+	//
+	SingleNodeAvailabilityMode *SingleNodeAvailabilityModeApplyConfiguration `json:"singleNodeSpec,omitempty"`
+}
+
+// FoundationLoadBalancerDeploymentSpecApplyConfiguration constructs a declarative configuration of the FoundationLoadBalancerDeploymentSpec type for use with
+// apply.
+func FoundationLoadBalancerDeploymentSpec() *FoundationLoadBalancerDeploymentSpecApplyConfiguration {
+	return &FoundationLoadBalancerDeploymentSpecApplyConfiguration{}
+}
+
+// WithSize sets the Size field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Size field is set to the value of the last call.
+func (b *FoundationLoadBalancerDeploymentSpecApplyConfiguration) WithSize(value apiv1alpha1.FoundationLoadBalancerSize) *FoundationLoadBalancerDeploymentSpecApplyConfiguration {
+	b.Size = &value
+	return b
+}
+
+// WithStoragePolicy sets the StoragePolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StoragePolicy field is set to the value of the last call.
+func (b *FoundationLoadBalancerDeploymentSpecApplyConfiguration) WithStoragePolicy(value string) *FoundationLoadBalancerDeploymentSpecApplyConfiguration {
+	b.StoragePolicy = &value
+	return b
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *FoundationLoadBalancerDeploymentSpecApplyConfiguration) WithVersion(value string) *FoundationLoadBalancerDeploymentSpecApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithZones adds the given value to the Zones field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Zones field.
+func (b *FoundationLoadBalancerDeploymentSpecApplyConfiguration) WithZones(values ...string) *FoundationLoadBalancerDeploymentSpecApplyConfiguration {
+	for i := range values {
+		b.Zones = append(b.Zones, values[i])
+	}
+	return b
+}
+
+// WithAvailabilityMode sets the AvailabilityMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AvailabilityMode field is set to the value of the last call.
+func (b *FoundationLoadBalancerDeploymentSpecApplyConfiguration) WithAvailabilityMode(value apiv1alpha1.FoundationLoadBalancerAvailabilityMode) *FoundationLoadBalancerDeploymentSpecApplyConfiguration {
+	b.AvailabilityMode = &value
+	return b
+}
+
+// WithActivePassiveAvailabilityMode sets the ActivePassiveAvailabilityMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActivePassiveAvailabilityMode field is set to the value of the last call.
+func (b *FoundationLoadBalancerDeploymentSpecApplyConfiguration) WithActivePassiveAvailabilityMode(value *ActivePassiveAvailabilityModeApplyConfiguration) *FoundationLoadBalancerDeploymentSpecApplyConfiguration {
+	b.ActivePassiveAvailabilityMode = value
+	return b
+}
+
+// WithSingleNodeAvailabilityMode sets the SingleNodeAvailabilityMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SingleNodeAvailabilityMode field is set to the value of the last call.
+func (b *FoundationLoadBalancerDeploymentSpecApplyConfiguration) WithSingleNodeAvailabilityMode(value *SingleNodeAvailabilityModeApplyConfiguration) *FoundationLoadBalancerDeploymentSpecApplyConfiguration {
+	b.SingleNodeAvailabilityMode = value
+	return b
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// FoundationLoadBalancerNetworkConfigSpecApplyConfiguration represents a declarative configuration of the FoundationLoadBalancerNetworkConfigSpec type for use
+// with apply.
+//
+// FoundationLoadBalancerNetworkConfigSpec contains values for configuring networks on the load balancer.
+type FoundationLoadBalancerNetworkConfigSpecApplyConfiguration struct {
+	// virtualServerIPPools is the list of IPPools that are used for load balancer IP addresses.
+	// If this field is used, effectiveVirtualServerIPPools will be populated with entries of virtualServerIPPools
+	// on a successful reconciliation.
+	//
+	// Use of VirtualServerIPPools and VirtualServerIPRanges are mutually exclusive of each other:
+	// One (and only one) of either VirtualServerIPPools or VirtualServerIPRanges must be non-empty.
+	// Once one of them is set, the other field must never be used.
+	//
+	VirtualServerIPPools []IPPoolReferenceApplyConfiguration `json:"virtualServerIPPools,omitempty"`
+	// virtualServerIPRanges are IP ranges from which Virtual Server IPs are allocated.
+	// If this field is used, on successful reconciliation of virtualServerIPRanges, effectiveVirtualServerIPPools
+	// will be populated with names of IP Pools reconciled from it.
+	//
+	// Use of VirtualServerIPRanges and VirtualServerIPPools are mutually exclusive of each other:
+	// One (and only one) of either VirtualServerIPRanges or VirtualServerIPPools must be non-empty.
+	// Once one of them is set, the other field must never be used.
+	//
+	VirtualServerIPRanges []IPRangeApplyConfiguration `json:"virtualServerIPRanges,omitempty"`
+	// VirtualServerSubnets are the list of subnets specified in CIDR notation
+	// that are directly connected to the VirtualIPNetwork.
+	//
+	// The VirtualServerIPPools must fall within the subnet of the VirtualIPNetwork
+	// or one of these subnets.
+	//
+	VirtualServerSubnets []string `json:"virtualServerSubnets,omitempty"`
+	// DNSServers is the list of servers used for DNS traffic.
+	// These servers must be reachable from the network configured
+	// for management traffic.
+	//
+	DNSServers []string `json:"dnsServers,omitempty"`
+	// DNSSearchDomains are the domains resolvable on the specified DNSServers.
+	//
+	DNSSearchDomains []string `json:"dnsSearchDomains,omitempty"`
+	// NTPServers are the servers used to sync time across nodes.
+	// These servers must be reachable from the network configured
+	// for management traffic.
+	//
+	NTPServers []string `json:"ntpServers,omitempty"`
+	// SyslogEndpoint configures the syslog server. It accepts a protocol, host and port.
+	// If using TLS, you must configure a TLS CA that is capable of verifying the endpoint certificate.
+	// E.g. [protocol://]host[:port]
+	// This server must be reachable from the network configured for management traffic.
+	//
+	// If empty, data will be logged locally to load balancer nodes.
+	// Defaults to port 514 if using UDP and 6514 if using TLS.
+	//
+	SyslogEndpoint *string `json:"syslogEndpoint,omitempty"`
+	// SyslogCertificateSecretName is the certificate required to verify
+	// the TLS syslog endpoint in PEM format.
+	//
+	SyslogCertificate *string `json:"syslogCertificate,omitempty"`
+}
+
+// FoundationLoadBalancerNetworkConfigSpecApplyConfiguration constructs a declarative configuration of the FoundationLoadBalancerNetworkConfigSpec type for use with
+// apply.
+func FoundationLoadBalancerNetworkConfigSpec() *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration {
+	return &FoundationLoadBalancerNetworkConfigSpecApplyConfiguration{}
+}
+
+// WithVirtualServerIPPools adds the given value to the VirtualServerIPPools field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VirtualServerIPPools field.
+func (b *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration) WithVirtualServerIPPools(values ...*IPPoolReferenceApplyConfiguration) *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVirtualServerIPPools")
+		}
+		b.VirtualServerIPPools = append(b.VirtualServerIPPools, *values[i])
+	}
+	return b
+}
+
+// WithVirtualServerIPRanges adds the given value to the VirtualServerIPRanges field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VirtualServerIPRanges field.
+func (b *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration) WithVirtualServerIPRanges(values ...*IPRangeApplyConfiguration) *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithVirtualServerIPRanges")
+		}
+		b.VirtualServerIPRanges = append(b.VirtualServerIPRanges, *values[i])
+	}
+	return b
+}
+
+// WithVirtualServerSubnets adds the given value to the VirtualServerSubnets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the VirtualServerSubnets field.
+func (b *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration) WithVirtualServerSubnets(values ...string) *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration {
+	for i := range values {
+		b.VirtualServerSubnets = append(b.VirtualServerSubnets, values[i])
+	}
+	return b
+}
+
+// WithDNSServers adds the given value to the DNSServers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DNSServers field.
+func (b *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration) WithDNSServers(values ...string) *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration {
+	for i := range values {
+		b.DNSServers = append(b.DNSServers, values[i])
+	}
+	return b
+}
+
+// WithDNSSearchDomains adds the given value to the DNSSearchDomains field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DNSSearchDomains field.
+func (b *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration) WithDNSSearchDomains(values ...string) *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration {
+	for i := range values {
+		b.DNSSearchDomains = append(b.DNSSearchDomains, values[i])
+	}
+	return b
+}
+
+// WithNTPServers adds the given value to the NTPServers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NTPServers field.
+func (b *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration) WithNTPServers(values ...string) *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration {
+	for i := range values {
+		b.NTPServers = append(b.NTPServers, values[i])
+	}
+	return b
+}
+
+// WithSyslogEndpoint sets the SyslogEndpoint field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SyslogEndpoint field is set to the value of the last call.
+func (b *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration) WithSyslogEndpoint(value string) *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration {
+	b.SyslogEndpoint = &value
+	return b
+}
+
+// WithSyslogCertificate sets the SyslogCertificate field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SyslogCertificate field is set to the value of the last call.
+func (b *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration) WithSyslogCertificate(value string) *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration {
+	b.SyslogCertificate = &value
+	return b
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IPAddressAllocationConditionApplyConfiguration represents a declarative configuration of the IPAddressAllocationCondition type for use
+// with apply.
+//
+// IPAddressAllocationCondition describes the state of an IPAddressAllocation at a specific point in time.
+type IPAddressAllocationConditionApplyConfiguration struct {
+	// Type is the type of the condition.
+	Type *apiv1alpha1.IPAddressAllocationConditionType `json:"type,omitempty"`
+	// Status reflects whether the condition is True, False, or Unknown.
+	Status *v1.ConditionStatus `json:"status,omitempty"`
+	// LastTransitionTime is the timestamp of the last change to the condition's status.
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Reason provides a machine-readable explanation for the last status transition.
+	Reason *apiv1alpha1.IPAddressAllocationConditionReason `json:"reason,omitempty"`
+	// Message provides a human-readable explanation for the last status transition.
+	Message *string `json:"message,omitempty"`
+}
+
+// IPAddressAllocationConditionApplyConfiguration constructs a declarative configuration of the IPAddressAllocationCondition type for use with
+// apply.
+func IPAddressAllocationCondition() *IPAddressAllocationConditionApplyConfiguration {
+	return &IPAddressAllocationConditionApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *IPAddressAllocationConditionApplyConfiguration) WithType(value apiv1alpha1.IPAddressAllocationConditionType) *IPAddressAllocationConditionApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *IPAddressAllocationConditionApplyConfiguration) WithStatus(value v1.ConditionStatus) *IPAddressAllocationConditionApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithLastTransitionTime sets the LastTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastTransitionTime field is set to the value of the last call.
+func (b *IPAddressAllocationConditionApplyConfiguration) WithLastTransitionTime(value metav1.Time) *IPAddressAllocationConditionApplyConfiguration {
+	b.LastTransitionTime = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *IPAddressAllocationConditionApplyConfiguration) WithReason(value apiv1alpha1.IPAddressAllocationConditionReason) *IPAddressAllocationConditionApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *IPAddressAllocationConditionApplyConfiguration) WithMessage(value string) *IPAddressAllocationConditionApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// IPAddressAllocationSpecApplyConfiguration represents a declarative configuration of the IPAddressAllocationSpec type for use
+// with apply.
+//
+// IPAddressAllocationSpec defines the desired state of an IPAddressAllocation, including the pool reference and an optional requested IP.
+type IPAddressAllocationSpecApplyConfiguration struct {
+	// PoolRef is the reference to the network's IP pool within the namespace.
+	// It currently only supports reference to a Network.
+	PoolRef *v1.TypedLocalObjectReference `json:"poolRef,omitempty"`
+	// RequestedIP is an optional field for a user to specify a particular IP they want to request.
+	// If omitted, the system will allocate a single IP address.
+	RequestedIP *string `json:"requestedIP,omitempty"`
+}
+
+// IPAddressAllocationSpecApplyConfiguration constructs a declarative configuration of the IPAddressAllocationSpec type for use with
+// apply.
+func IPAddressAllocationSpec() *IPAddressAllocationSpecApplyConfiguration {
+	return &IPAddressAllocationSpecApplyConfiguration{}
+}
+
+// WithPoolRef sets the PoolRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PoolRef field is set to the value of the last call.
+func (b *IPAddressAllocationSpecApplyConfiguration) WithPoolRef(value v1.TypedLocalObjectReference) *IPAddressAllocationSpecApplyConfiguration {
+	b.PoolRef = &value
+	return b
+}
+
+// WithRequestedIP sets the RequestedIP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RequestedIP field is set to the value of the last call.
+func (b *IPAddressAllocationSpecApplyConfiguration) WithRequestedIP(value string) *IPAddressAllocationSpecApplyConfiguration {
+	b.RequestedIP = &value
+	return b
+}
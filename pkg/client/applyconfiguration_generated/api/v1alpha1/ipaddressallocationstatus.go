@@ -0,0 +1,45 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// IPAddressAllocationStatusApplyConfiguration represents a declarative configuration of the IPAddressAllocationStatus type for use
+// with apply.
+//
+// IPAddressAllocationStatus contains the current status of an IPAddressAllocation, including the allocated IP address and conditions.
+type IPAddressAllocationStatusApplyConfiguration struct {
+	// IPAddress is the actually allocated IP address.
+	IPAddress *string `json:"ipaddress,omitempty"`
+	// Conditions provide detailed information about the status of the allocation.
+	Conditions []IPAddressAllocationConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// IPAddressAllocationStatusApplyConfiguration constructs a declarative configuration of the IPAddressAllocationStatus type for use with
+// apply.
+func IPAddressAllocationStatus() *IPAddressAllocationStatusApplyConfiguration {
+	return &IPAddressAllocationStatusApplyConfiguration{}
+}
+
+// WithIPAddress sets the IPAddress field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPAddress field is set to the value of the last call.
+func (b *IPAddressAllocationStatusApplyConfiguration) WithIPAddress(value string) *IPAddressAllocationStatusApplyConfiguration {
+	b.IPAddress = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *IPAddressAllocationStatusApplyConfiguration) WithConditions(values ...*IPAddressAllocationConditionApplyConfiguration) *IPAddressAllocationStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
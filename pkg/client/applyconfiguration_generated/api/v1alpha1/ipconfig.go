@@ -0,0 +1,77 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// IPConfigApplyConfiguration represents a declarative configuration of the IPConfig type for use
+// with apply.
+//
+// IPConfig represents an IP configuration.
+type IPConfigApplyConfiguration struct {
+	// IP setting.
+	IP *string `json:"ip,omitempty"`
+	// IPFamily specifies the IP family (IPv4 vs IPv6) the IP belongs to.
+	IPFamily *v1.IPFamily `json:"ipFamily,omitempty"`
+	// Gateway setting.
+	Gateway *string `json:"gateway,omitempty"`
+	// SubnetMask setting.
+	// Deprecated: Use Prefix instead. If Prefix is set, SubnetMask is ignored.
+	SubnetMask *string `json:"subnetMask,omitempty"`
+	// Prefix is the prefix length for the IP address (e.g. 24 for a /24 IPv4 network,
+	// 64 for a /64 IPv6 network). If set, this field takes precedence over SubnetMask
+	// for both IPv4 and IPv6 addresses.
+	Prefix *int32 `json:"prefix,omitempty"`
+}
+
+// IPConfigApplyConfiguration constructs a declarative configuration of the IPConfig type for use with
+// apply.
+func IPConfig() *IPConfigApplyConfiguration {
+	return &IPConfigApplyConfiguration{}
+}
+
+// WithIP sets the IP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IP field is set to the value of the last call.
+func (b *IPConfigApplyConfiguration) WithIP(value string) *IPConfigApplyConfiguration {
+	b.IP = &value
+	return b
+}
+
+// WithIPFamily sets the IPFamily field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPFamily field is set to the value of the last call.
+func (b *IPConfigApplyConfiguration) WithIPFamily(value v1.IPFamily) *IPConfigApplyConfiguration {
+	b.IPFamily = &value
+	return b
+}
+
+// WithGateway sets the Gateway field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Gateway field is set to the value of the last call.
+func (b *IPConfigApplyConfiguration) WithGateway(value string) *IPConfigApplyConfiguration {
+	b.Gateway = &value
+	return b
+}
+
+// WithSubnetMask sets the SubnetMask field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SubnetMask field is set to the value of the last call.
+func (b *IPConfigApplyConfiguration) WithSubnetMask(value string) *IPConfigApplyConfiguration {
+	b.SubnetMask = &value
+	return b
+}
+
+// WithPrefix sets the Prefix field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Prefix field is set to the value of the last call.
+func (b *IPConfigApplyConfiguration) WithPrefix(value int32) *IPConfigApplyConfiguration {
+	b.Prefix = &value
+	return b
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+)
+
+// IPPoolConditionApplyConfiguration represents a declarative configuration of the IPPoolCondition type for use
+// with apply.
+//
+// IPPoolCondition describes the state of a IPPool at a certain point.
+type IPPoolConditionApplyConfiguration struct {
+	// Type is the type of IPPool condition.
+	Type *apiv1alpha1.IPPoolConditionType `json:"type,omitempty"`
+	// Status is the status of the condition.
+	// Can be True, False, Unknown.
+	Status *v1.ConditionStatus `json:"status,omitempty"`
+	// Machine understandable string that gives the reason for condition's last transition.
+	Reason *string `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition.
+	Message *string `json:"message,omitempty"`
+}
+
+// IPPoolConditionApplyConfiguration constructs a declarative configuration of the IPPoolCondition type for use with
+// apply.
+func IPPoolCondition() *IPPoolConditionApplyConfiguration {
+	return &IPPoolConditionApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *IPPoolConditionApplyConfiguration) WithType(value apiv1alpha1.IPPoolConditionType) *IPPoolConditionApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *IPPoolConditionApplyConfiguration) WithStatus(value v1.ConditionStatus) *IPPoolConditionApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *IPPoolConditionApplyConfiguration) WithReason(value string) *IPPoolConditionApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *IPPoolConditionApplyConfiguration) WithMessage(value string) *IPPoolConditionApplyConfiguration {
+	b.Message = &value
+	return b
+}
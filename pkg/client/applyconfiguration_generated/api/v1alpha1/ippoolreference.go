@@ -0,0 +1,39 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// IPPoolReferenceApplyConfiguration represents a declarative configuration of the IPPoolReference type for use
+// with apply.
+type IPPoolReferenceApplyConfiguration struct {
+	// Name of the IPPool resource being referenced.
+	//
+	Name *string `json:"name,omitempty"`
+	// API version of the referent.
+	APIVersion *string `json:"apiVersion,omitempty"`
+}
+
+// IPPoolReferenceApplyConfiguration constructs a declarative configuration of the IPPoolReference type for use with
+// apply.
+func IPPoolReference() *IPPoolReferenceApplyConfiguration {
+	return &IPPoolReferenceApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *IPPoolReferenceApplyConfiguration) WithName(value string) *IPPoolReferenceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *IPPoolReferenceApplyConfiguration) WithAPIVersion(value string) *IPPoolReferenceApplyConfiguration {
+	b.APIVersion = &value
+	return b
+}
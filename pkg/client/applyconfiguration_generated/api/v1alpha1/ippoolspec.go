@@ -0,0 +1,40 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// IPPoolSpecApplyConfiguration represents a declarative configuration of the IPPoolSpec type for use
+// with apply.
+//
+// IPPoolSpec defines the desired state of IPPool.
+type IPPoolSpecApplyConfiguration struct {
+	// StartingAddress represents the starting IP address of the pool.
+	StartingAddress *string `json:"startingAddress,omitempty"`
+	// AddressCount represents the number of IP addresses in the pool.
+	AddressCount *int64 `json:"addressCount,omitempty"`
+}
+
+// IPPoolSpecApplyConfiguration constructs a declarative configuration of the IPPoolSpec type for use with
+// apply.
+func IPPoolSpec() *IPPoolSpecApplyConfiguration {
+	return &IPPoolSpecApplyConfiguration{}
+}
+
+// WithStartingAddress sets the StartingAddress field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartingAddress field is set to the value of the last call.
+func (b *IPPoolSpecApplyConfiguration) WithStartingAddress(value string) *IPPoolSpecApplyConfiguration {
+	b.StartingAddress = &value
+	return b
+}
+
+// WithAddressCount sets the AddressCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AddressCount field is set to the value of the last call.
+func (b *IPPoolSpecApplyConfiguration) WithAddressCount(value int64) *IPPoolSpecApplyConfiguration {
+	b.AddressCount = &value
+	return b
+}
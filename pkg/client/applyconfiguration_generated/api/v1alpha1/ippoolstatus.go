@@ -0,0 +1,45 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// IPPoolStatusApplyConfiguration represents a declarative configuration of the IPPoolStatus type for use
+// with apply.
+//
+// IPPoolStatus defines the current state of IPPool.
+type IPPoolStatusApplyConfiguration struct {
+	// Allocated represents the number of IP addresses currently allocated to services.
+	Allocated *int64 `json:"allocated,omitempty"`
+	// Conditions is an array of current observed IPPool conditions.
+	Conditions []IPPoolConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// IPPoolStatusApplyConfiguration constructs a declarative configuration of the IPPoolStatus type for use with
+// apply.
+func IPPoolStatus() *IPPoolStatusApplyConfiguration {
+	return &IPPoolStatusApplyConfiguration{}
+}
+
+// WithAllocated sets the Allocated field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Allocated field is set to the value of the last call.
+func (b *IPPoolStatusApplyConfiguration) WithAllocated(value int64) *IPPoolStatusApplyConfiguration {
+	b.Allocated = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *IPPoolStatusApplyConfiguration) WithConditions(values ...*IPPoolConditionApplyConfiguration) *IPPoolStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
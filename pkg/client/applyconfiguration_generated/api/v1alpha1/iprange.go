@@ -0,0 +1,42 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// IPRangeApplyConfiguration represents a declarative configuration of the IPRange type for use
+// with apply.
+//
+// IPRange defines a contiguous block of IP addresses.
+type IPRangeApplyConfiguration struct {
+	// startingAddress is the first IP address of the range. Accepts both IPv4 and IPv6 addresses.
+	//
+	StartingAddress *string `json:"startingAddress,omitempty"`
+	// addressCount is the number of IP addresses in the range.
+	//
+	AddressCount *int64 `json:"addressCount,omitempty"`
+}
+
+// IPRangeApplyConfiguration constructs a declarative configuration of the IPRange type for use with
+// apply.
+func IPRange() *IPRangeApplyConfiguration {
+	return &IPRangeApplyConfiguration{}
+}
+
+// WithStartingAddress sets the StartingAddress field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartingAddress field is set to the value of the last call.
+func (b *IPRangeApplyConfiguration) WithStartingAddress(value string) *IPRangeApplyConfiguration {
+	b.StartingAddress = &value
+	return b
+}
+
+// WithAddressCount sets the AddressCount field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AddressCount field is set to the value of the last call.
+func (b *IPRangeApplyConfiguration) WithAddressCount(value int64) *IPRangeApplyConfiguration {
+	b.AddressCount = &value
+	return b
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// LoadBalancerConfigProviderReferenceApplyConfiguration represents a declarative configuration of the LoadBalancerConfigProviderReference type for use
+// with apply.
+//
+// LoadBalancerConfigProviderReference represents the specific load balancer instance that needs to be configured
+type LoadBalancerConfigProviderReferenceApplyConfiguration struct {
+	// APIGroup is the group for the resource being referenced
+	APIGroup *string `json:"apiGroup,omitempty"`
+	// Kind is the type of resource being referenced
+	Kind *string `json:"kind,omitempty"`
+	// Name is the name of resource being referenced
+	Name *string `json:"name,omitempty"`
+	// API version of the referent
+	APIVersion *string `json:"apiVersion,omitempty"`
+}
+
+// LoadBalancerConfigProviderReferenceApplyConfiguration constructs a declarative configuration of the LoadBalancerConfigProviderReference type for use with
+// apply.
+func LoadBalancerConfigProviderReference() *LoadBalancerConfigProviderReferenceApplyConfiguration {
+	return &LoadBalancerConfigProviderReferenceApplyConfiguration{}
+}
+
+// WithAPIGroup sets the APIGroup field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIGroup field is set to the value of the last call.
+func (b *LoadBalancerConfigProviderReferenceApplyConfiguration) WithAPIGroup(value string) *LoadBalancerConfigProviderReferenceApplyConfiguration {
+	b.APIGroup = &value
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *LoadBalancerConfigProviderReferenceApplyConfiguration) WithKind(value string) *LoadBalancerConfigProviderReferenceApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *LoadBalancerConfigProviderReferenceApplyConfiguration) WithName(value string) *LoadBalancerConfigProviderReferenceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *LoadBalancerConfigProviderReferenceApplyConfiguration) WithAPIVersion(value string) *LoadBalancerConfigProviderReferenceApplyConfiguration {
+	b.APIVersion = &value
+	return b
+}
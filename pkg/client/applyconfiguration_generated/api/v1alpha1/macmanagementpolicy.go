@@ -0,0 +1,62 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// MacManagementPolicyApplyConfiguration represents a declarative configuration of the MacManagementPolicy type for use
+// with apply.
+//
+// MacManagementPolicy represents the MAC management policy configuration.
+type MacManagementPolicyApplyConfiguration struct {
+	// AllowPromiscuous indicates whether promiscuous mode is enabled. Determines whether or not all
+	// traffic is seen on the port.
+	AllowPromiscuous *bool `json:"allowPromiscuous,omitempty"`
+	// MacChanges specifies whether virtual machines can receive frames with a Mac Address that is different from the one configured in the VMX.
+	MacChanges *bool `json:"macChanges,omitempty"`
+	// ForgedTransmits indicates whether or not the virtual network adapter should be allowed to send
+	// network traffic with a different MAC address than the one assigned to it.
+	ForgedTransmits *bool `json:"forgedTransmits,omitempty"`
+	// MacLearningPolicy represents the MAC learning policy configuration.
+	MacLearningPolicy *MacLearningPolicyApplyConfiguration `json:"macLearningPolicy,omitempty"`
+}
+
+// MacManagementPolicyApplyConfiguration constructs a declarative configuration of the MacManagementPolicy type for use with
+// apply.
+func MacManagementPolicy() *MacManagementPolicyApplyConfiguration {
+	return &MacManagementPolicyApplyConfiguration{}
+}
+
+// WithAllowPromiscuous sets the AllowPromiscuous field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AllowPromiscuous field is set to the value of the last call.
+func (b *MacManagementPolicyApplyConfiguration) WithAllowPromiscuous(value bool) *MacManagementPolicyApplyConfiguration {
+	b.AllowPromiscuous = &value
+	return b
+}
+
+// WithMacChanges sets the MacChanges field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MacChanges field is set to the value of the last call.
+func (b *MacManagementPolicyApplyConfiguration) WithMacChanges(value bool) *MacManagementPolicyApplyConfiguration {
+	b.MacChanges = &value
+	return b
+}
+
+// WithForgedTransmits sets the ForgedTransmits field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ForgedTransmits field is set to the value of the last call.
+func (b *MacManagementPolicyApplyConfiguration) WithForgedTransmits(value bool) *MacManagementPolicyApplyConfiguration {
+	b.ForgedTransmits = &value
+	return b
+}
+
+// WithMacLearningPolicy sets the MacLearningPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MacLearningPolicy field is set to the value of the last call.
+func (b *MacManagementPolicyApplyConfiguration) WithMacLearningPolicy(value *MacLearningPolicyApplyConfiguration) *MacManagementPolicyApplyConfiguration {
+	b.MacLearningPolicy = value
+	return b
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+// NamespaceNetworkAssociationApplyConfiguration represents a declarative configuration of the NamespaceNetworkAssociation type for use
+// with apply.
+//
+// NamespaceNetworkAssociation describes the reconciliation state of a
+// single Namespace associated with a NamespaceNetworkConfiguration.
+type NamespaceNetworkAssociationApplyConfiguration struct {
+	// name is the name of the associated Namespace.
+	//
+	Name *string `json:"name,omitempty"`
+	// status is the reconciliation state for this Namespace.
+	//
+	Status *apiv1alpha1.NamespaceNetworkReconciliationStatus `json:"status,omitempty"`
+	// message provides a human-readable explanation when the Namespace has not
+	// yet reached the Reconciled state.
+	//
+	Message *string `json:"message,omitempty"`
+}
+
+// NamespaceNetworkAssociationApplyConfiguration constructs a declarative configuration of the NamespaceNetworkAssociation type for use with
+// apply.
+func NamespaceNetworkAssociation() *NamespaceNetworkAssociationApplyConfiguration {
+	return &NamespaceNetworkAssociationApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *NamespaceNetworkAssociationApplyConfiguration) WithName(value string) *NamespaceNetworkAssociationApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *NamespaceNetworkAssociationApplyConfiguration) WithStatus(value apiv1alpha1.NamespaceNetworkReconciliationStatus) *NamespaceNetworkAssociationApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *NamespaceNetworkAssociationApplyConfiguration) WithMessage(value string) *NamespaceNetworkAssociationApplyConfiguration {
+	b.Message = &value
+	return b
+}
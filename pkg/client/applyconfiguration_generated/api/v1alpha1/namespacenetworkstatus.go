@@ -0,0 +1,63 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// NamespaceNetworkStatusApplyConfiguration represents a declarative configuration of the NamespaceNetworkStatus type for use
+// with apply.
+//
+// NamespaceNetworkStatus describes the observed state of the
+// NamespaceNetworkConfiguration.
+type NamespaceNetworkStatusApplyConfiguration struct {
+	// conditions describe the current state of the NamespaceNetworkConfiguration.
+	// The Ready condition is True when all networking resources owned by this
+	// configuration have been created and all associated Namespaces have been
+	// fully reconciled.
+	//
+	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// associatedNamespaces lists each Namespace currently associated with this
+	// NamespaceNetworkConfiguration and its individual reconciliation state. Net
+	// Operator updates this list as Namespaces are attached or detached via the
+	// NamespaceNetworkLabelKey label.
+	//
+	AssociatedNamespaces []NamespaceNetworkAssociationApplyConfiguration `json:"associatedNamespaces,omitempty"`
+}
+
+// NamespaceNetworkStatusApplyConfiguration constructs a declarative configuration of the NamespaceNetworkStatus type for use with
+// apply.
+func NamespaceNetworkStatus() *NamespaceNetworkStatusApplyConfiguration {
+	return &NamespaceNetworkStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *NamespaceNetworkStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *NamespaceNetworkStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithAssociatedNamespaces adds the given value to the AssociatedNamespaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AssociatedNamespaces field.
+func (b *NamespaceNetworkStatusApplyConfiguration) WithAssociatedNamespaces(values ...*NamespaceNetworkAssociationApplyConfiguration) *NamespaceNetworkStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAssociatedNamespaces")
+		}
+		b.AssociatedNamespaces = append(b.AssociatedNamespaces, *values[i])
+	}
+	return b
+}
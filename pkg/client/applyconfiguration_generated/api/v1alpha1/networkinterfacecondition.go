@@ -0,0 +1,78 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NetworkInterfaceConditionApplyConfiguration represents a declarative configuration of the NetworkInterfaceCondition type for use
+// with apply.
+//
+// NetworkInterfaceCondition describes the state of a NetworkInterface at a certain point.
+type NetworkInterfaceConditionApplyConfiguration struct {
+	// Type is the type of network interface condition.
+	Type *apiv1alpha1.NetworkInterfaceConditionType `json:"type,omitempty"`
+	// Status is the status of the condition.
+	// Can be True, False, Unknown.
+	Status *v1.ConditionStatus `json:"status,omitempty"`
+	// LastTransitionTime is the timestamp corresponding to the last status
+	// change of this condition.
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Machine understandable string that gives the reason for condition's last transition.
+	Reason *apiv1alpha1.NetworkInterfaceConditionReason `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition.
+	Message *string `json:"message,omitempty"`
+}
+
+// NetworkInterfaceConditionApplyConfiguration constructs a declarative configuration of the NetworkInterfaceCondition type for use with
+// apply.
+func NetworkInterfaceCondition() *NetworkInterfaceConditionApplyConfiguration {
+	return &NetworkInterfaceConditionApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *NetworkInterfaceConditionApplyConfiguration) WithType(value apiv1alpha1.NetworkInterfaceConditionType) *NetworkInterfaceConditionApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *NetworkInterfaceConditionApplyConfiguration) WithStatus(value v1.ConditionStatus) *NetworkInterfaceConditionApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithLastTransitionTime sets the LastTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastTransitionTime field is set to the value of the last call.
+func (b *NetworkInterfaceConditionApplyConfiguration) WithLastTransitionTime(value metav1.Time) *NetworkInterfaceConditionApplyConfiguration {
+	b.LastTransitionTime = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *NetworkInterfaceConditionApplyConfiguration) WithReason(value apiv1alpha1.NetworkInterfaceConditionReason) *NetworkInterfaceConditionApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *NetworkInterfaceConditionApplyConfiguration) WithMessage(value string) *NetworkInterfaceConditionApplyConfiguration {
+	b.Message = &value
+	return b
+}
@@ -0,0 +1,30 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// NetworkInterfacePortAllocationApplyConfiguration represents a declarative configuration of the NetworkInterfacePortAllocation type for use
+// with apply.
+//
+// NetworkInterfacePortAllocation describes the settings for network interface port allocation request.
+type NetworkInterfacePortAllocationApplyConfiguration struct {
+	// NodeName is the node where port must be allocated for this network interface.
+	NodeName *string `json:"nodeName,omitempty"`
+}
+
+// NetworkInterfacePortAllocationApplyConfiguration constructs a declarative configuration of the NetworkInterfacePortAllocation type for use with
+// apply.
+func NetworkInterfacePortAllocation() *NetworkInterfacePortAllocationApplyConfiguration {
+	return &NetworkInterfacePortAllocationApplyConfiguration{}
+}
+
+// WithNodeName sets the NodeName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeName field is set to the value of the last call.
+func (b *NetworkInterfacePortAllocationApplyConfiguration) WithNodeName(value string) *NetworkInterfacePortAllocationApplyConfiguration {
+	b.NodeName = &value
+	return b
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+// NetworkInterfaceStatusApplyConfiguration represents a declarative configuration of the NetworkInterfaceStatus type for use
+// with apply.
+//
+// NetworkInterfaceStatus defines the observed state of NetworkInterface.
+// Once NetworkInterfaceReady condition is True, it should contain configuration to use to place
+// a VM/Pod/Container's nic on the specified network.
+type NetworkInterfaceStatusApplyConfiguration struct {
+	// Conditions is an array of current observed network interface conditions.
+	Conditions []NetworkInterfaceConditionApplyConfiguration `json:"conditions,omitempty"`
+	// IPConfigs is an array of IP configurations for the network interface.
+	IPConfigs []IPConfigApplyConfiguration `json:"ipConfigs,omitempty"`
+	// MacAddress setting for the network interface.
+	MacAddress *string `json:"macAddress,omitempty"`
+	// ExternalID is a network provider specific identifier assigned to the network interface.
+	ExternalID *string `json:"externalID,omitempty"`
+	// NetworkID is an network provider specific identifier for the network backing the network
+	// interface.
+	NetworkID *string `json:"networkID,omitempty"`
+	// PortID is a network provider specific port identifier allocated for this network interface on
+	// the backing network. It is only valid on requested node and is set only if port allocation
+	// was requested.
+	PortID *string `json:"portID,omitempty"`
+	// ConnectionID is a network provider specific port connection identifier allocated for this
+	// network interface on the backing network. It is only valid on requested node and is set
+	// only if port allocation was requested.
+	ConnectionID *string `json:"connectionID,omitempty"`
+	// IPAssignmentMode indicates how IPv4 addresses are assigned to this interface.
+	// When unset:
+	// - If IP is assigned, it is assumed to be NetworkInterfaceIPAssignmentModeStaticPool.
+	// - If IP is unassigned, it is assumed to be NetworkInterfaceIPAssignmentModeDHCP.
+	// When set to NetworkInterfaceIPAssignmentModeStaticPool, indicates IP is assigned from a static pool.
+	// When set to NetworkInterfaceIPAssignmentModeDHCP, indicates IP should be obtained via DHCP.
+	// When set to NetworkInterfaceIPAssignmentModeNone, indicates no IP assignment should be performed.
+	IPAssignmentMode *apiv1alpha1.NetworkInterfaceIPAssignmentMode `json:"ipAssignmentMode,omitempty"`
+	// IPv6AssignmentMode indicates how IPv6 addresses are assigned to this interface.
+	// This field is independent of IPAssignmentMode, allowing different assignment modes for IPv4
+	// and IPv6 (e.g., IPv4 uses DHCP while IPv6 uses static pool).
+	// When unset, defaults to IPAssignmentModeNone (IPv6 disabled) for backward compatibility with existing IPv4-only
+	// deployments.
+	// When set to NetworkInterfaceIPAssignmentModeStaticPool, indicates IPv6 is assigned from a static pool.
+	// When set to NetworkInterfaceIPAssignmentModeDHCP, indicates IPv6 should be obtained via DHCPv6.
+	// When set to NetworkInterfaceIPAssignmentModeNone, indicates no IPv6 assignment should be performed.
+	IPv6AssignmentMode *apiv1alpha1.NetworkInterfaceIPAssignmentMode `json:"ipv6AssignmentMode,omitempty"`
+}
+
+// NetworkInterfaceStatusApplyConfiguration constructs a declarative configuration of the NetworkInterfaceStatus type for use with
+// apply.
+func NetworkInterfaceStatus() *NetworkInterfaceStatusApplyConfiguration {
+	return &NetworkInterfaceStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *NetworkInterfaceStatusApplyConfiguration) WithConditions(values ...*NetworkInterfaceConditionApplyConfiguration) *NetworkInterfaceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithIPConfigs adds the given value to the IPConfigs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IPConfigs field.
+func (b *NetworkInterfaceStatusApplyConfiguration) WithIPConfigs(values ...*IPConfigApplyConfiguration) *NetworkInterfaceStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithIPConfigs")
+		}
+		b.IPConfigs = append(b.IPConfigs, *values[i])
+	}
+	return b
+}
+
+// WithMacAddress sets the MacAddress field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MacAddress field is set to the value of the last call.
+func (b *NetworkInterfaceStatusApplyConfiguration) WithMacAddress(value string) *NetworkInterfaceStatusApplyConfiguration {
+	b.MacAddress = &value
+	return b
+}
+
+// WithExternalID sets the ExternalID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExternalID field is set to the value of the last call.
+func (b *NetworkInterfaceStatusApplyConfiguration) WithExternalID(value string) *NetworkInterfaceStatusApplyConfiguration {
+	b.ExternalID = &value
+	return b
+}
+
+// WithNetworkID sets the NetworkID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkID field is set to the value of the last call.
+func (b *NetworkInterfaceStatusApplyConfiguration) WithNetworkID(value string) *NetworkInterfaceStatusApplyConfiguration {
+	b.NetworkID = &value
+	return b
+}
+
+// WithPortID sets the PortID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PortID field is set to the value of the last call.
+func (b *NetworkInterfaceStatusApplyConfiguration) WithPortID(value string) *NetworkInterfaceStatusApplyConfiguration {
+	b.PortID = &value
+	return b
+}
+
+// WithConnectionID sets the ConnectionID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConnectionID field is set to the value of the last call.
+func (b *NetworkInterfaceStatusApplyConfiguration) WithConnectionID(value string) *NetworkInterfaceStatusApplyConfiguration {
+	b.ConnectionID = &value
+	return b
+}
+
+// WithIPAssignmentMode sets the IPAssignmentMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPAssignmentMode field is set to the value of the last call.
+func (b *NetworkInterfaceStatusApplyConfiguration) WithIPAssignmentMode(value apiv1alpha1.NetworkInterfaceIPAssignmentMode) *NetworkInterfaceStatusApplyConfiguration {
+	b.IPAssignmentMode = &value
+	return b
+}
+
+// WithIPv6AssignmentMode sets the IPv6AssignmentMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPv6AssignmentMode field is set to the value of the last call.
+func (b *NetworkInterfaceStatusApplyConfiguration) WithIPv6AssignmentMode(value apiv1alpha1.NetworkInterfaceIPAssignmentMode) *NetworkInterfaceStatusApplyConfiguration {
+	b.IPv6AssignmentMode = &value
+	return b
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+// NetworkSpecApplyConfiguration represents a declarative configuration of the NetworkSpec type for use
+// with apply.
+//
+// NetworkSpec defines the state of Network.
+type NetworkSpecApplyConfiguration struct {
+	// Type describes type of Network. Supported values are nsx-t, vsphere-distributed.
+	Type *apiv1alpha1.NetworkType `json:"type,omitempty"`
+	// ProviderRef is reference to a network provider object that provides this type of network.
+	ProviderRef *NetworkProviderReferenceApplyConfiguration `json:"providerRef,omitempty"`
+	// DNS is a list of DNS server IPs to associate with network interfaces on this network.
+	DNS []string `json:"dns,omitempty"`
+	// DNSSearchDomains is a list of DNS search domains to associate with network interfaces on this network.
+	DNSSearchDomains []string `json:"dnsSearchDomains,omitempty"`
+	// NTP is a list of NTP server DNS names or IP addresses to use on this network.
+	NTP []string `json:"ntp,omitempty"`
+}
+
+// NetworkSpecApplyConfiguration constructs a declarative configuration of the NetworkSpec type for use with
+// apply.
+func NetworkSpec() *NetworkSpecApplyConfiguration {
+	return &NetworkSpecApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *NetworkSpecApplyConfiguration) WithType(value apiv1alpha1.NetworkType) *NetworkSpecApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithProviderRef sets the ProviderRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProviderRef field is set to the value of the last call.
+func (b *NetworkSpecApplyConfiguration) WithProviderRef(value *NetworkProviderReferenceApplyConfiguration) *NetworkSpecApplyConfiguration {
+	b.ProviderRef = value
+	return b
+}
+
+// WithDNS adds the given value to the DNS field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DNS field.
+func (b *NetworkSpecApplyConfiguration) WithDNS(values ...string) *NetworkSpecApplyConfiguration {
+	for i := range values {
+		b.DNS = append(b.DNS, values[i])
+	}
+	return b
+}
+
+// WithDNSSearchDomains adds the given value to the DNSSearchDomains field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the DNSSearchDomains field.
+func (b *NetworkSpecApplyConfiguration) WithDNSSearchDomains(values ...string) *NetworkSpecApplyConfiguration {
+	for i := range values {
+		b.DNSSearchDomains = append(b.DNSSearchDomains, values[i])
+	}
+	return b
+}
+
+// WithNTP adds the given value to the NTP field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NTP field.
+func (b *NetworkSpecApplyConfiguration) WithNTP(values ...string) *NetworkSpecApplyConfiguration {
+	for i := range values {
+		b.NTP = append(b.NTP, values[i])
+	}
+	return b
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+// NSXTier1ConfigApplyConfiguration represents a declarative configuration of the NSXTier1Config type for use
+// with apply.
+//
+// NSXTier1Config specifies the NSX Tier-1 override configuration for a namespace.
+//
+// When this field is absent on the parent NamespaceNetworkSpec, the namespace
+// inherits the cluster-level defaults from the NSX Container Plugin (NCP) in inherit mode.
+// When present, the fields here override those defaults for this namespace in override mode.
+type NSXTier1ConfigApplyConfiguration struct {
+	// namespaceCIDRs specifies CIDR blocks from which Kubernetes allocates IP
+	// addresses for all workloads (such as Pods and VMs) that attach to the namespace.
+	// These ranges must not overlap with ingressCIDRs, egressCIDRs, or other services running in the datacenter.
+	// Required when tier0Gateway or any of ingressCIDRs or egressCIDRs are
+	// specified. This field is append-only; existing entries cannot be removed.
+	//
+	NamespaceCIDRs []string `json:"namespaceCIDRs,omitempty"`
+	// ingressCIDRs specifies CIDR blocks from which NSX assigns IP addresses
+	// for Kubernetes Ingresses and Services of type LoadBalancer. These ranges
+	// must not overlap with namespaceCIDRs, egressCIDRs, or other services
+	// running in the datacenter. Required when tier0Gateway or any of
+	// namespaceCIDRs or egressCIDRs are specified. This field is append-only; existing entries cannot be removed.
+	//
+	IngressCIDRs []string `json:"ingressCIDRs,omitempty"`
+	// egressCIDRs specifies CIDR blocks from which NSX assigns IPs used for
+	// SNAT from container IPs to external IPs. Must not be set when routingMode
+	// is Routed. Required when routingMode is NAT and tier0Gateway or any of
+	// namespaceCIDRs or ingressCIDRs are specified. This field is append-only; existing entries cannot be removed.
+	//
+	EgressCIDRs []string `json:"egressCIDRs,omitempty"`
+	// tier0Gateway is the NSX policy path of the Tier-0 Gateway used for this
+	// namespace (e.g., /infra/tier-0s/<t0-id>). When unset, the cluster-level Tier-0 Gateway from the global NSX Container Plugin (NCP)
+	// configuration is applied. Leaving this field unset allows partial overrides of other parameters.
+	// This field is immutable once set.
+	//
+	Tier0Gateway *string `json:"tier0Gateway,omitempty"`
+	// subnetPrefixLength is the prefix length of subnets reserved for namespace
+	// segments (e.g., 28 for a /28 subnet). Range: [1, 29]. Values 30, 31, and 32
+	// are invalid because NSX-T/NCP reserves 3 IP addresses (network, gateway,
+	// and broadcast) per logical segment, and the vCenter UI enforces a maximum of 29.
+	// When unset, the cluster-level default from the global NSX Container Plugin (NCP)
+	// configuration is applied. Leaving this field unset allows partial overrides of other parameters.
+	// This field is immutable once set.
+	//
+	SubnetPrefixLength *int32 `json:"subnetPrefixLength,omitempty"`
+	// routingMode specifies whether traffic leaving the namespace is NATed.
+	// When set to Routed, egressCIDRs must not be specified. When unset,
+	// defaults to NAT programmatically at the global NSX Container Plugin (NCP) cluster level.
+	// This field is immutable once set.
+	//
+	RoutingMode *apiv1alpha1.NSXTier1RoutingMode `json:"routingMode,omitempty"`
+	// loadBalancerSize specifies the size of the NSX Load Balancer provisioned
+	// for this namespace. When unset, defaults programmatically to Small.
+	// This field is immutable once set.
+	//
+	LoadBalancerSize *apiv1alpha1.NSXLoadBalancerSize `json:"loadBalancerSize,omitempty"`
+}
+
+// NSXTier1ConfigApplyConfiguration constructs a declarative configuration of the NSXTier1Config type for use with
+// apply.
+func NSXTier1Config() *NSXTier1ConfigApplyConfiguration {
+	return &NSXTier1ConfigApplyConfiguration{}
+}
+
+// WithNamespaceCIDRs adds the given value to the NamespaceCIDRs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NamespaceCIDRs field.
+func (b *NSXTier1ConfigApplyConfiguration) WithNamespaceCIDRs(values ...string) *NSXTier1ConfigApplyConfiguration {
+	for i := range values {
+		b.NamespaceCIDRs = append(b.NamespaceCIDRs, values[i])
+	}
+	return b
+}
+
+// WithIngressCIDRs adds the given value to the IngressCIDRs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IngressCIDRs field.
+func (b *NSXTier1ConfigApplyConfiguration) WithIngressCIDRs(values ...string) *NSXTier1ConfigApplyConfiguration {
+	for i := range values {
+		b.IngressCIDRs = append(b.IngressCIDRs, values[i])
+	}
+	return b
+}
+
+// WithEgressCIDRs adds the given value to the EgressCIDRs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EgressCIDRs field.
+func (b *NSXTier1ConfigApplyConfiguration) WithEgressCIDRs(values ...string) *NSXTier1ConfigApplyConfiguration {
+	for i := range values {
+		b.EgressCIDRs = append(b.EgressCIDRs, values[i])
+	}
+	return b
+}
+
+// WithTier0Gateway sets the Tier0Gateway field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Tier0Gateway field is set to the value of the last call.
+func (b *NSXTier1ConfigApplyConfiguration) WithTier0Gateway(value string) *NSXTier1ConfigApplyConfiguration {
+	b.Tier0Gateway = &value
+	return b
+}
+
+// WithSubnetPrefixLength sets the SubnetPrefixLength field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SubnetPrefixLength field is set to the value of the last call.
+func (b *NSXTier1ConfigApplyConfiguration) WithSubnetPrefixLength(value int32) *NSXTier1ConfigApplyConfiguration {
+	b.SubnetPrefixLength = &value
+	return b
+}
+
+// WithRoutingMode sets the RoutingMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RoutingMode field is set to the value of the last call.
+func (b *NSXTier1ConfigApplyConfiguration) WithRoutingMode(value apiv1alpha1.NSXTier1RoutingMode) *NSXTier1ConfigApplyConfiguration {
+	b.RoutingMode = &value
+	return b
+}
+
+// WithLoadBalancerSize sets the LoadBalancerSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LoadBalancerSize field is set to the value of the last call.
+func (b *NSXTier1ConfigApplyConfiguration) WithLoadBalancerSize(value apiv1alpha1.NSXLoadBalancerSize) *NSXTier1ConfigApplyConfiguration {
+	b.LoadBalancerSize = &value
+	return b
+}
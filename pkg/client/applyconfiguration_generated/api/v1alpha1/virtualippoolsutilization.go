@@ -0,0 +1,42 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// VirtualIPPoolsUtilizationApplyConfiguration represents a declarative configuration of the VirtualIPPoolsUtilization type for use
+// with apply.
+//
+// VirtualIPPoolsUtilization defines the IP addresses utilization for virtual IPPools resource.
+type VirtualIPPoolsUtilizationApplyConfiguration struct {
+	// IPsAllocated represents the total number of virtual IP addresses currently allocated to services.
+	//
+	IPsAllocated *int64 `json:"ipsAllocated,omitempty"`
+	// IPsAvailable represents the total number of virtual IP addresses eligible to be used for services.
+	//
+	IPsAvailable *int64 `json:"ipsAvailable,omitempty"`
+}
+
+// VirtualIPPoolsUtilizationApplyConfiguration constructs a declarative configuration of the VirtualIPPoolsUtilization type for use with
+// apply.
+func VirtualIPPoolsUtilization() *VirtualIPPoolsUtilizationApplyConfiguration {
+	return &VirtualIPPoolsUtilizationApplyConfiguration{}
+}
+
+// WithIPsAllocated sets the IPsAllocated field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPsAllocated field is set to the value of the last call.
+func (b *VirtualIPPoolsUtilizationApplyConfiguration) WithIPsAllocated(value int64) *VirtualIPPoolsUtilizationApplyConfiguration {
+	b.IPsAllocated = &value
+	return b
+}
+
+// WithIPsAvailable sets the IPsAvailable field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPsAvailable field is set to the value of the last call.
+func (b *VirtualIPPoolsUtilizationApplyConfiguration) WithIPsAvailable(value int64) *VirtualIPPoolsUtilizationApplyConfiguration {
+	b.IPsAvailable = &value
+	return b
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// VSphereDistributedConfigApplyConfiguration represents a declarative configuration of the VSphereDistributedConfig type for use
+// with apply.
+//
+// VSphereDistributedConfig specifies the vSphere Distributed (VDS) network
+// configuration for a namespace.
+type VSphereDistributedConfigApplyConfiguration struct {
+	// networks lists the VSphereDistributedNetwork resources to present inside
+	// each associated Namespace. Each entry results in a corresponding Network
+	// created inside each associated Namespace, with a reference to the
+	// corresponding VSphereDistributedNetwork. When an entry is removed from
+	// this list, the corresponding Network is deleted from all associated
+	// Namespaces. At least one entry is required; the entry referenced by
+	// defaultNetwork cannot be removed once Namespaces are associated
+	// with this NamespaceNetworkConfiguration.
+	//
+	Networks []VSphereDistributedNetworkRefApplyConfiguration `json:"networks,omitempty"`
+	// defaultNetwork is the name of one of the entries in networks. The
+	// generated Network corresponding to this entry is labeled
+	// netoperator.vmware.com/is-default: "true", making it the network
+	// resolved by workloads that do not explicitly select a network.
+	//
+	// The referenced VSphereDistributedNetwork must not have an IP assignment
+	// mode of None; a network with no IP assignment cannot serve as a workload
+	// default.
+	//
+	// This field is immutable once set and cannot be removed while Namespaces
+	// are associated with this NamespaceNetworkConfiguration.
+	//
+	DefaultNetwork *string `json:"defaultNetwork,omitempty"`
+}
+
+// VSphereDistributedConfigApplyConfiguration constructs a declarative configuration of the VSphereDistributedConfig type for use with
+// apply.
+func VSphereDistributedConfig() *VSphereDistributedConfigApplyConfiguration {
+	return &VSphereDistributedConfigApplyConfiguration{}
+}
+
+// WithNetworks adds the given value to the Networks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Networks field.
+func (b *VSphereDistributedConfigApplyConfiguration) WithNetworks(values ...*VSphereDistributedNetworkRefApplyConfiguration) *VSphereDistributedConfigApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNetworks")
+		}
+		b.Networks = append(b.Networks, *values[i])
+	}
+	return b
+}
+
+// WithDefaultNetwork sets the DefaultNetwork field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultNetwork field is set to the value of the last call.
+func (b *VSphereDistributedConfigApplyConfiguration) WithDefaultNetwork(value string) *VSphereDistributedConfigApplyConfiguration {
+	b.DefaultNetwork = &value
+	return b
+}
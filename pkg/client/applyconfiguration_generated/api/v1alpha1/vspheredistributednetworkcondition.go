@@ -0,0 +1,77 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VSphereDistributedNetworkConditionApplyConfiguration represents a declarative configuration of the VSphereDistributedNetworkCondition type for use
+// with apply.
+//
+// VSphereDistributedNetworkCondition describes the state of a VSphereDistributedNetwork at a certain point.
+type VSphereDistributedNetworkConditionApplyConfiguration struct {
+	// Type is the type of VSphereDistributedNetwork condition.
+	Type *apiv1alpha1.VSphereDistributedNetworkConditionType `json:"type,omitempty"`
+	// Status is the status of the condition.
+	// Can be True, False, Unknown.
+	Status *v1.ConditionStatus `json:"status,omitempty"`
+	// Machine understandable string that gives the reason for condition's last transition.
+	Reason *string `json:"reason,omitempty"`
+	// Human-readable message indicating details about last transition.
+	Message *string `json:"message,omitempty"`
+	// Provides a timestamp for when the VSphereDistributedNetwork object last transitioned from one status to another.
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// VSphereDistributedNetworkConditionApplyConfiguration constructs a declarative configuration of the VSphereDistributedNetworkCondition type for use with
+// apply.
+func VSphereDistributedNetworkCondition() *VSphereDistributedNetworkConditionApplyConfiguration {
+	return &VSphereDistributedNetworkConditionApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *VSphereDistributedNetworkConditionApplyConfiguration) WithType(value apiv1alpha1.VSphereDistributedNetworkConditionType) *VSphereDistributedNetworkConditionApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *VSphereDistributedNetworkConditionApplyConfiguration) WithStatus(value v1.ConditionStatus) *VSphereDistributedNetworkConditionApplyConfiguration {
+	b.Status = &value
+	return b
+}
+
+// WithReason sets the Reason field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Reason field is set to the value of the last call.
+func (b *VSphereDistributedNetworkConditionApplyConfiguration) WithReason(value string) *VSphereDistributedNetworkConditionApplyConfiguration {
+	b.Reason = &value
+	return b
+}
+
+// WithMessage sets the Message field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Message field is set to the value of the last call.
+func (b *VSphereDistributedNetworkConditionApplyConfiguration) WithMessage(value string) *VSphereDistributedNetworkConditionApplyConfiguration {
+	b.Message = &value
+	return b
+}
+
+// WithLastTransitionTime sets the LastTransitionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastTransitionTime field is set to the value of the last call.
+func (b *VSphereDistributedNetworkConditionApplyConfiguration) WithLastTransitionTime(value metav1.Time) *VSphereDistributedNetworkConditionApplyConfiguration {
+	b.LastTransitionTime = &value
+	return b
+}
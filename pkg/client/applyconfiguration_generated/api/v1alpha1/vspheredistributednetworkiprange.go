@@ -0,0 +1,43 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// VSphereDistributedNetworkIPRangeApplyConfiguration represents a declarative configuration of the VSphereDistributedNetworkIPRange type for use
+// with apply.
+//
+// VSphereDistributedNetworkIPRange is the static IP range for a VSphereDistributedNetwork.
+type VSphereDistributedNetworkIPRangeApplyConfiguration struct {
+	// address is the starting IPv4 or IPv6 address of the range.
+	// The items of the atomic addressRanges list cannot be compared to the stored
+	// object, so an address that the earlier patterns accepted, such as one with
+	// leading zeros, stays valid.
+	Address *string `json:"address,omitempty"`
+	// count is the number of addresses in the range when using static range assignment.
+	Count *int64 `json:"count,omitempty"`
+}
+
+// VSphereDistributedNetworkIPRangeApplyConfiguration constructs a declarative configuration of the VSphereDistributedNetworkIPRange type for use with
+// apply.
+func VSphereDistributedNetworkIPRange() *VSphereDistributedNetworkIPRangeApplyConfiguration {
+	return &VSphereDistributedNetworkIPRangeApplyConfiguration{}
+}
+
+// WithAddress sets the Address field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Address field is set to the value of the last call.
+func (b *VSphereDistributedNetworkIPRangeApplyConfiguration) WithAddress(value string) *VSphereDistributedNetworkIPRangeApplyConfiguration {
+	b.Address = &value
+	return b
+}
+
+// WithCount sets the Count field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Count field is set to the value of the last call.
+func (b *VSphereDistributedNetworkIPRangeApplyConfiguration) WithCount(value int64) *VSphereDistributedNetworkIPRangeApplyConfiguration {
+	b.Count = &value
+	return b
+}
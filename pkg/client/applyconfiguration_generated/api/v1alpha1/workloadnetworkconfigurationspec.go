@@ -0,0 +1,54 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+)
+
+// WorkloadNetworkConfigurationSpecApplyConfiguration represents a declarative configuration of the WorkloadNetworkConfigurationSpec type for use
+// with apply.
+//
+// WorkloadNetworkConfigurationSpec defines the desired state of the WorkloadNetworkConfiguration.
+type WorkloadNetworkConfigurationSpecApplyConfiguration struct {
+	// providers declares the set of network providers and their system-level configurations.
+	// Each entry must have a unique type. At least one provider must be declared.
+	//
+	Providers []NetworkProviderEntryApplyConfiguration `json:"providers,omitempty"`
+	// activeSystemProvider identifies which provider in the providers list is currently
+	// authoritative for deriving the system NamespaceNetworkConfiguration. Changing this
+	// field triggers a transition of the system NNC to the newly active provider.
+	//
+	ActiveSystemProvider *apiv1alpha1.NetworkProvider `json:"activeSystemProvider,omitempty"`
+}
+
+// WorkloadNetworkConfigurationSpecApplyConfiguration constructs a declarative configuration of the WorkloadNetworkConfigurationSpec type for use with
+// apply.
+func WorkloadNetworkConfigurationSpec() *WorkloadNetworkConfigurationSpecApplyConfiguration {
+	return &WorkloadNetworkConfigurationSpecApplyConfiguration{}
+}
+
+// WithProviders adds the given value to the Providers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Providers field.
+func (b *WorkloadNetworkConfigurationSpecApplyConfiguration) WithProviders(values ...*NetworkProviderEntryApplyConfiguration) *WorkloadNetworkConfigurationSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithProviders")
+		}
+		b.Providers = append(b.Providers, *values[i])
+	}
+	return b
+}
+
+// WithActiveSystemProvider sets the ActiveSystemProvider field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveSystemProvider field is set to the value of the last call.
+func (b *WorkloadNetworkConfigurationSpecApplyConfiguration) WithActiveSystemProvider(value apiv1alpha1.NetworkProvider) *WorkloadNetworkConfigurationSpecApplyConfiguration {
+	b.ActiveSystemProvider = &value
+	return b
+}
@@ -0,0 +1,34 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// ActivePassiveAvailabilityModeApplyConfiguration represents a declarative configuration of the ActivePassiveAvailabilityMode type for use
+// with apply.
+//
+// ActivePassiveAvailabilityMode deploys two nodes in Active-Passive mode where one node is set into
+// active state and is responsible for serving traffic, and one node is passive -
+// awaiting a fail-over event. When a fail-over occurs, connections to and from the load balancer
+// may be reset.
+type ActivePassiveAvailabilityModeApplyConfiguration struct {
+	// Replicas describes the total number of deployed nodes. Defaults to 2.
+	//
+	Replicas *uint32 `json:"replicas,omitempty"`
+}
+
+// ActivePassiveAvailabilityModeApplyConfiguration constructs a declarative configuration of the ActivePassiveAvailabilityMode type for use with
+// apply.
+func ActivePassiveAvailabilityMode() *ActivePassiveAvailabilityModeApplyConfiguration {
+	return &ActivePassiveAvailabilityModeApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *ActivePassiveAvailabilityModeApplyConfiguration) WithReplicas(value uint32) *ActivePassiveAvailabilityModeApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
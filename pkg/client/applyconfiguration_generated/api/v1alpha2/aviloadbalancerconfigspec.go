@@ -0,0 +1,115 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// AviLoadBalancerConfigSpecApplyConfiguration represents a declarative configuration of the AviLoadBalancerConfigSpec type for use
+// with apply.
+//
+// AviLoadBalancerConfigSpec defines the configuration for an Avi load balancer.
+// This specification is used to configure the resources the Avi Kubernetes
+// Operator (AKO) requires in order to connect to the Avi load balancer.
+type AviLoadBalancerConfigSpecApplyConfiguration struct {
+	// Server is the endpoint at which the Avi Controller REST API is available.
+	// The format is [SCHEME://]ADDRESS[:PORT], ex. https://10.10.10.10
+	// * SCHEME may be http or https and defaults to https if the SCHEME is
+	// omitted
+	// * ADDRESS is the Avi Controller IP address or the Avi Cluster IP when
+	// two or more Avi Controllers are deployed in cluster mode.
+	// * PORT defaults to 80 when SCHEME is http and 443 when SCHEME is https.
+	Server *string `json:"server,omitempty"`
+	// CloudName is used by the Avi Kubernetes Operator (AKO) when querying
+	// properties via the Avi REST API, ex. /api/cloud/?name=CLOUD_NAME.
+	// Defaults to Default-Cloud.
+	CloudName *string `json:"cloudName,omitempty"`
+	// AdvancedL4 is a flag that enables support for WCP in AKO.
+	// Defaults to true.
+	AdvancedL4 *bool `json:"advancedL4,omitempty"`
+	// LogLevel specifies the log level used by AKO.
+	LogLevel *apiv1alpha2.AviLoadBalancerLogLevel `json:"logLevel,omitempty"`
+	// IPAMType is the type of IPAM used by the Avi Software Load Balancer.
+	IPAMType *apiv1alpha2.AviLoadBalancerIPAMType `json:"ipamType,omitempty"`
+	// CredentialSecretRef points to a Secret resource used to access and
+	// configure the Avi Controller.
+	//
+	// * certificateAuthorityData   PEM-encoded certificate authority
+	// certificates
+	// * username                   Username used with basic authentication for
+	// the Avi REST API
+	// * password                   Password used with basic authentication for
+	// the Avi REST API
+	//
+	// The following YAML is an example secret:
+	//
+	// apiVersion: v1
+	// kind: Secret
+	// metadata:
+	// name: avi-lb-config
+	// namespace: vmware-system-netop
+	// data:
+	// certificateAuthorityData: []byte
+	// username: []byte
+	// password: []byte
+	CredentialSecretRef *ClientSecretReferenceApplyConfiguration `json:"credentialSecretRef,omitempty"`
+}
+
+// AviLoadBalancerConfigSpecApplyConfiguration constructs a declarative configuration of the AviLoadBalancerConfigSpec type for use with
+// apply.
+func AviLoadBalancerConfigSpec() *AviLoadBalancerConfigSpecApplyConfiguration {
+	return &AviLoadBalancerConfigSpecApplyConfiguration{}
+}
+
+// WithServer sets the Server field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Server field is set to the value of the last call.
+func (b *AviLoadBalancerConfigSpecApplyConfiguration) WithServer(value string) *AviLoadBalancerConfigSpecApplyConfiguration {
+	b.Server = &value
+	return b
+}
+
+// WithCloudName sets the CloudName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CloudName field is set to the value of the last call.
+func (b *AviLoadBalancerConfigSpecApplyConfiguration) WithCloudName(value string) *AviLoadBalancerConfigSpecApplyConfiguration {
+	b.CloudName = &value
+	return b
+}
+
+// WithAdvancedL4 sets the AdvancedL4 field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AdvancedL4 field is set to the value of the last call.
+func (b *AviLoadBalancerConfigSpecApplyConfiguration) WithAdvancedL4(value bool) *AviLoadBalancerConfigSpecApplyConfiguration {
+	b.AdvancedL4 = &value
+	return b
+}
+
+// WithLogLevel sets the LogLevel field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LogLevel field is set to the value of the last call.
+func (b *AviLoadBalancerConfigSpecApplyConfiguration) WithLogLevel(value apiv1alpha2.AviLoadBalancerLogLevel) *AviLoadBalancerConfigSpecApplyConfiguration {
+	b.LogLevel = &value
+	return b
+}
+
+// WithIPAMType sets the IPAMType field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPAMType field is set to the value of the last call.
+func (b *AviLoadBalancerConfigSpecApplyConfiguration) WithIPAMType(value apiv1alpha2.AviLoadBalancerIPAMType) *AviLoadBalancerConfigSpecApplyConfiguration {
+	b.IPAMType = &value
+	return b
+}
+
+// WithCredentialSecretRef sets the CredentialSecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CredentialSecretRef field is set to the value of the last call.
+func (b *AviLoadBalancerConfigSpecApplyConfiguration) WithCredentialSecretRef(value *ClientSecretReferenceApplyConfiguration) *AviLoadBalancerConfigSpecApplyConfiguration {
+	b.CredentialSecretRef = value
+	return b
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// FoundationLoadBalancerConfigSpecApplyConfiguration represents a declarative configuration of the FoundationLoadBalancerConfigSpec type for use
+// with apply.
+//
+// FoundationLoadBalancerConfigSpec defines the configuration for a vSphere Foundation Load Balancer.
+// This specification is used to configure the resources for the load balancer on vCenter Server.
+type FoundationLoadBalancerConfigSpecApplyConfiguration struct {
+	// DeploymentSpec describes sizing and placement constraints of the load balancer.
+	DeploymentSpec *FoundationLoadBalancerDeploymentSpecApplyConfiguration `json:"deploymentSpec,omitempty"`
+	// ManagementNetwork points to the Network used to program node management network interfaces.
+	//
+	// If unset, the VirtualIPNetwork will be used for management traffic.
+	//
+	ManagementNetwork *NetworkReferenceApplyConfiguration `json:"managementNetwork,omitempty"`
+	// WorkloadNetwork points to the Network used to program node workload network interfaces.
+	//
+	// If unset, workload data traffic will be routed out of the same NIF bound to VirtualIPNetwork.
+	//
+	WorkloadNetworks []NetworkReferenceApplyConfiguration `json:"workloadNetworks,omitempty"`
+	// VirtualIPNetwork points to the Network used to program node VIP network interfaces.
+	VirtualIPNetwork *NetworkReferenceApplyConfiguration `json:"virtualIPNetwork,omitempty"`
+	// NetworkSpec contains values for configuring networks on the load balancer.
+	// If unset, default settings will be applied.
+	//
+	NetworkSpec *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration `json:"networkSpec,omitempty"`
+}
+
+// FoundationLoadBalancerConfigSpecApplyConfiguration constructs a declarative configuration of the FoundationLoadBalancerConfigSpec type for use with
+// apply.
+func FoundationLoadBalancerConfigSpec() *FoundationLoadBalancerConfigSpecApplyConfiguration {
+	return &FoundationLoadBalancerConfigSpecApplyConfiguration{}
+}
+
+// WithDeploymentSpec sets the DeploymentSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeploymentSpec field is set to the value of the last call.
+func (b *FoundationLoadBalancerConfigSpecApplyConfiguration) WithDeploymentSpec(value *FoundationLoadBalancerDeploymentSpecApplyConfiguration) *FoundationLoadBalancerConfigSpecApplyConfiguration {
+	b.DeploymentSpec = value
+	return b
+}
+
+// WithManagementNetwork sets the ManagementNetwork field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ManagementNetwork field is set to the value of the last call.
+func (b *FoundationLoadBalancerConfigSpecApplyConfiguration) WithManagementNetwork(value *NetworkReferenceApplyConfiguration) *FoundationLoadBalancerConfigSpecApplyConfiguration {
+	b.ManagementNetwork = value
+	return b
+}
+
+// WithWorkloadNetworks adds the given value to the WorkloadNetworks field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WorkloadNetworks field.
+func (b *FoundationLoadBalancerConfigSpecApplyConfiguration) WithWorkloadNetworks(values ...*NetworkReferenceApplyConfiguration) *FoundationLoadBalancerConfigSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkloadNetworks")
+		}
+		b.WorkloadNetworks = append(b.WorkloadNetworks, *values[i])
+	}
+	return b
+}
+
+// WithVirtualIPNetwork sets the VirtualIPNetwork field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VirtualIPNetwork field is set to the value of the last call.
+func (b *FoundationLoadBalancerConfigSpecApplyConfiguration) WithVirtualIPNetwork(value *NetworkReferenceApplyConfiguration) *FoundationLoadBalancerConfigSpecApplyConfiguration {
+	b.VirtualIPNetwork = value
+	return b
+}
+
+// WithNetworkSpec sets the NetworkSpec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkSpec field is set to the value of the last call.
+func (b *FoundationLoadBalancerConfigSpecApplyConfiguration) WithNetworkSpec(value *FoundationLoadBalancerNetworkConfigSpecApplyConfiguration) *FoundationLoadBalancerConfigSpecApplyConfiguration {
+	b.NetworkSpec = value
+	return b
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// FoundationLoadBalancerConfigStatusApplyConfiguration represents a declarative configuration of the FoundationLoadBalancerConfigStatus type for use
+// with apply.
+//
+// FoundationLoadBalancerConfigStatus describes the observed state of the Foundation Load Balancer.
+type FoundationLoadBalancerConfigStatusApplyConfiguration struct {
+	// Version describes the current version of the Foundation Load Balancer.
+	//
+	Version *string `json:"version,omitempty"`
+	// Nodes list specific information about each deployed node.
+	//
+	Nodes []FoundationLoadBalancerNodeStatusApplyConfiguration `json:"nodes,omitempty"`
+	// VirtualServerIPPoolsUtilization describes the current states of virtual server IP addresses utilization.
+	//
+	VirtualServerIPPoolsUtilization *VirtualIPPoolsUtilizationApplyConfiguration `json:"virtualServerIPPoolsUtilization,omitempty"`
+	// TokenDigest represents a hash of the current token in JWT format used for authentication with
+	// the load balancer controller.
+	//
+	TokenDigest *string `json:"tokenDigest,omitempty"`
+	// Conditions describes states of the load balancer at specific points in time.
+	//
+	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// effectiveVirtualServerIPPools is the union of explicitly referenced pools
+	// (spec.networkSpec.virtualServerIPPools) and controller-managed pools derived
+	// from spec.networkSpec.virtualServerIPRanges, as of the last successful reconcile.
+	//
+	EffectiveVirtualServerIPPools []string `json:"effectiveVirtualServerIPPools,omitempty"`
+}
+
+// FoundationLoadBalancerConfigStatusApplyConfiguration constructs a declarative configuration of the FoundationLoadBalancerConfigStatus type for use with
+// apply.
+func FoundationLoadBalancerConfigStatus() *FoundationLoadBalancerConfigStatusApplyConfiguration {
+	return &FoundationLoadBalancerConfigStatusApplyConfiguration{}
+}
+
+// WithVersion sets the Version field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Version field is set to the value of the last call.
+func (b *FoundationLoadBalancerConfigStatusApplyConfiguration) WithVersion(value string) *FoundationLoadBalancerConfigStatusApplyConfiguration {
+	b.Version = &value
+	return b
+}
+
+// WithNodes adds the given value to the Nodes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Nodes field.
+func (b *FoundationLoadBalancerConfigStatusApplyConfiguration) WithNodes(values ...*FoundationLoadBalancerNodeStatusApplyConfiguration) *FoundationLoadBalancerConfigStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithNodes")
+		}
+		b.Nodes = append(b.Nodes, *values[i])
+	}
+	return b
+}
+
+// WithVirtualServerIPPoolsUtilization sets the VirtualServerIPPoolsUtilization field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VirtualServerIPPoolsUtilization field is set to the value of the last call.
+func (b *FoundationLoadBalancerConfigStatusApplyConfiguration) WithVirtualServerIPPoolsUtilization(value *VirtualIPPoolsUtilizationApplyConfiguration) *FoundationLoadBalancerConfigStatusApplyConfiguration {
+	b.VirtualServerIPPoolsUtilization = value
+	return b
+}
+
+// WithTokenDigest sets the TokenDigest field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenDigest field is set to the value of the last call.
+func (b *FoundationLoadBalancerConfigStatusApplyConfiguration) WithTokenDigest(value string) *FoundationLoadBalancerConfigStatusApplyConfiguration {
+	b.TokenDigest = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *FoundationLoadBalancerConfigStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *FoundationLoadBalancerConfigStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithEffectiveVirtualServerIPPools adds the given value to the EffectiveVirtualServerIPPools field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EffectiveVirtualServerIPPools field.
+func (b *FoundationLoadBalancerConfigStatusApplyConfiguration) WithEffectiveVirtualServerIPPools(values ...string) *FoundationLoadBalancerConfigStatusApplyConfiguration {
+	for i := range values {
+		b.EffectiveVirtualServerIPPools = append(b.EffectiveVirtualServerIPPools, values[i])
+	}
+	return b
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// FoundationLoadBalancerNodeStatusApplyConfiguration represents a declarative configuration of the FoundationLoadBalancerNodeStatus type for use
+// with apply.
+//
+// Status objects. Specs are realized into Statuses.
+// FoundationLoadBalancerNodeStatus describes the per-node status of the load balancer.
+type FoundationLoadBalancerNodeStatusApplyConfiguration struct {
+	// NodeID is a node's unique identifier.
+	NodeID *string `json:"nodeID,omitempty"`
+	// ManagementNetworkInterface defines the management NetworkInterface if it exists.
+	//
+	ManagementNetworkInterface *NetworkInterfaceReferenceApplyConfiguration `json:"managementNetworkInterface,omitempty"`
+	// WorkloadNetworkInterface defines the workload NetworkInterfaces if they exist.
+	//
+	WorkloadNetworkInterfaces []NetworkInterfaceReferenceApplyConfiguration `json:"workloadNetworkInterfaces,omitempty"`
+	// VIPNetworkInterface is the interface bound to the Virtual IP Network.
+	VIPNetworkInterface *NetworkInterfaceReferenceApplyConfiguration `json:"vipNetworkInterface,omitempty"`
+}
+
+// FoundationLoadBalancerNodeStatusApplyConfiguration constructs a declarative configuration of the FoundationLoadBalancerNodeStatus type for use with
+// apply.
+func FoundationLoadBalancerNodeStatus() *FoundationLoadBalancerNodeStatusApplyConfiguration {
+	return &FoundationLoadBalancerNodeStatusApplyConfiguration{}
+}
+
+// WithNodeID sets the NodeID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NodeID field is set to the value of the last call.
+func (b *FoundationLoadBalancerNodeStatusApplyConfiguration) WithNodeID(value string) *FoundationLoadBalancerNodeStatusApplyConfiguration {
+	b.NodeID = &value
+	return b
+}
+
+// WithManagementNetworkInterface sets the ManagementNetworkInterface field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ManagementNetworkInterface field is set to the value of the last call.
+func (b *FoundationLoadBalancerNodeStatusApplyConfiguration) WithManagementNetworkInterface(value *NetworkInterfaceReferenceApplyConfiguration) *FoundationLoadBalancerNodeStatusApplyConfiguration {
+	b.ManagementNetworkInterface = value
+	return b
+}
+
+// WithWorkloadNetworkInterfaces adds the given value to the WorkloadNetworkInterfaces field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the WorkloadNetworkInterfaces field.
+func (b *FoundationLoadBalancerNodeStatusApplyConfiguration) WithWorkloadNetworkInterfaces(values ...*NetworkInterfaceReferenceApplyConfiguration) *FoundationLoadBalancerNodeStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithWorkloadNetworkInterfaces")
+		}
+		b.WorkloadNetworkInterfaces = append(b.WorkloadNetworkInterfaces, *values[i])
+	}
+	return b
+}
+
+// WithVIPNetworkInterface sets the VIPNetworkInterface field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VIPNetworkInterface field is set to the value of the last call.
+func (b *FoundationLoadBalancerNodeStatusApplyConfiguration) WithVIPNetworkInterface(value *NetworkInterfaceReferenceApplyConfiguration) *FoundationLoadBalancerNodeStatusApplyConfiguration {
+	b.VIPNetworkInterface = value
+	return b
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// HAProxyLoadBalancerConfigSpecApplyConfiguration represents a declarative configuration of the HAProxyLoadBalancerConfigSpec type for use
+// with apply.
+//
+// HAProxyLoadBalancerConfigSpec defines the configuration for an HAProxyLoadBalancerConfig instance.
+// The spec is used to configure the HAProxyLoadBalancer instance to correctly route traffic to services.
+// This spec supports HAProxyLoadBalancerConfig Dataplane API 2.0+ sidecar
+type HAProxyLoadBalancerConfigSpecApplyConfiguration struct {
+	// EndPointURLs is a list of the addresses for the DataPlane API servers used
+	// to configure HAProxy.
+	// One or more DataPlane API endpoints are possible due to the following topologies:
+	// Single Node Topology
+	// Multi-Node Active/Passive Topology
+	// The strings should include the host, port, and API version, ex.:
+	// https://hostname:port/v1
+	EndPointURLs []string `json:"endPointURLs,omitempty"`
+	// ServerName is used to verify the hostname on the returned
+	// certificates. It is also included
+	// in the client's handshake to support virtual hosting unless it is
+	// an IP address.
+	// Defaults to the host part parsed from Server
+	ServerName *string `json:"serverName,omitempty"`
+	// CredentialSecretRef is an object name of kind Secret.
+	// It will be used to access and configure the HAProxy load balancer DataPlane API servers.
+	// The following fields are optional:
+	//
+	// * certificateAuthorityData - CertificateAuthorityData contains PEM-encoded certificate authority certificates.
+	//
+	// * clientCertificateData - ClientCertificateData contains PEM-encoded data from a client cert file.
+	//
+	// * clientKeyData - ClientKeyData contains PEM-encoded data from a client key file for TLS.
+	//
+	// * username - Username is the username for basic authentication. Defaults to "client".
+	//
+	// * password - Password is the password for basic authentication. Defaults to "cert".
+	//
+	// Sample of a secret:
+	//
+	// apiVersion: v1
+	// kind: Secret
+	// metadata:
+	// name: haproxy-lb-config
+	// namespace: vmware-system-netop
+	// data:
+	// certificateAuthorityData: <base64_Encoded>
+	// clientCertificateData: <base64_Encoded>
+	// clientKeyData: <base64_Encoded>
+	// username: <base64_Encoded>
+	// password: <base64_Encoded>
+	CredentialSecretRef *ClientSecretReferenceApplyConfiguration `json:"credentialSecretRef,omitempty"`
+}
+
+// HAProxyLoadBalancerConfigSpecApplyConfiguration constructs a declarative configuration of the HAProxyLoadBalancerConfigSpec type for use with
+// apply.
+func HAProxyLoadBalancerConfigSpec() *HAProxyLoadBalancerConfigSpecApplyConfiguration {
+	return &HAProxyLoadBalancerConfigSpecApplyConfiguration{}
+}
+
+// WithEndPointURLs adds the given value to the EndPointURLs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the EndPointURLs field.
+func (b *HAProxyLoadBalancerConfigSpecApplyConfiguration) WithEndPointURLs(values ...string) *HAProxyLoadBalancerConfigSpecApplyConfiguration {
+	for i := range values {
+		b.EndPointURLs = append(b.EndPointURLs, values[i])
+	}
+	return b
+}
+
+// WithServerName sets the ServerName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ServerName field is set to the value of the last call.
+func (b *HAProxyLoadBalancerConfigSpecApplyConfiguration) WithServerName(value string) *HAProxyLoadBalancerConfigSpecApplyConfiguration {
+	b.ServerName = &value
+	return b
+}
+
+// WithCredentialSecretRef sets the CredentialSecretRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CredentialSecretRef field is set to the value of the last call.
+func (b *HAProxyLoadBalancerConfigSpecApplyConfiguration) WithCredentialSecretRef(value *ClientSecretReferenceApplyConfiguration) *HAProxyLoadBalancerConfigSpecApplyConfiguration {
+	b.CredentialSecretRef = value
+	return b
+}
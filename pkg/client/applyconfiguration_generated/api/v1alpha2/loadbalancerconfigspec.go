@@ -0,0 +1,44 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// LoadBalancerConfigSpecApplyConfiguration represents a declarative configuration of the LoadBalancerConfigSpec type for use
+// with apply.
+//
+// LoadBalancerConfigSpec defines the desired state of LoadBalancerConfig
+type LoadBalancerConfigSpecApplyConfiguration struct {
+	// Type describes type of load balancer.
+	Type *apiv1alpha2.LoadBalancerConfigType `json:"type,omitempty"`
+	// ProviderRef is reference to a load balancer provider object that provides the details for this type of load balancer
+	ProviderRef *LoadBalancerConfigProviderReferenceApplyConfiguration `json:"providerRef,omitempty"`
+}
+
+// LoadBalancerConfigSpecApplyConfiguration constructs a declarative configuration of the LoadBalancerConfigSpec type for use with
+// apply.
+func LoadBalancerConfigSpec() *LoadBalancerConfigSpecApplyConfiguration {
+	return &LoadBalancerConfigSpecApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *LoadBalancerConfigSpecApplyConfiguration) WithType(value apiv1alpha2.LoadBalancerConfigType) *LoadBalancerConfigSpecApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithProviderRef sets the ProviderRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProviderRef field is set to the value of the last call.
+func (b *LoadBalancerConfigSpecApplyConfiguration) WithProviderRef(value *LoadBalancerConfigProviderReferenceApplyConfiguration) *LoadBalancerConfigSpecApplyConfiguration {
+	b.ProviderRef = value
+	return b
+}
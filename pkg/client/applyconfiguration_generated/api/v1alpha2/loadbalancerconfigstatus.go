@@ -0,0 +1,39 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// LoadBalancerConfigStatusApplyConfiguration represents a declarative configuration of the LoadBalancerConfigStatus type for use
+// with apply.
+//
+// LoadBalancerConfigStatus defines the observed state of LoadBalancerConfig
+type LoadBalancerConfigStatusApplyConfiguration struct {
+	// Conditions is an array of current observed load balancer conditions
+	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// LoadBalancerConfigStatusApplyConfiguration constructs a declarative configuration of the LoadBalancerConfigStatus type for use with
+// apply.
+func LoadBalancerConfigStatus() *LoadBalancerConfigStatusApplyConfiguration {
+	return &LoadBalancerConfigStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *LoadBalancerConfigStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *LoadBalancerConfigStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// MacLearningPolicyApplyConfiguration represents a declarative configuration of the MacLearningPolicy type for use
+// with apply.
+//
+// MacLearningPolicy represents the MAC learning policy configuration.
+type MacLearningPolicyApplyConfiguration struct {
+	// Enabled indicates whether MAC learning is enabled.
+	Enabled *bool `json:"enabled,omitempty"`
+	// AllowUnicastFlooding indicates whether to allow flooding of unlearned MAC for ingress traffic.
+	AllowUnicastFlooding *bool `json:"allowUnicastFlooding,omitempty"`
+	// Limit represents the maximum number of MAC addresses that can be learned.
+	Limit *int32 `json:"limit,omitempty"`
+	// LimitPolicy represents the policy to be used when the limit is exceeded.
+	LimitPolicy *apiv1alpha2.MacLimitPolicyType `json:"limitPolicy,omitempty"`
+}
+
+// MacLearningPolicyApplyConfiguration constructs a declarative configuration of the MacLearningPolicy type for use with
+// apply.
+func MacLearningPolicy() *MacLearningPolicyApplyConfiguration {
+	return &MacLearningPolicyApplyConfiguration{}
+}
+
+// WithEnabled sets the Enabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Enabled field is set to the value of the last call.
+func (b *MacLearningPolicyApplyConfiguration) WithEnabled(value bool) *MacLearningPolicyApplyConfiguration {
+	b.Enabled = &value
+	return b
+}
+
+// WithAllowUnicastFlooding sets the AllowUnicastFlooding field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AllowUnicastFlooding field is set to the value of the last call.
+func (b *MacLearningPolicyApplyConfiguration) WithAllowUnicastFlooding(value bool) *MacLearningPolicyApplyConfiguration {
+	b.AllowUnicastFlooding = &value
+	return b
+}
+
+// WithLimit sets the Limit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Limit field is set to the value of the last call.
+func (b *MacLearningPolicyApplyConfiguration) WithLimit(value int32) *MacLearningPolicyApplyConfiguration {
+	b.Limit = &value
+	return b
+}
+
+// WithLimitPolicy sets the LimitPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LimitPolicy field is set to the value of the last call.
+func (b *MacLearningPolicyApplyConfiguration) WithLimitPolicy(value apiv1alpha2.MacLimitPolicyType) *MacLearningPolicyApplyConfiguration {
+	b.LimitPolicy = &value
+	return b
+}
@@ -0,0 +1,63 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// NamespaceNetworkConfigApplyConfiguration represents a declarative configuration of the NamespaceNetworkConfig type for use
+// with apply.
+//
+// NamespaceNetworkConfig holds the provider-specific fields that are shared
+// between NamespaceNetworkSpec and WorkloadNetworkConfiguration's per-provider
+// system templates. Adding new provider config fields here makes them
+// automatically available in both APIs.
+type NamespaceNetworkConfigApplyConfiguration struct {
+	// vsphereDistributedConfig contains the vSphere Distributed (VDS) network
+	// configuration. Required when type is vsphere-distributed.
+	//
+	VSphereDistributedConfig *VSphereDistributedConfigApplyConfiguration `json:"vsphereDistributedConfig,omitempty"`
+	// vpcConfig contains the VPC network configuration.
+	// Required when type is vpc.
+	//
+	VPCConfig *VPCConfigApplyConfiguration `json:"vpcConfig,omitempty"`
+	// nsxTier1Config contains the NSX Tier-1 override configuration.
+	// Applicable when type is nsx-tier1. When absent, the namespace inherits
+	// the cluster-level NSX Container Plugin (NCP) configuration in inherit mode. When present,
+	// the fields here override those cluster-level defaults for this namespace in override mode, and
+	// a dedicated physical NSX Tier-1 Gateway is provisioned for the namespace.
+	// The presence of this field is immutable once the resource is created (it cannot be added if absent at creation, and cannot be removed if present at creation).
+	//
+	NSXTier1Config *NSXTier1ConfigApplyConfiguration `json:"nsxTier1Config,omitempty"`
+}
+
+// NamespaceNetworkConfigApplyConfiguration constructs a declarative configuration of the NamespaceNetworkConfig type for use with
+// apply.
+func NamespaceNetworkConfig() *NamespaceNetworkConfigApplyConfiguration {
+	return &NamespaceNetworkConfigApplyConfiguration{}
+}
+
+// WithVSphereDistributedConfig sets the VSphereDistributedConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VSphereDistributedConfig field is set to the value of the last call.
+func (b *NamespaceNetworkConfigApplyConfiguration) WithVSphereDistributedConfig(value *VSphereDistributedConfigApplyConfiguration) *NamespaceNetworkConfigApplyConfiguration {
+	b.VSphereDistributedConfig = value
+	return b
+}
+
+// WithVPCConfig sets the VPCConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VPCConfig field is set to the value of the last call.
+func (b *NamespaceNetworkConfigApplyConfiguration) WithVPCConfig(value *VPCConfigApplyConfiguration) *NamespaceNetworkConfigApplyConfiguration {
+	b.VPCConfig = value
+	return b
+}
+
+// WithNSXTier1Config sets the NSXTier1Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NSXTier1Config field is set to the value of the last call.
+func (b *NamespaceNetworkConfigApplyConfiguration) WithNSXTier1Config(value *NSXTier1ConfigApplyConfiguration) *NamespaceNetworkConfigApplyConfiguration {
+	b.NSXTier1Config = value
+	return b
+}
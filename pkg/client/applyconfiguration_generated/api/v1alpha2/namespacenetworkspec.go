@@ -0,0 +1,70 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// NamespaceNetworkSpecApplyConfiguration represents a declarative configuration of the NamespaceNetworkSpec type for use
+// with apply.
+//
+// NamespaceNetworkSpec defines the desired network configuration
+// for Namespaces associated with this NamespaceNetworkConfiguration.
+//
+// The type field selects the active network provider. For the vsphere-distributed
+// provider, vsphereDistributedConfig must be populated. For the vpc provider,
+// vpcConfig must be populated. For the nsx-tier1 provider, nsxTier1Config may
+// optionally be populated to override cluster-level NCP defaults; when absent
+// the namespace inherits those defaults. Only the config section corresponding
+// to the selected type may be populated; setting other config sections is invalid
+// and will be rejected by the API server.
+type NamespaceNetworkSpecApplyConfiguration struct {
+	// type selects the network provider for this configuration and determines
+	// which provider-specific config section must be populated.
+	//
+	Type                                     *apiv1alpha2.NetworkProvider `json:"type,omitempty"`
+	NamespaceNetworkConfigApplyConfiguration `json:",inline"`
+}
+
+// NamespaceNetworkSpecApplyConfiguration constructs a declarative configuration of the NamespaceNetworkSpec type for use with
+// apply.
+func NamespaceNetworkSpec() *NamespaceNetworkSpecApplyConfiguration {
+	return &NamespaceNetworkSpecApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *NamespaceNetworkSpecApplyConfiguration) WithType(value apiv1alpha2.NetworkProvider) *NamespaceNetworkSpecApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithVSphereDistributedConfig sets the VSphereDistributedConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VSphereDistributedConfig field is set to the value of the last call.
+func (b *NamespaceNetworkSpecApplyConfiguration) WithVSphereDistributedConfig(value *VSphereDistributedConfigApplyConfiguration) *NamespaceNetworkSpecApplyConfiguration {
+	b.NamespaceNetworkConfigApplyConfiguration.VSphereDistributedConfig = value
+	return b
+}
+
+// WithVPCConfig sets the VPCConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VPCConfig field is set to the value of the last call.
+func (b *NamespaceNetworkSpecApplyConfiguration) WithVPCConfig(value *VPCConfigApplyConfiguration) *NamespaceNetworkSpecApplyConfiguration {
+	b.NamespaceNetworkConfigApplyConfiguration.VPCConfig = value
+	return b
+}
+
+// WithNSXTier1Config sets the NSXTier1Config field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NSXTier1Config field is set to the value of the last call.
+func (b *NamespaceNetworkSpecApplyConfiguration) WithNSXTier1Config(value *NSXTier1ConfigApplyConfiguration) *NamespaceNetworkSpecApplyConfiguration {
+	b.NamespaceNetworkConfigApplyConfiguration.NSXTier1Config = value
+	return b
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// NetworkInterfaceReferenceApplyConfiguration represents a declarative configuration of the NetworkInterfaceReference type for use
+// with apply.
+//
+// NetworkInterfaceReference is an object that points to a NetworkInterface.
+type NetworkInterfaceReferenceApplyConfiguration struct {
+	// Kind is the type of resource being referenced.
+	Kind *string `json:"kind,omitempty"`
+	// Name is the name of resource being referenced.
+	Name *string `json:"name,omitempty"`
+	// APIVersion of the referent.
+	//
+	APIVersion *string `json:"apiVersion,omitempty"`
+}
+
+// NetworkInterfaceReferenceApplyConfiguration constructs a declarative configuration of the NetworkInterfaceReference type for use with
+// apply.
+func NetworkInterfaceReference() *NetworkInterfaceReferenceApplyConfiguration {
+	return &NetworkInterfaceReferenceApplyConfiguration{}
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *NetworkInterfaceReferenceApplyConfiguration) WithKind(value string) *NetworkInterfaceReferenceApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *NetworkInterfaceReferenceApplyConfiguration) WithName(value string) *NetworkInterfaceReferenceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *NetworkInterfaceReferenceApplyConfiguration) WithAPIVersion(value string) *NetworkInterfaceReferenceApplyConfiguration {
+	b.APIVersion = &value
+	return b
+}
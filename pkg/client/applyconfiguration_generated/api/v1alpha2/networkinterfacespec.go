@@ -0,0 +1,102 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// NetworkInterfaceSpecApplyConfiguration represents a declarative configuration of the NetworkInterfaceSpec type for use
+// with apply.
+//
+// NetworkInterfaceSpec defines the desired state of NetworkInterface.
+type NetworkInterfaceSpecApplyConfiguration struct {
+	// NetworkName refers to a NetworkObject in the same namespace.
+	NetworkName *string `json:"networkName,omitempty"`
+	// Type is the type of NetworkInterface. Supported values are vmxnet3.
+	Type *apiv1alpha2.NetworkInterfaceType `json:"type,omitempty"`
+	// ProviderRef is a reference to a provider specific network interface object
+	// that specifies the network interface configuration.
+	// If unset, default configuration is assumed.
+	ProviderRef *NetworkInterfaceProviderReferenceApplyConfiguration `json:"providerRef,omitempty"`
+	// PortAllocation is a request to allocate a port for this network interface on the backing network.
+	// This feature is currently supported only if backing network type is NetworkTypeVDS. In all other
+	// cases this field is ignored. Typically this is done implicitly by vCenter Server at the time
+	// of attaching a network interface to a network and should be left unset. This is used primarily when
+	// attachment of network interface to the network is done without vCenter Server's knowledge.
+	PortAllocation *NetworkInterfacePortAllocationApplyConfiguration `json:"portAllocation,omitempty"`
+	// ExternalID describes a value that will be surfaced as status.externalID.
+	// If this field is omitted, then it is up to the underlying network
+	// provider to surface any information in status.externalID.
+	ExternalID *string `json:"externalID,omitempty"`
+	// IPFamilyPolicy specifies the IP family policy for this network interface.
+	// Values: IPv4Only, IPv6Only, DualStack.
+	// When set to IPv4Only, only an IPv4 address will be allocated.
+	// When set to IPv6Only, only an IPv6 address will be allocated.
+	// When set to DualStack, both IPv4 and IPv6 addresses will be allocated.
+	// If not specified, the allocation is determined by the IP families available in the
+	// IPPools referenced by the backing Network: if both IPv4 and IPv6 pools are present,
+	// one address per IP family will be allocated (equivalent to DualStack); if only a
+	// single IP family is available, only one address of that family will be allocated.
+	// Users can discover the supported IP families by inspecting the SupportedIPFamilies
+	// field on the Network object.
+	IPFamilyPolicy *apiv1alpha2.NetworkInterfaceIPFamilyPolicy `json:"ipFamilyPolicy,omitempty"`
+}
+
+// NetworkInterfaceSpecApplyConfiguration constructs a declarative configuration of the NetworkInterfaceSpec type for use with
+// apply.
+func NetworkInterfaceSpec() *NetworkInterfaceSpecApplyConfiguration {
+	return &NetworkInterfaceSpecApplyConfiguration{}
+}
+
+// WithNetworkName sets the NetworkName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NetworkName field is set to the value of the last call.
+func (b *NetworkInterfaceSpecApplyConfiguration) WithNetworkName(value string) *NetworkInterfaceSpecApplyConfiguration {
+	b.NetworkName = &value
+	return b
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *NetworkInterfaceSpecApplyConfiguration) WithType(value apiv1alpha2.NetworkInterfaceType) *NetworkInterfaceSpecApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithProviderRef sets the ProviderRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProviderRef field is set to the value of the last call.
+func (b *NetworkInterfaceSpecApplyConfiguration) WithProviderRef(value *NetworkInterfaceProviderReferenceApplyConfiguration) *NetworkInterfaceSpecApplyConfiguration {
+	b.ProviderRef = value
+	return b
+}
+
+// WithPortAllocation sets the PortAllocation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PortAllocation field is set to the value of the last call.
+func (b *NetworkInterfaceSpecApplyConfiguration) WithPortAllocation(value *NetworkInterfacePortAllocationApplyConfiguration) *NetworkInterfaceSpecApplyConfiguration {
+	b.PortAllocation = value
+	return b
+}
+
+// WithExternalID sets the ExternalID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExternalID field is set to the value of the last call.
+func (b *NetworkInterfaceSpecApplyConfiguration) WithExternalID(value string) *NetworkInterfaceSpecApplyConfiguration {
+	b.ExternalID = &value
+	return b
+}
+
+// WithIPFamilyPolicy sets the IPFamilyPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPFamilyPolicy field is set to the value of the last call.
+func (b *NetworkInterfaceSpecApplyConfiguration) WithIPFamilyPolicy(value apiv1alpha2.NetworkInterfaceIPFamilyPolicy) *NetworkInterfaceSpecApplyConfiguration {
+	b.IPFamilyPolicy = &value
+	return b
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// NetworkProviderEntryApplyConfiguration represents a declarative configuration of the NetworkProviderEntry type for use
+// with apply.
+//
+// NetworkProviderEntry pairs a network provider type with its system-level
+// NamespaceNetworkConfiguration template. Exactly one entry per type is allowed
+// (enforced via listType=map on the providers field).
+type NetworkProviderEntryApplyConfiguration struct {
+	// type identifies the network provider for this entry.
+	//
+	Type *apiv1alpha2.NetworkProvider `json:"type,omitempty"`
+	// systemConfiguration holds the provider-specific NNC template for this provider.
+	//
+	SystemConfiguration *NamespaceNetworkConfigApplyConfiguration `json:"systemConfiguration,omitempty"`
+}
+
+// NetworkProviderEntryApplyConfiguration constructs a declarative configuration of the NetworkProviderEntry type for use with
+// apply.
+func NetworkProviderEntry() *NetworkProviderEntryApplyConfiguration {
+	return &NetworkProviderEntryApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *NetworkProviderEntryApplyConfiguration) WithType(value apiv1alpha2.NetworkProvider) *NetworkProviderEntryApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithSystemConfiguration sets the SystemConfiguration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SystemConfiguration field is set to the value of the last call.
+func (b *NetworkProviderEntryApplyConfiguration) WithSystemConfiguration(value *NamespaceNetworkConfigApplyConfiguration) *NetworkProviderEntryApplyConfiguration {
+	b.SystemConfiguration = value
+	return b
+}
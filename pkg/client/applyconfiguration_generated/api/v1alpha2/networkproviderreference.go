@@ -0,0 +1,70 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// NetworkProviderReferenceApplyConfiguration represents a declarative configuration of the NetworkProviderReference type for use
+// with apply.
+//
+// NetworkProviderReference contains info to locate a network provider object.
+type NetworkProviderReferenceApplyConfiguration struct {
+	// APIGroup is the group for the resource being referenced.
+	APIGroup *string `json:"apiGroup,omitempty"`
+	// Kind is the type of resource being referenced.
+	Kind *string `json:"kind,omitempty"`
+	// Name is the name of resource being referenced.
+	Name *string `json:"name,omitempty"`
+	// Namespace of the resource being referenced. If empty, cluster scoped resource is assumed.
+	Namespace *string `json:"namespace,omitempty"`
+	// API version of the referent.
+	APIVersion *string `json:"apiVersion,omitempty"`
+}
+
+// NetworkProviderReferenceApplyConfiguration constructs a declarative configuration of the NetworkProviderReference type for use with
+// apply.
+func NetworkProviderReference() *NetworkProviderReferenceApplyConfiguration {
+	return &NetworkProviderReferenceApplyConfiguration{}
+}
+
+// WithAPIGroup sets the APIGroup field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIGroup field is set to the value of the last call.
+func (b *NetworkProviderReferenceApplyConfiguration) WithAPIGroup(value string) *NetworkProviderReferenceApplyConfiguration {
+	b.APIGroup = &value
+	return b
+}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *NetworkProviderReferenceApplyConfiguration) WithKind(value string) *NetworkProviderReferenceApplyConfiguration {
+	b.Kind = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *NetworkProviderReferenceApplyConfiguration) WithName(value string) *NetworkProviderReferenceApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *NetworkProviderReferenceApplyConfiguration) WithNamespace(value string) *NetworkProviderReferenceApplyConfiguration {
+	b.Namespace = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *NetworkProviderReferenceApplyConfiguration) WithAPIVersion(value string) *NetworkProviderReferenceApplyConfiguration {
+	b.APIVersion = &value
+	return b
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// NetworkStatusApplyConfiguration represents a declarative configuration of the NetworkStatus type for use
+// with apply.
+//
+// NetworkStatus defines the observed state of Network.
+type NetworkStatusApplyConfiguration struct {
+	// Conditions is an array of current observed network conditions.
+	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// SupportedIPFamilies lists the IP families that are available on this network,
+	// as determined by the backing network provider (e.g. the IP families of the
+	// IPPools referenced by a VSphereDistributedNetwork). Users can inspect this field
+	// to understand which IPFamilyPolicy values are valid when creating a NetworkInterface
+	// on this network.
+	SupportedIPFamilies []corev1.IPFamily `json:"supportedIPFamilies,omitempty"`
+}
+
+// NetworkStatusApplyConfiguration constructs a declarative configuration of the NetworkStatus type for use with
+// apply.
+func NetworkStatus() *NetworkStatusApplyConfiguration {
+	return &NetworkStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *NetworkStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *NetworkStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithSupportedIPFamilies adds the given value to the SupportedIPFamilies field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SupportedIPFamilies field.
+func (b *NetworkStatusApplyConfiguration) WithSupportedIPFamilies(values ...corev1.IPFamily) *NetworkStatusApplyConfiguration {
+	for i := range values {
+		b.SupportedIPFamilies = append(b.SupportedIPFamilies, values[i])
+	}
+	return b
+}
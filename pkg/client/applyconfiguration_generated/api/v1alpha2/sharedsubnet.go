@@ -0,0 +1,89 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// SharedSubnetApplyConfiguration represents a declarative configuration of the SharedSubnet type for use
+// with apply.
+//
+// SharedSubnet defines a pre-created Subnet to be associated with a Namespace.
+type SharedSubnetApplyConfiguration struct {
+	// path is the NSX policy path of the shared Subnet to be associated with
+	// this Namespace. This field is immutable once set.
+	//
+	Path *string `json:"path,omitempty"`
+	// name is the name of the Subnet CR for this shared Subnet.
+	// It uniquely identifies this entry in the sharedSubnets list and must be
+	// unique across all entries. This field is immutable.
+	//
+	Name *string `json:"name,omitempty"`
+	// podDefault indicates this Subnet is the default network for Pod workloads
+	// in this Namespace. At most one entry in sharedSubnets may be set to True.
+	// When unset, this Subnet is not a Pod default. When set to True, vpc must
+	// be set on the parent VPCConfig, as the Subnet must reside in the
+	// Namespace's VPC to support resources such as load balancer virtual
+	// services and static routes.
+	//
+	// If no shared Subnet has podDefault set to True and the Namespace VPC has
+	// sufficient PrivateTGW IP block space, Pods use Subnets generated from
+	// that block. Otherwise, Pods are not assigned a default network.
+	//
+	PodDefault *apiv1alpha2.SharedSubnetDefault `json:"podDefault,omitempty"`
+	// vmDefault indicates this Subnet is the default network for VM workloads
+	// in this Namespace. At most one entry in sharedSubnets may be set to True.
+	// When unset, this Subnet is not a VM default. When set to True, vpc must
+	// be set on the parent VPCConfig, as the Subnet must reside in the
+	// Namespace's VPC to support resources such as load balancer virtual
+	// services and static routes.
+	//
+	// If no shared Subnet has vmDefault set to True and the Namespace VPC has
+	// sufficient privateCIDR space, VMs use Subnets generated from those CIDRs.
+	// Otherwise, there is no VM default network.
+	//
+	VMDefault *apiv1alpha2.SharedSubnetDefault `json:"vmDefault,omitempty"`
+}
+
+// SharedSubnetApplyConfiguration constructs a declarative configuration of the SharedSubnet type for use with
+// apply.
+func SharedSubnet() *SharedSubnetApplyConfiguration {
+	return &SharedSubnetApplyConfiguration{}
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *SharedSubnetApplyConfiguration) WithPath(value string) *SharedSubnetApplyConfiguration {
+	b.Path = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *SharedSubnetApplyConfiguration) WithName(value string) *SharedSubnetApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithPodDefault sets the PodDefault field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PodDefault field is set to the value of the last call.
+func (b *SharedSubnetApplyConfiguration) WithPodDefault(value apiv1alpha2.SharedSubnetDefault) *SharedSubnetApplyConfiguration {
+	b.PodDefault = &value
+	return b
+}
+
+// WithVMDefault sets the VMDefault field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VMDefault field is set to the value of the last call.
+func (b *SharedSubnetApplyConfiguration) WithVMDefault(value apiv1alpha2.SharedSubnetDefault) *SharedSubnetApplyConfiguration {
+	b.VMDefault = &value
+	return b
+}
@@ -0,0 +1,33 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// SingleNodeAvailabilityModeApplyConfiguration represents a declarative configuration of the SingleNodeAvailabilityMode type for use
+// with apply.
+//
+// SingleNodeAvailabilityMode defines single node configuration. Single node configuration involves
+// trading availability in return for reduced resource consumption. Upon node failure, redeployment will
+// be attempted on a best-effort basis.
+type SingleNodeAvailabilityModeApplyConfiguration struct {
+	// Replicas describes the total number of deployed nodes. Defaults to 1.
+	//
+	Replicas *uint32 `json:"replicas,omitempty"`
+}
+
+// SingleNodeAvailabilityModeApplyConfiguration constructs a declarative configuration of the SingleNodeAvailabilityMode type for use with
+// apply.
+func SingleNodeAvailabilityMode() *SingleNodeAvailabilityModeApplyConfiguration {
+	return &SingleNodeAvailabilityModeApplyConfiguration{}
+}
+
+// WithReplicas sets the Replicas field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Replicas field is set to the value of the last call.
+func (b *SingleNodeAvailabilityModeApplyConfiguration) WithReplicas(value uint32) *SingleNodeAvailabilityModeApplyConfiguration {
+	b.Replicas = &value
+	return b
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// VlanSpecApplyConfiguration represents a declarative configuration of the VlanSpec type for use
+// with apply.
+//
+// VlanSpec represents the VLAN configuration.
+type VlanSpecApplyConfiguration struct {
+	// Type indicates the type of VLAN configuration (standard, trunk, or private).
+	Type *apiv1alpha2.VLANType `json:"type,omitempty"`
+	// VlanID specifies the VLAN ID when Type is VLANTypeStandard.
+	// This field is ignored for other VLAN types.
+	// Possible values:
+	// - A value of 0 indicates there is no VLAN configuration for the port.
+	// - A value from 1 to 4094 specifies a VLAN ID for the port.
+	VlanID *int32 `json:"vlanID,omitempty"`
+	// TrunkRange specifies the ranges of allowed VLANs when Type is VLANTypeTrunk.
+	// This field is ignored for other VLAN types.
+	// Each range's Start and End values must be between 0 and 4094 inclusive.
+	// Overlapping ranges are allowed.
+	TrunkRange []VLANTrunkRangeApplyConfiguration `json:"trunkRange,omitempty"`
+	// PrivateVlanID specifies the private VLAN ID when Type is VLANTypePrivate.
+	// This field is ignored for other VLAN types.
+	PrivateVlanID *int32 `json:"privateVlanID,omitempty"`
+}
+
+// VlanSpecApplyConfiguration constructs a declarative configuration of the VlanSpec type for use with
+// apply.
+func VlanSpec() *VlanSpecApplyConfiguration {
+	return &VlanSpecApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *VlanSpecApplyConfiguration) WithType(value apiv1alpha2.VLANType) *VlanSpecApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithVlanID sets the VlanID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VlanID field is set to the value of the last call.
+func (b *VlanSpecApplyConfiguration) WithVlanID(value int32) *VlanSpecApplyConfiguration {
+	b.VlanID = &value
+	return b
+}
+
+// WithTrunkRange adds the given value to the TrunkRange field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TrunkRange field.
+func (b *VlanSpecApplyConfiguration) WithTrunkRange(values ...*VLANTrunkRangeApplyConfiguration) *VlanSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithTrunkRange")
+		}
+		b.TrunkRange = append(b.TrunkRange, *values[i])
+	}
+	return b
+}
+
+// WithPrivateVlanID sets the PrivateVlanID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PrivateVlanID field is set to the value of the last call.
+func (b *VlanSpecApplyConfiguration) WithPrivateVlanID(value int32) *VlanSpecApplyConfiguration {
+	b.PrivateVlanID = &value
+	return b
+}
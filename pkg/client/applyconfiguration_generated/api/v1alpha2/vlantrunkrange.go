@@ -0,0 +1,40 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// VLANTrunkRangeApplyConfiguration represents a declarative configuration of the VLANTrunkRange type for use
+// with apply.
+//
+// VLANTrunkRange represents a range of VLAN IDs for trunk configuration
+type VLANTrunkRangeApplyConfiguration struct {
+	// Start represents the beginning of the VLAN ID range (inclusive).
+	Start *int32 `json:"start,omitempty"`
+	// End represents the end of the VLAN ID range (inclusive).
+	End *int32 `json:"end,omitempty"`
+}
+
+// VLANTrunkRangeApplyConfiguration constructs a declarative configuration of the VLANTrunkRange type for use with
+// apply.
+func VLANTrunkRange() *VLANTrunkRangeApplyConfiguration {
+	return &VLANTrunkRangeApplyConfiguration{}
+}
+
+// WithStart sets the Start field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Start field is set to the value of the last call.
+func (b *VLANTrunkRangeApplyConfiguration) WithStart(value int32) *VLANTrunkRangeApplyConfiguration {
+	b.Start = &value
+	return b
+}
+
+// WithEnd sets the End field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the End field is set to the value of the last call.
+func (b *VLANTrunkRangeApplyConfiguration) WithEnd(value int32) *VLANTrunkRangeApplyConfiguration {
+	b.End = &value
+	return b
+}
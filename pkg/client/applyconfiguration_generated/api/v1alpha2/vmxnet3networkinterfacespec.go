@@ -0,0 +1,42 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// VMXNET3NetworkInterfaceSpecApplyConfiguration represents a declarative configuration of the VMXNET3NetworkInterfaceSpec type for use
+// with apply.
+//
+// VMXNET3NetworkInterfaceSpec defines the desired state of VMXNET3NetworkInterface.
+type VMXNET3NetworkInterfaceSpecApplyConfiguration struct {
+	// UPTCompatibilityEnabled indicates whether UPT(Universal Pass-through) compatibility is enabled
+	// on this network interface.
+	UPTCompatibilityEnabled *bool `json:"uptCompatibilityEnabled,omitempty"`
+	// WakeOnLanEnabled indicates whether wake-on-LAN is enabled on this network interface. Clients
+	// can set this property to selectively enable or disable wake-on-LAN.
+	WakeOnLanEnabled *bool `json:"wakeOnLanEnabled,omitempty"`
+}
+
+// VMXNET3NetworkInterfaceSpecApplyConfiguration constructs a declarative configuration of the VMXNET3NetworkInterfaceSpec type for use with
+// apply.
+func VMXNET3NetworkInterfaceSpec() *VMXNET3NetworkInterfaceSpecApplyConfiguration {
+	return &VMXNET3NetworkInterfaceSpecApplyConfiguration{}
+}
+
+// WithUPTCompatibilityEnabled sets the UPTCompatibilityEnabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UPTCompatibilityEnabled field is set to the value of the last call.
+func (b *VMXNET3NetworkInterfaceSpecApplyConfiguration) WithUPTCompatibilityEnabled(value bool) *VMXNET3NetworkInterfaceSpecApplyConfiguration {
+	b.UPTCompatibilityEnabled = &value
+	return b
+}
+
+// WithWakeOnLanEnabled sets the WakeOnLanEnabled field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the WakeOnLanEnabled field is set to the value of the last call.
+func (b *VMXNET3NetworkInterfaceSpecApplyConfiguration) WithWakeOnLanEnabled(value bool) *VMXNET3NetworkInterfaceSpecApplyConfiguration {
+	b.WakeOnLanEnabled = &value
+	return b
+}
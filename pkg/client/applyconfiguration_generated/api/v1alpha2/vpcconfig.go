@@ -0,0 +1,92 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// VPCConfigApplyConfiguration represents a declarative configuration of the VPCConfig type for use
+// with apply.
+//
+// VPCConfig specifies the VPC network configuration for a namespace.
+//
+// There are two mutually exclusive modes:
+//
+// 1. Pre-created VPC mode: Set vpc to reference an existing VPC. Only
+// defaultSubnetSize and sharedSubnets take effect alongside vpc.
+//
+// 2. Auto-create VPC mode: Set autoCreateConfig to have a VPC automatically
+// created and scoped to this namespace.
+type VPCConfigApplyConfiguration struct {
+	// vpc is the NSX policy path of an existing VPC the namespace is associated
+	// with. When set, the namespace uses this pre-created VPC and
+	// autoCreateConfig must not be set. This field is immutable once set.
+	//
+	VPC *string `json:"vpc,omitempty"`
+	// autoCreateConfig holds the configuration for automatically creating a
+	// namespace-scoped VPC. Mutually exclusive with vpc.
+	//
+	AutoCreateConfig *AutoCreateVPCConfigApplyConfiguration `json:"autoCreateConfig,omitempty"`
+	// sharedSubnets lists pre-created Subnets to be associated with this
+	// Namespace. At most one entry may have podDefault set to True, and at
+	// most one entry may have vmDefault set to True. These constraints are
+	// enforced by validation rules; the API server will reject any update
+	// that sets podDefault or vmDefault to True on more than one entry.
+	//
+	// A Subnet that is currently in use cannot be removed. If all shared Subnets
+	// acting as a Pod or VM default are removed, the default network falls back
+	// to Subnets generated from the Namespace VPC's available address space. If
+	// no such space exists, the affected workload type is not assigned a default
+	// network.
+	//
+	SharedSubnets []SharedSubnetApplyConfiguration `json:"sharedSubnets,omitempty"`
+	// defaultSubnetSize is the default size of Namespace Subnets, specified as
+	// the number of IP addresses. Must be a power of 2 (e.g. 16, 32, 64, 128).
+	// When not set, defaults to 32 (equivalent to a /27 subnet).
+	//
+	DefaultSubnetSize *int32 `json:"defaultSubnetSize,omitempty"`
+}
+
+// VPCConfigApplyConfiguration constructs a declarative configuration of the VPCConfig type for use with
+// apply.
+func VPCConfig() *VPCConfigApplyConfiguration {
+	return &VPCConfigApplyConfiguration{}
+}
+
+// WithVPC sets the VPC field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VPC field is set to the value of the last call.
+func (b *VPCConfigApplyConfiguration) WithVPC(value string) *VPCConfigApplyConfiguration {
+	b.VPC = &value
+	return b
+}
+
+// WithAutoCreateConfig sets the AutoCreateConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AutoCreateConfig field is set to the value of the last call.
+func (b *VPCConfigApplyConfiguration) WithAutoCreateConfig(value *AutoCreateVPCConfigApplyConfiguration) *VPCConfigApplyConfiguration {
+	b.AutoCreateConfig = value
+	return b
+}
+
+// WithSharedSubnets adds the given value to the SharedSubnets field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the SharedSubnets field.
+func (b *VPCConfigApplyConfiguration) WithSharedSubnets(values ...*SharedSubnetApplyConfiguration) *VPCConfigApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithSharedSubnets")
+		}
+		b.SharedSubnets = append(b.SharedSubnets, *values[i])
+	}
+	return b
+}
+
+// WithDefaultSubnetSize sets the DefaultSubnetSize field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultSubnetSize field is set to the value of the last call.
+func (b *VPCConfigApplyConfiguration) WithDefaultSubnetSize(value int32) *VPCConfigApplyConfiguration {
+	b.DefaultSubnetSize = &value
+	return b
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// VSphereDistributedNetworkRefApplyConfiguration represents a declarative configuration of the VSphereDistributedNetworkRef type for use
+// with apply.
+//
+// VSphereDistributedNetworkRef is a reference to a VSphereDistributedNetwork
+// resource by name.
+type VSphereDistributedNetworkRefApplyConfiguration struct {
+	// name is the name of the VSphereDistributedNetwork resource.
+	//
+	Name *string `json:"name,omitempty"`
+}
+
+// VSphereDistributedNetworkRefApplyConfiguration constructs a declarative configuration of the VSphereDistributedNetworkRef type for use with
+// apply.
+func VSphereDistributedNetworkRef() *VSphereDistributedNetworkRefApplyConfiguration {
+	return &VSphereDistributedNetworkRefApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *VSphereDistributedNetworkRefApplyConfiguration) WithName(value string) *VSphereDistributedNetworkRefApplyConfiguration {
+	b.Name = &value
+	return b
+}
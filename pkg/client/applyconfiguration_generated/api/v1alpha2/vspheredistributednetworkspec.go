@@ -0,0 +1,153 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+)
+
+// VSphereDistributedNetworkSpecApplyConfiguration represents a declarative configuration of the VSphereDistributedNetworkSpec type for use
+// with apply.
+//
+// VSphereDistributedNetworkSpec defines the desired state of VSphereDistributedNetwork.
+type VSphereDistributedNetworkSpecApplyConfiguration struct {
+	// PortGroupID is an existing vSphere Distributed PortGroup identifier.
+	PortGroupID *string `json:"portGroupID,omitempty"`
+	// ipAssignmentMode to use for IPv4 addresses on network interfaces.
+	// For IPAssignmentModeDHCP and IPAssignmentModeNone, the IPv4 IPPools, Gateway and SubnetMask
+	// fields should be empty/unset. When using IPAssignmentModeNone, no IPv4 IP will be assigned
+	// and no DHCP client will be configured.
+	// Note: For IPv6 address assignment, see IPv6AssignmentMode.
+	IPAssignmentMode *apiv1alpha2.IPAssignmentModeType `json:"ipAssignmentMode,omitempty"`
+	// IPv6AssignmentMode to use for IPv6 addresses on network interfaces. If unset, defaults to
+	// IPAssignmentModeNone (IPv6 disabled) for backward compatibility with existing IPv4-only
+	// deployments. To enable IPv6 support, explicitly set this field to IPAssignmentModeStaticPool
+	// or IPAssignmentModeDHCP. This allows different assignment modes for IPv4 and IPv6, for
+	// example static IPv4 pool assignment combined with DHCPv6 for IPv6.
+	// When unset, IPAssignmentModeDHCP or IPAssignmentModeNone, IPv6Gateway and IPv6Prefix must be unset.
+	// For IPAssignmentModeStaticPool, they are required.
+	IPv6AssignmentMode *apiv1alpha2.IPAssignmentModeType `json:"ipv6AssignmentMode,omitempty"`
+	// IPPools references list of IPPool objects. This field should only be set when using
+	// IPAssignmentModeStaticPool. For all other modes (IPAssignmentModeDHCP, IPAssignmentModeNone), this should be set
+	// to an empty list.
+	// When addressRanges is non-empty, the operator reconciles ipPools against those ranges:
+	// references that do not correspond to any address range are removed, new references are added
+	// where needed, and every retained reference (including ones that already matched a range) is reconciled.
+	IPPools []IPPoolReferenceApplyConfiguration `json:"ipPools,omitempty"`
+	// Gateway setting to use for network interfaces. This field should only be set when using
+	// IPAssignmentModeStaticPool. For all other modes (IPAssignmentModeDHCP, IPAssignmentModeNone), this should be set
+	// to an empty string.
+	// Note: The value must be a valid IPv4 address, but an empty string is allowed for backward compatibility.
+	Gateway *string `json:"gateway,omitempty"`
+	// SubnetMask setting to use for network interfaces. This field should only be set when using
+	// IPAssignmentModeStaticPool. For all other modes (IPAssignmentModeDHCP, IPAssignmentModeNone), this should be set
+	// to an empty string.
+	// Note: The value must be a valid IPv4 address, but an empty string is allowed for backward compatibility.
+	SubnetMask *string `json:"subnetMask,omitempty"`
+	// IPv6Gateway setting to use for IPv6 addresses on network interfaces. This field should only
+	// be set when using IPv6AssignmentMode IPAssignmentModeStaticPool. For all other modes
+	// (IPAssignmentModeDHCP, IPAssignmentModeNone), this should be empty/unset.
+	IPv6Gateway *string `json:"ipv6Gateway,omitempty"`
+	// IPv6Prefix is the prefix length for IPv6 addresses assigned to network interfaces (e.g. 64
+	// for a /64 network). This field should only be set when using IPv6AssignmentMode
+	// IPAssignmentModeStaticPool. For all other modes (IPAssignmentModeDHCP, IPAssignmentModeNone),
+	// this should be unset.
+	IPv6Prefix *int32 `json:"ipv6Prefix,omitempty"`
+	// addressRanges is a list of IP ranges for static IP assignment.
+	// When non-empty, the operator reconciles ipPools against this list: IPPool references that do
+	// not map to any range here are removed, new references are added for ranges that require them,
+	// and all references that remain are reconciled (including those that already mapped to a range).
+	AddressRanges []VSphereDistributedNetworkIPRangeApplyConfiguration `json:"addressRanges,omitempty"`
+}
+
+// VSphereDistributedNetworkSpecApplyConfiguration constructs a declarative configuration of the VSphereDistributedNetworkSpec type for use with
+// apply.
+func VSphereDistributedNetworkSpec() *VSphereDistributedNetworkSpecApplyConfiguration {
+	return &VSphereDistributedNetworkSpecApplyConfiguration{}
+}
+
+// WithPortGroupID sets the PortGroupID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PortGroupID field is set to the value of the last call.
+func (b *VSphereDistributedNetworkSpecApplyConfiguration) WithPortGroupID(value string) *VSphereDistributedNetworkSpecApplyConfiguration {
+	b.PortGroupID = &value
+	return b
+}
+
+// WithIPAssignmentMode sets the IPAssignmentMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPAssignmentMode field is set to the value of the last call.
+func (b *VSphereDistributedNetworkSpecApplyConfiguration) WithIPAssignmentMode(value apiv1alpha2.IPAssignmentModeType) *VSphereDistributedNetworkSpecApplyConfiguration {
+	b.IPAssignmentMode = &value
+	return b
+}
+
+// WithIPv6AssignmentMode sets the IPv6AssignmentMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPv6AssignmentMode field is set to the value of the last call.
+func (b *VSphereDistributedNetworkSpecApplyConfiguration) WithIPv6AssignmentMode(value apiv1alpha2.IPAssignmentModeType) *VSphereDistributedNetworkSpecApplyConfiguration {
+	b.IPv6AssignmentMode = &value
+	return b
+}
+
+// WithIPPools adds the given value to the IPPools field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the IPPools field.
+func (b *VSphereDistributedNetworkSpecApplyConfiguration) WithIPPools(values ...*IPPoolReferenceApplyConfiguration) *VSphereDistributedNetworkSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithIPPools")
+		}
+		b.IPPools = append(b.IPPools, *values[i])
+	}
+	return b
+}
+
+// WithGateway sets the Gateway field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Gateway field is set to the value of the last call.
+func (b *VSphereDistributedNetworkSpecApplyConfiguration) WithGateway(value string) *VSphereDistributedNetworkSpecApplyConfiguration {
+	b.Gateway = &value
+	return b
+}
+
+// WithSubnetMask sets the SubnetMask field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SubnetMask field is set to the value of the last call.
+func (b *VSphereDistributedNetworkSpecApplyConfiguration) WithSubnetMask(value string) *VSphereDistributedNetworkSpecApplyConfiguration {
+	b.SubnetMask = &value
+	return b
+}
+
+// WithIPv6Gateway sets the IPv6Gateway field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPv6Gateway field is set to the value of the last call.
+func (b *VSphereDistributedNetworkSpecApplyConfiguration) WithIPv6Gateway(value string) *VSphereDistributedNetworkSpecApplyConfiguration {
+	b.IPv6Gateway = &value
+	return b
+}
+
+// WithIPv6Prefix sets the IPv6Prefix field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPv6Prefix field is set to the value of the last call.
+func (b *VSphereDistributedNetworkSpecApplyConfiguration) WithIPv6Prefix(value int32) *VSphereDistributedNetworkSpecApplyConfiguration {
+	b.IPv6Prefix = &value
+	return b
+}
+
+// WithAddressRanges adds the given value to the AddressRanges field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AddressRanges field.
+func (b *VSphereDistributedNetworkSpecApplyConfiguration) WithAddressRanges(values ...*VSphereDistributedNetworkIPRangeApplyConfiguration) *VSphereDistributedNetworkSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAddressRanges")
+		}
+		b.AddressRanges = append(b.AddressRanges, *values[i])
+	}
+	return b
+}
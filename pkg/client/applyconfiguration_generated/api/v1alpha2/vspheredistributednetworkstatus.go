@@ -0,0 +1,51 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// VSphereDistributedNetworkStatusApplyConfiguration represents a declarative configuration of the VSphereDistributedNetworkStatus type for use
+// with apply.
+//
+// VSphereDistributedNetworkStatus defines the observed state of VSphereDistributedNetwork.
+type VSphereDistributedNetworkStatusApplyConfiguration struct {
+	// Conditions is an array of current observed vSphere Distributed network conditions.
+	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+	// DefaultPortConfig represents the default port-level configuration that applies to all ports
+	// unless overridden at the individual port level.
+	// If unset, indicates that no default port-level configuration has been retrieved yet for this network.
+	DefaultPortConfig *VSphereDistributedPortConfigApplyConfiguration `json:"defaultPortConfig,omitempty"`
+}
+
+// VSphereDistributedNetworkStatusApplyConfiguration constructs a declarative configuration of the VSphereDistributedNetworkStatus type for use with
+// apply.
+func VSphereDistributedNetworkStatus() *VSphereDistributedNetworkStatusApplyConfiguration {
+	return &VSphereDistributedNetworkStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *VSphereDistributedNetworkStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *VSphereDistributedNetworkStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
+
+// WithDefaultPortConfig sets the DefaultPortConfig field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DefaultPortConfig field is set to the value of the last call.
+func (b *VSphereDistributedNetworkStatusApplyConfiguration) WithDefaultPortConfig(value *VSphereDistributedPortConfigApplyConfiguration) *VSphereDistributedNetworkStatusApplyConfiguration {
+	b.DefaultPortConfig = value
+	return b
+}
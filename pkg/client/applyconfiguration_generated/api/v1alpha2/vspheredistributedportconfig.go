@@ -0,0 +1,40 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+// VSphereDistributedPortConfigApplyConfiguration represents a declarative configuration of the VSphereDistributedPortConfig type for use
+// with apply.
+//
+// VSphereDistributedPortConfig represents the port-level configuration for a vSphere Distributed Network's ports.
+type VSphereDistributedPortConfigApplyConfiguration struct {
+	// Vlan represents the VLAN configuration.
+	Vlan *VlanSpecApplyConfiguration `json:"vlan,omitempty"`
+	// MacManagementPolicy represents the MAC management policy configuration.
+	MacManagementPolicy *MacManagementPolicyApplyConfiguration `json:"macManagementPolicy,omitempty"`
+}
+
+// VSphereDistributedPortConfigApplyConfiguration constructs a declarative configuration of the VSphereDistributedPortConfig type for use with
+// apply.
+func VSphereDistributedPortConfig() *VSphereDistributedPortConfigApplyConfiguration {
+	return &VSphereDistributedPortConfigApplyConfiguration{}
+}
+
+// WithVlan sets the Vlan field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Vlan field is set to the value of the last call.
+func (b *VSphereDistributedPortConfigApplyConfiguration) WithVlan(value *VlanSpecApplyConfiguration) *VSphereDistributedPortConfigApplyConfiguration {
+	b.Vlan = value
+	return b
+}
+
+// WithMacManagementPolicy sets the MacManagementPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MacManagementPolicy field is set to the value of the last call.
+func (b *VSphereDistributedPortConfigApplyConfiguration) WithMacManagementPolicy(value *MacManagementPolicyApplyConfiguration) *VSphereDistributedPortConfigApplyConfiguration {
+	b.MacManagementPolicy = value
+	return b
+}
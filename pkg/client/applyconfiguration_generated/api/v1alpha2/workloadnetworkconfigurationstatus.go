@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// WorkloadNetworkConfigurationStatusApplyConfiguration represents a declarative configuration of the WorkloadNetworkConfigurationStatus type for use
+// with apply.
+//
+// WorkloadNetworkConfigurationStatus defines the observed state of the WorkloadNetworkConfiguration.
+type WorkloadNetworkConfigurationStatusApplyConfiguration struct {
+	// conditions represents the latest available observations of the
+	// WorkloadNetworkConfiguration's current state.
+	//
+	Conditions []v1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// WorkloadNetworkConfigurationStatusApplyConfiguration constructs a declarative configuration of the WorkloadNetworkConfigurationStatus type for use with
+// apply.
+func WorkloadNetworkConfigurationStatus() *WorkloadNetworkConfigurationStatusApplyConfiguration {
+	return &WorkloadNetworkConfigurationStatusApplyConfiguration{}
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *WorkloadNetworkConfigurationStatusApplyConfiguration) WithConditions(values ...*v1.ConditionApplyConfiguration) *WorkloadNetworkConfigurationStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
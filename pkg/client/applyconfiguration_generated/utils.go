@@ -0,0 +1,306 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package applyconfiguration_generated
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	internal "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/internal"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	managedfields "k8s.io/apimachinery/pkg/util/managedfields"
+)
+
+// ForKind returns an apply configuration type for the given GroupVersionKind, or nil if no
+// apply configuration type exists for the given GroupVersionKind.
+func ForKind(kind schema.GroupVersionKind) interface{} {
+	switch kind {
+	// Group=netoperator.vmware.com, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("ActivePassiveAvailabilityMode"):
+		return &apiv1alpha1.ActivePassiveAvailabilityModeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AutoCreateVPCConfig"):
+		return &apiv1alpha1.AutoCreateVPCConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AviLoadBalancerConfig"):
+		return &apiv1alpha1.AviLoadBalancerConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("AviLoadBalancerConfigSpec"):
+		return &apiv1alpha1.AviLoadBalancerConfigSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("ClientSecretReference"):
+		return &apiv1alpha1.ClientSecretReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FoundationLoadBalancerConfig"):
+		return &apiv1alpha1.FoundationLoadBalancerConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FoundationLoadBalancerConfigSpec"):
+		return &apiv1alpha1.FoundationLoadBalancerConfigSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FoundationLoadBalancerConfigStatus"):
+		return &apiv1alpha1.FoundationLoadBalancerConfigStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FoundationLoadBalancerDeploymentSpec"):
+		return &apiv1alpha1.FoundationLoadBalancerDeploymentSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FoundationLoadBalancerNetworkConfigSpec"):
+		return &apiv1alpha1.FoundationLoadBalancerNetworkConfigSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("FoundationLoadBalancerNodeStatus"):
+		return &apiv1alpha1.FoundationLoadBalancerNodeStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HAProxyLoadBalancerConfig"):
+		return &apiv1alpha1.HAProxyLoadBalancerConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("HAProxyLoadBalancerConfigSpec"):
+		return &apiv1alpha1.HAProxyLoadBalancerConfigSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPAddressAllocation"):
+		return &apiv1alpha1.IPAddressAllocationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPAddressAllocationCondition"):
+		return &apiv1alpha1.IPAddressAllocationConditionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPAddressAllocationSpec"):
+		return &apiv1alpha1.IPAddressAllocationSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPAddressAllocationStatus"):
+		return &apiv1alpha1.IPAddressAllocationStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPConfig"):
+		return &apiv1alpha1.IPConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPPool"):
+		return &apiv1alpha1.IPPoolApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPPoolCondition"):
+		return &apiv1alpha1.IPPoolConditionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPPoolReference"):
+		return &apiv1alpha1.IPPoolReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPPoolSpec"):
+		return &apiv1alpha1.IPPoolSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPPoolStatus"):
+		return &apiv1alpha1.IPPoolStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("IPRange"):
+		return &apiv1alpha1.IPRangeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LoadBalancerConfig"):
+		return &apiv1alpha1.LoadBalancerConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LoadBalancerConfigCondition"):
+		return &apiv1alpha1.LoadBalancerConfigConditionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LoadBalancerConfigProviderReference"):
+		return &apiv1alpha1.LoadBalancerConfigProviderReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LoadBalancerConfigSpec"):
+		return &apiv1alpha1.LoadBalancerConfigSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("LoadBalancerConfigStatus"):
+		return &apiv1alpha1.LoadBalancerConfigStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MacLearningPolicy"):
+		return &apiv1alpha1.MacLearningPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("MacManagementPolicy"):
+		return &apiv1alpha1.MacManagementPolicyApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NamespaceNetworkAssociation"):
+		return &apiv1alpha1.NamespaceNetworkAssociationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NamespaceNetworkConfig"):
+		return &apiv1alpha1.NamespaceNetworkConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NamespaceNetworkConfiguration"):
+		return &apiv1alpha1.NamespaceNetworkConfigurationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NamespaceNetworkSpec"):
+		return &apiv1alpha1.NamespaceNetworkSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NamespaceNetworkStatus"):
+		return &apiv1alpha1.NamespaceNetworkStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("Network"):
+		return &apiv1alpha1.NetworkApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkCondition"):
+		return &apiv1alpha1.NetworkConditionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterface"):
+		return &apiv1alpha1.NetworkInterfaceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterfaceCondition"):
+		return &apiv1alpha1.NetworkInterfaceConditionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterfacePortAllocation"):
+		return &apiv1alpha1.NetworkInterfacePortAllocationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterfaceProviderReference"):
+		return &apiv1alpha1.NetworkInterfaceProviderReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterfaceReference"):
+		return &apiv1alpha1.NetworkInterfaceReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterfaceSpec"):
+		return &apiv1alpha1.NetworkInterfaceSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkInterfaceStatus"):
+		return &apiv1alpha1.NetworkInterfaceStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkProviderEntry"):
+		return &apiv1alpha1.NetworkProviderEntryApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkProviderReference"):
+		return &apiv1alpha1.NetworkProviderReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkReference"):
+		return &apiv1alpha1.NetworkReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkSettings"):
+		return &apiv1alpha1.NetworkSettingsApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkSpec"):
+		return &apiv1alpha1.NetworkSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NetworkStatus"):
+		return &apiv1alpha1.NetworkStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("NSXTier1Config"):
+		return &apiv1alpha1.NSXTier1ConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SharedSubnet"):
+		return &apiv1alpha1.SharedSubnetApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("SingleNodeAvailabilityMode"):
+		return &apiv1alpha1.SingleNodeAvailabilityModeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VirtualIPPoolsUtilization"):
+		return &apiv1alpha1.VirtualIPPoolsUtilizationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VlanSpec"):
+		return &apiv1alpha1.VlanSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VLANTrunkRange"):
+		return &apiv1alpha1.VLANTrunkRangeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VMXNET3NetworkInterface"):
+		return &apiv1alpha1.VMXNET3NetworkInterfaceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VMXNET3NetworkInterfaceSpec"):
+		return &apiv1alpha1.VMXNET3NetworkInterfaceSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VPCConfig"):
+		return &apiv1alpha1.VPCConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VSphereDistributedConfig"):
+		return &apiv1alpha1.VSphereDistributedConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VSphereDistributedNetwork"):
+		return &apiv1alpha1.VSphereDistributedNetworkApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VSphereDistributedNetworkCondition"):
+		return &apiv1alpha1.VSphereDistributedNetworkConditionApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VSphereDistributedNetworkIPRange"):
+		return &apiv1alpha1.VSphereDistributedNetworkIPRangeApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VSphereDistributedNetworkRef"):
+		return &apiv1alpha1.VSphereDistributedNetworkRefApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VSphereDistributedNetworkSpec"):
+		return &apiv1alpha1.VSphereDistributedNetworkSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VSphereDistributedNetworkStatus"):
+		return &apiv1alpha1.VSphereDistributedNetworkStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VSphereDistributedPortConfig"):
+		return &apiv1alpha1.VSphereDistributedPortConfigApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("WorkloadNetworkConfiguration"):
+		return &apiv1alpha1.WorkloadNetworkConfigurationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("WorkloadNetworkConfigurationSpec"):
+		return &apiv1alpha1.WorkloadNetworkConfigurationSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("WorkloadNetworkConfigurationStatus"):
+		return &apiv1alpha1.WorkloadNetworkConfigurationStatusApplyConfiguration{}
+
+		// Group=netoperator.vmware.com, Version=v1alpha2
+	case v1alpha2.SchemeGroupVersion.WithKind("ActivePassiveAvailabilityMode"):
+		return &apiv1alpha2.ActivePassiveAvailabilityModeApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("AutoCreateVPCConfig"):
+		return &apiv1alpha2.AutoCreateVPCConfigApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("AviLoadBalancerConfig"):
+		return &apiv1alpha2.AviLoadBalancerConfigApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("AviLoadBalancerConfigSpec"):
+		return &apiv1alpha2.AviLoadBalancerConfigSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("ClientSecretReference"):
+		return &apiv1alpha2.ClientSecretReferenceApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("FoundationLoadBalancerConfig"):
+		return &apiv1alpha2.FoundationLoadBalancerConfigApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("FoundationLoadBalancerConfigSpec"):
+		return &apiv1alpha2.FoundationLoadBalancerConfigSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("FoundationLoadBalancerConfigStatus"):
+		return &apiv1alpha2.FoundationLoadBalancerConfigStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("FoundationLoadBalancerDeploymentSpec"):
+		return &apiv1alpha2.FoundationLoadBalancerDeploymentSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("FoundationLoadBalancerNetworkConfigSpec"):
+		return &apiv1alpha2.FoundationLoadBalancerNetworkConfigSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("FoundationLoadBalancerNodeStatus"):
+		return &apiv1alpha2.FoundationLoadBalancerNodeStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("HAProxyLoadBalancerConfig"):
+		return &apiv1alpha2.HAProxyLoadBalancerConfigApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("HAProxyLoadBalancerConfigSpec"):
+		return &apiv1alpha2.HAProxyLoadBalancerConfigSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("IPAddressAllocation"):
+		return &apiv1alpha2.IPAddressAllocationApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("IPAddressAllocationSpec"):
+		return &apiv1alpha2.IPAddressAllocationSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("IPAddressAllocationStatus"):
+		return &apiv1alpha2.IPAddressAllocationStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("IPConfig"):
+		return &apiv1alpha2.IPConfigApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("IPPool"):
+		return &apiv1alpha2.IPPoolApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("IPPoolReference"):
+		return &apiv1alpha2.IPPoolReferenceApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("IPPoolSpec"):
+		return &apiv1alpha2.IPPoolSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("IPPoolStatus"):
+		return &apiv1alpha2.IPPoolStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("IPRange"):
+		return &apiv1alpha2.IPRangeApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("LoadBalancerConfig"):
+		return &apiv1alpha2.LoadBalancerConfigApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("LoadBalancerConfigProviderReference"):
+		return &apiv1alpha2.LoadBalancerConfigProviderReferenceApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("LoadBalancerConfigSpec"):
+		return &apiv1alpha2.LoadBalancerConfigSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("LoadBalancerConfigStatus"):
+		return &apiv1alpha2.LoadBalancerConfigStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("MacLearningPolicy"):
+		return &apiv1alpha2.MacLearningPolicyApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("MacManagementPolicy"):
+		return &apiv1alpha2.MacManagementPolicyApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NamespaceNetworkAssociation"):
+		return &apiv1alpha2.NamespaceNetworkAssociationApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NamespaceNetworkConfig"):
+		return &apiv1alpha2.NamespaceNetworkConfigApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NamespaceNetworkConfiguration"):
+		return &apiv1alpha2.NamespaceNetworkConfigurationApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NamespaceNetworkSpec"):
+		return &apiv1alpha2.NamespaceNetworkSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NamespaceNetworkStatus"):
+		return &apiv1alpha2.NamespaceNetworkStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("Network"):
+		return &apiv1alpha2.NetworkApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NetworkInterface"):
+		return &apiv1alpha2.NetworkInterfaceApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NetworkInterfacePortAllocation"):
+		return &apiv1alpha2.NetworkInterfacePortAllocationApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NetworkInterfaceProviderReference"):
+		return &apiv1alpha2.NetworkInterfaceProviderReferenceApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NetworkInterfaceReference"):
+		return &apiv1alpha2.NetworkInterfaceReferenceApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NetworkInterfaceSpec"):
+		return &apiv1alpha2.NetworkInterfaceSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NetworkInterfaceStatus"):
+		return &apiv1alpha2.NetworkInterfaceStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NetworkProviderEntry"):
+		return &apiv1alpha2.NetworkProviderEntryApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NetworkProviderReference"):
+		return &apiv1alpha2.NetworkProviderReferenceApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NetworkReference"):
+		return &apiv1alpha2.NetworkReferenceApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NetworkSettings"):
+		return &apiv1alpha2.NetworkSettingsApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NetworkSpec"):
+		return &apiv1alpha2.NetworkSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NetworkStatus"):
+		return &apiv1alpha2.NetworkStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NSXTier1Config"):
+		return &apiv1alpha2.NSXTier1ConfigApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("SharedSubnet"):
+		return &apiv1alpha2.SharedSubnetApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("SingleNodeAvailabilityMode"):
+		return &apiv1alpha2.SingleNodeAvailabilityModeApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VirtualIPPoolsUtilization"):
+		return &apiv1alpha2.VirtualIPPoolsUtilizationApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VlanSpec"):
+		return &apiv1alpha2.VlanSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VLANTrunkRange"):
+		return &apiv1alpha2.VLANTrunkRangeApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VMXNET3NetworkInterface"):
+		return &apiv1alpha2.VMXNET3NetworkInterfaceApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VMXNET3NetworkInterfaceSpec"):
+		return &apiv1alpha2.VMXNET3NetworkInterfaceSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VPCConfig"):
+		return &apiv1alpha2.VPCConfigApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VSphereDistributedConfig"):
+		return &apiv1alpha2.VSphereDistributedConfigApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VSphereDistributedNetwork"):
+		return &apiv1alpha2.VSphereDistributedNetworkApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VSphereDistributedNetworkIPRange"):
+		return &apiv1alpha2.VSphereDistributedNetworkIPRangeApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VSphereDistributedNetworkRef"):
+		return &apiv1alpha2.VSphereDistributedNetworkRefApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VSphereDistributedNetworkSpec"):
+		return &apiv1alpha2.VSphereDistributedNetworkSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VSphereDistributedNetworkStatus"):
+		return &apiv1alpha2.VSphereDistributedNetworkStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("VSphereDistributedPortConfig"):
+		return &apiv1alpha2.VSphereDistributedPortConfigApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("WorkloadNetworkConfiguration"):
+		return &apiv1alpha2.WorkloadNetworkConfigurationApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("WorkloadNetworkConfigurationSpec"):
+		return &apiv1alpha2.WorkloadNetworkConfigurationSpecApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("WorkloadNetworkConfigurationStatus"):
+		return &apiv1alpha2.WorkloadNetworkConfigurationStatusApplyConfiguration{}
+
+	}
+	return nil
+}
+
+func NewTypeConverter(scheme *runtime.Scheme) managedfields.TypeConverter {
+	return managedfields.NewSchemeTypeConverter(scheme, internal.Parser())
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package client_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	applyconfiguration "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated"
+	netopv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
+	netopv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha2"
+	informersv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/informers_generated/api/v1alpha1"
+	informersv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/informers_generated/api/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type generatedVersion struct {
+	gv          schema.GroupVersion
+	addToScheme func(*runtime.Scheme) error
+	// pkgPath is the import path of the API types.
+	pkgPath string
+	// client and informers are the group interfaces of the clientset and the
+	// informer factory for the version.
+	client    reflect.Type
+	informers reflect.Type
+}
+
+var versions = []generatedVersion{
+	{
+		gv:          v1alpha1.SchemeGroupVersion,
+		addToScheme: v1alpha1.AddToScheme,
+		pkgPath:     reflect.TypeOf(v1alpha1.Network{}).PkgPath(),
+		client:      reflect.TypeOf((*netopv1alpha1.NetoperatorV1alpha1Interface)(nil)).Elem(),
+		informers:   reflect.TypeOf((*informersv1alpha1.Interface)(nil)).Elem(),
+	},
+	{
+		gv:          v1alpha2.SchemeGroupVersion,
+		addToScheme: v1alpha2.AddToScheme,
+		pkgPath:     reflect.TypeOf(v1alpha2.Network{}).PkgPath(),
+		client:      reflect.TypeOf((*netopv1alpha2.NetoperatorV1alpha2Interface)(nil)).Elem(),
+		informers:   reflect.TypeOf((*informersv1alpha2.Interface)(nil)).Elem(),
+	},
+}
+
+// TestGeneratedClientsCoverAllKinds checks that the clientset, the informers
+// and listers, and the apply configurations know about every kind of the API
+// types they were generated from, and that every kind with a status can have
+// it updated through its status subresource.
+func TestGeneratedClientsCoverAllKinds(t *testing.T) {
+	for _, v := range versions {
+		t.Run(v.gv.Version, func(t *testing.T) {
+			kinds := apiKinds(t, v)
+			if len(kinds) == 0 {
+				t.Fatal("expected the API to register at least one kind")
+			}
+
+			clients := typedClients(v)
+			listers := map[reflect.Type]bool{}
+			for informer := range methodResults(v.informers) {
+				lister, ok := informer.MethodByName("Lister")
+				if !ok {
+					continue
+				}
+				if typ, ok := resultType(lister.Type.Out(0), "List", v.pkgPath); ok {
+					listers[typ] = true
+				}
+			}
+
+			for kind, typ := range kinds {
+				client, ok := clients[typ]
+				if !ok {
+					t.Errorf("clientset has no typed client for %s", kind)
+				} else if _, hasStatus := typ.FieldByName("Status"); hasStatus {
+					if _, ok := client.MethodByName("UpdateStatus"); !ok {
+						t.Errorf("typed client for %s has no UpdateStatus", kind)
+					}
+				}
+				if !listers[typ] {
+					t.Errorf("informers have no lister for %s", kind)
+				}
+				if applyconfiguration.ForKind(v.gv.WithKind(kind)) == nil {
+					t.Errorf("no apply configuration for %s", kind)
+				}
+			}
+			for typ := range clients {
+				if _, ok := kinds[typ.Name()]; !ok {
+					t.Errorf("clientset has a typed client for %s, which is not a kind of %s", typ.Name(), v.gv)
+				}
+			}
+		})
+	}
+}
+
+// TestGeneratedClientsAreUpToDate regenerates the clients and compares them
+// with the checked-in ones. It needs the generators built by "make tools".
+func TestGeneratedClientsAreUpToDate(t *testing.T) {
+	root, err := filepath.Abs(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(root, "hack", "tools", "bin", "client-gen")); err != nil {
+		t.Skip("client generators are not built, run 'make tools' to enable this test")
+	}
+
+	cmd := exec.Command(filepath.Join(root, "hack", "verify-client.sh"))
+	cmd.Dir = root
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("generated clients are out of date: %v\n%s", err, out)
+	}
+}
+
+// apiKinds returns the Go types of the kinds of the version, keyed by kind.
+func apiKinds(t *testing.T, v generatedVersion) map[string]reflect.Type {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := v.addToScheme(scheme); err != nil {
+		t.Fatalf("add to scheme: %v", err)
+	}
+
+	objectType := reflect.TypeOf((*metav1.Object)(nil)).Elem()
+	kinds := map[string]reflect.Type{}
+	for kind, typ := range scheme.KnownTypes(v.gv) {
+		// Skip lists and the option types registered with every version.
+		if typ.PkgPath() != v.pkgPath || !reflect.PointerTo(typ).Implements(objectType) {
+			continue
+		}
+		kinds[kind] = typ
+	}
+	return kinds
+}
+
+// typedClients returns the typed clients of the clientset for the version,
+// keyed by the API type they serve.
+func typedClients(v generatedVersion) map[reflect.Type]reflect.Type {
+	clients := map[reflect.Type]reflect.Type{}
+	for client := range methodResults(v.client) {
+		if typ, ok := resultType(client, "Get", v.pkgPath); ok {
+			clients[typ] = client
+		}
+	}
+	return clients
+}
+
+// methodResults returns the result types of the methods of iface that return
+// a single interface.
+func methodResults(iface reflect.Type) map[reflect.Type]bool {
+	results := map[reflect.Type]bool{}
+	for i := 0; i < iface.NumMethod(); i++ {
+		m := iface.Method(i).Type
+		if m.NumOut() == 1 && m.Out(0).Kind() == reflect.Interface {
+			results[m.Out(0)] = true
+		}
+	}
+	return results
+}
+
+// resultType returns the API type of the objects returned by the method named
+// name of iface. The method returns either a pointer to or a slice of pointers
+// to the type.
+func resultType(iface reflect.Type, name, pkgPath string) (reflect.Type, bool) {
+	m, ok := iface.MethodByName(name)
+	if !ok || m.Type.NumOut() == 0 {
+		return nil, false
+	}
+	typ := m.Type.Out(0)
+	if typ.Kind() == reflect.Slice {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Pointer || typ.Elem().PkgPath() != pkgPath {
+		return nil, false
+	}
+	return typ.Elem(), true
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package clientset
+
+import (
+	fmt "fmt"
+	http "net/http"
+
+	netoperatorv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
+	netoperatorv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha2"
+	discovery "k8s.io/client-go/discovery"
+	rest "k8s.io/client-go/rest"
+	flowcontrol "k8s.io/client-go/util/flowcontrol"
+)
+
+type Interface interface {
+	Discovery() discovery.DiscoveryInterface
+	NetoperatorV1alpha1() netoperatorv1alpha1.NetoperatorV1alpha1Interface
+	NetoperatorV1alpha2() netoperatorv1alpha2.NetoperatorV1alpha2Interface
+}
+
+// Clientset contains the clients for groups.
+type Clientset struct {
+	*discovery.DiscoveryClient
+	netoperatorV1alpha1 *netoperatorv1alpha1.NetoperatorV1alpha1Client
+	netoperatorV1alpha2 *netoperatorv1alpha2.NetoperatorV1alpha2Client
+}
+
+// NetoperatorV1alpha1 retrieves the NetoperatorV1alpha1Client
+func (c *Clientset) NetoperatorV1alpha1() netoperatorv1alpha1.NetoperatorV1alpha1Interface {
+	return c.netoperatorV1alpha1
+}
+
+// NetoperatorV1alpha2 retrieves the NetoperatorV1alpha2Client
+func (c *Clientset) NetoperatorV1alpha2() netoperatorv1alpha2.NetoperatorV1alpha2Interface {
+	return c.netoperatorV1alpha2
+}
+
+// Discovery retrieves the DiscoveryClient
+func (c *Clientset) Discovery() discovery.DiscoveryInterface {
+	if c == nil {
+		return nil
+	}
+	return c.DiscoveryClient
+}
+
+// NewForConfig creates a new Clientset for the given config.
+// If config's RateLimiter is not set and QPS and Burst are acceptable,
+// NewForConfig will generate a rate-limiter in configShallowCopy.
+// NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
+// where httpClient was generated with rest.HTTPClientFor(c).
+func NewForConfig(c *rest.Config) (*Clientset, error) {
+	configShallowCopy := *c
+
+	if configShallowCopy.UserAgent == "" {
+		configShallowCopy.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+
+	// share the transport between all clients
+	httpClient, err := rest.HTTPClientFor(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewForConfigAndClient(&configShallowCopy, httpClient)
+}
+
+// NewForConfigAndClient creates a new Clientset for the given config and http client.
+// Note the http client provided takes precedence over the configured transport values.
+// If config's RateLimiter is not set and QPS and Burst are acceptable,
+// NewForConfigAndClient will generate a rate-limiter in configShallowCopy.
+func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset, error) {
+	configShallowCopy := *c
+	if configShallowCopy.RateLimiter == nil && configShallowCopy.QPS > 0 {
+		if configShallowCopy.Burst <= 0 {
+			return nil, fmt.Errorf("burst is required to be greater than 0 when RateLimiter is not set and QPS is set to greater than 0")
+		}
+		configShallowCopy.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(configShallowCopy.QPS, configShallowCopy.Burst)
+	}
+
+	var cs Clientset
+	var err error
+	cs.netoperatorV1alpha1, err = netoperatorv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	cs.netoperatorV1alpha2, err = netoperatorv1alpha2.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
+
+	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
+	return &cs, nil
+}
+
+// NewForConfigOrDie creates a new Clientset for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *Clientset {
+	cs, err := NewForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return cs
+}
+
+// New creates a new Clientset for the given RESTClient.
+func New(c rest.Interface) *Clientset {
+	var cs Clientset
+	cs.netoperatorV1alpha1 = netoperatorv1alpha1.New(c)
+	cs.netoperatorV1alpha2 = netoperatorv1alpha2.New(c)
+
+	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
+	return &cs
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	typedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeAviLoadBalancerConfigs implements AviLoadBalancerConfigInterface
+type fakeAviLoadBalancerConfigs struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.AviLoadBalancerConfig, *v1alpha1.AviLoadBalancerConfigList, *apiv1alpha1.AviLoadBalancerConfigApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha1
+}
+
+func newFakeAviLoadBalancerConfigs(fake *FakeNetoperatorV1alpha1) typedapiv1alpha1.AviLoadBalancerConfigInterface {
+	return &fakeAviLoadBalancerConfigs{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.AviLoadBalancerConfig, *v1alpha1.AviLoadBalancerConfigList, *apiv1alpha1.AviLoadBalancerConfigApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha1.SchemeGroupVersion.WithResource("aviloadbalancerconfigs"),
+			v1alpha1.SchemeGroupVersion.WithKind("AviLoadBalancerConfig"),
+			func() *v1alpha1.AviLoadBalancerConfig { return &v1alpha1.AviLoadBalancerConfig{} },
+			func() *v1alpha1.AviLoadBalancerConfigList { return &v1alpha1.AviLoadBalancerConfigList{} },
+			func(dst, src *v1alpha1.AviLoadBalancerConfigList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.AviLoadBalancerConfigList) []*v1alpha1.AviLoadBalancerConfig {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.AviLoadBalancerConfigList, items []*v1alpha1.AviLoadBalancerConfig) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
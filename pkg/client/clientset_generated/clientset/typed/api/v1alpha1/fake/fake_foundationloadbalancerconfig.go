@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	typedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeFoundationLoadBalancerConfigs implements FoundationLoadBalancerConfigInterface
+type fakeFoundationLoadBalancerConfigs struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.FoundationLoadBalancerConfig, *v1alpha1.FoundationLoadBalancerConfigList, *apiv1alpha1.FoundationLoadBalancerConfigApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha1
+}
+
+func newFakeFoundationLoadBalancerConfigs(fake *FakeNetoperatorV1alpha1, namespace string) typedapiv1alpha1.FoundationLoadBalancerConfigInterface {
+	return &fakeFoundationLoadBalancerConfigs{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.FoundationLoadBalancerConfig, *v1alpha1.FoundationLoadBalancerConfigList, *apiv1alpha1.FoundationLoadBalancerConfigApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("foundationloadbalancerconfigs"),
+			v1alpha1.SchemeGroupVersion.WithKind("FoundationLoadBalancerConfig"),
+			func() *v1alpha1.FoundationLoadBalancerConfig { return &v1alpha1.FoundationLoadBalancerConfig{} },
+			func() *v1alpha1.FoundationLoadBalancerConfigList { return &v1alpha1.FoundationLoadBalancerConfigList{} },
+			func(dst, src *v1alpha1.FoundationLoadBalancerConfigList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.FoundationLoadBalancerConfigList) []*v1alpha1.FoundationLoadBalancerConfig {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.FoundationLoadBalancerConfigList, items []*v1alpha1.FoundationLoadBalancerConfig) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	typedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeHAProxyLoadBalancerConfigs implements HAProxyLoadBalancerConfigInterface
+type fakeHAProxyLoadBalancerConfigs struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.HAProxyLoadBalancerConfig, *v1alpha1.HAProxyLoadBalancerConfigList, *apiv1alpha1.HAProxyLoadBalancerConfigApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha1
+}
+
+func newFakeHAProxyLoadBalancerConfigs(fake *FakeNetoperatorV1alpha1) typedapiv1alpha1.HAProxyLoadBalancerConfigInterface {
+	return &fakeHAProxyLoadBalancerConfigs{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.HAProxyLoadBalancerConfig, *v1alpha1.HAProxyLoadBalancerConfigList, *apiv1alpha1.HAProxyLoadBalancerConfigApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha1.SchemeGroupVersion.WithResource("haproxyloadbalancerconfigs"),
+			v1alpha1.SchemeGroupVersion.WithKind("HAProxyLoadBalancerConfig"),
+			func() *v1alpha1.HAProxyLoadBalancerConfig { return &v1alpha1.HAProxyLoadBalancerConfig{} },
+			func() *v1alpha1.HAProxyLoadBalancerConfigList { return &v1alpha1.HAProxyLoadBalancerConfigList{} },
+			func(dst, src *v1alpha1.HAProxyLoadBalancerConfigList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.HAProxyLoadBalancerConfigList) []*v1alpha1.HAProxyLoadBalancerConfig {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.HAProxyLoadBalancerConfigList, items []*v1alpha1.HAProxyLoadBalancerConfig) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
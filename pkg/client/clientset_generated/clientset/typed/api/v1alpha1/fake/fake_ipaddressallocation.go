@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	typedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeIPAddressAllocations implements IPAddressAllocationInterface
+type fakeIPAddressAllocations struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.IPAddressAllocation, *v1alpha1.IPAddressAllocationList, *apiv1alpha1.IPAddressAllocationApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha1
+}
+
+func newFakeIPAddressAllocations(fake *FakeNetoperatorV1alpha1, namespace string) typedapiv1alpha1.IPAddressAllocationInterface {
+	return &fakeIPAddressAllocations{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.IPAddressAllocation, *v1alpha1.IPAddressAllocationList, *apiv1alpha1.IPAddressAllocationApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("ipaddressallocations"),
+			v1alpha1.SchemeGroupVersion.WithKind("IPAddressAllocation"),
+			func() *v1alpha1.IPAddressAllocation { return &v1alpha1.IPAddressAllocation{} },
+			func() *v1alpha1.IPAddressAllocationList { return &v1alpha1.IPAddressAllocationList{} },
+			func(dst, src *v1alpha1.IPAddressAllocationList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.IPAddressAllocationList) []*v1alpha1.IPAddressAllocation {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.IPAddressAllocationList, items []*v1alpha1.IPAddressAllocation) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	typedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeIPPools implements IPPoolInterface
+type fakeIPPools struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.IPPool, *v1alpha1.IPPoolList, *apiv1alpha1.IPPoolApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha1
+}
+
+func newFakeIPPools(fake *FakeNetoperatorV1alpha1) typedapiv1alpha1.IPPoolInterface {
+	return &fakeIPPools{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.IPPool, *v1alpha1.IPPoolList, *apiv1alpha1.IPPoolApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha1.SchemeGroupVersion.WithResource("ippools"),
+			v1alpha1.SchemeGroupVersion.WithKind("IPPool"),
+			func() *v1alpha1.IPPool { return &v1alpha1.IPPool{} },
+			func() *v1alpha1.IPPoolList { return &v1alpha1.IPPoolList{} },
+			func(dst, src *v1alpha1.IPPoolList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.IPPoolList) []*v1alpha1.IPPool { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1alpha1.IPPoolList, items []*v1alpha1.IPPool) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	typedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeLoadBalancerConfigs implements LoadBalancerConfigInterface
+type fakeLoadBalancerConfigs struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.LoadBalancerConfig, *v1alpha1.LoadBalancerConfigList, *apiv1alpha1.LoadBalancerConfigApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha1
+}
+
+func newFakeLoadBalancerConfigs(fake *FakeNetoperatorV1alpha1) typedapiv1alpha1.LoadBalancerConfigInterface {
+	return &fakeLoadBalancerConfigs{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.LoadBalancerConfig, *v1alpha1.LoadBalancerConfigList, *apiv1alpha1.LoadBalancerConfigApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha1.SchemeGroupVersion.WithResource("loadbalancerconfigs"),
+			v1alpha1.SchemeGroupVersion.WithKind("LoadBalancerConfig"),
+			func() *v1alpha1.LoadBalancerConfig { return &v1alpha1.LoadBalancerConfig{} },
+			func() *v1alpha1.LoadBalancerConfigList { return &v1alpha1.LoadBalancerConfigList{} },
+			func(dst, src *v1alpha1.LoadBalancerConfigList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.LoadBalancerConfigList) []*v1alpha1.LoadBalancerConfig {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.LoadBalancerConfigList, items []*v1alpha1.LoadBalancerConfig) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	typedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeNamespaceNetworkConfigurations implements NamespaceNetworkConfigurationInterface
+type fakeNamespaceNetworkConfigurations struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.NamespaceNetworkConfiguration, *v1alpha1.NamespaceNetworkConfigurationList, *apiv1alpha1.NamespaceNetworkConfigurationApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha1
+}
+
+func newFakeNamespaceNetworkConfigurations(fake *FakeNetoperatorV1alpha1) typedapiv1alpha1.NamespaceNetworkConfigurationInterface {
+	return &fakeNamespaceNetworkConfigurations{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.NamespaceNetworkConfiguration, *v1alpha1.NamespaceNetworkConfigurationList, *apiv1alpha1.NamespaceNetworkConfigurationApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha1.SchemeGroupVersion.WithResource("namespacenetworkconfigurations"),
+			v1alpha1.SchemeGroupVersion.WithKind("NamespaceNetworkConfiguration"),
+			func() *v1alpha1.NamespaceNetworkConfiguration { return &v1alpha1.NamespaceNetworkConfiguration{} },
+			func() *v1alpha1.NamespaceNetworkConfigurationList {
+				return &v1alpha1.NamespaceNetworkConfigurationList{}
+			},
+			func(dst, src *v1alpha1.NamespaceNetworkConfigurationList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.NamespaceNetworkConfigurationList) []*v1alpha1.NamespaceNetworkConfiguration {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.NamespaceNetworkConfigurationList, items []*v1alpha1.NamespaceNetworkConfiguration) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	typedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeNetworks implements NetworkInterface
+type fakeNetworks struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.Network, *v1alpha1.NetworkList, *apiv1alpha1.NetworkApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha1
+}
+
+func newFakeNetworks(fake *FakeNetoperatorV1alpha1, namespace string) typedapiv1alpha1.NetworkInterface {
+	return &fakeNetworks{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.Network, *v1alpha1.NetworkList, *apiv1alpha1.NetworkApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("networks"),
+			v1alpha1.SchemeGroupVersion.WithKind("Network"),
+			func() *v1alpha1.Network { return &v1alpha1.Network{} },
+			func() *v1alpha1.NetworkList { return &v1alpha1.NetworkList{} },
+			func(dst, src *v1alpha1.NetworkList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.NetworkList) []*v1alpha1.Network { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1alpha1.NetworkList, items []*v1alpha1.Network) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	typedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeNetworkInterfaces implements NetworkInterfaceInterface
+type fakeNetworkInterfaces struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.NetworkInterface, *v1alpha1.NetworkInterfaceList, *apiv1alpha1.NetworkInterfaceApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha1
+}
+
+func newFakeNetworkInterfaces(fake *FakeNetoperatorV1alpha1, namespace string) typedapiv1alpha1.NetworkInterfaceInterface {
+	return &fakeNetworkInterfaces{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.NetworkInterface, *v1alpha1.NetworkInterfaceList, *apiv1alpha1.NetworkInterfaceApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("networkinterfaces"),
+			v1alpha1.SchemeGroupVersion.WithKind("NetworkInterface"),
+			func() *v1alpha1.NetworkInterface { return &v1alpha1.NetworkInterface{} },
+			func() *v1alpha1.NetworkInterfaceList { return &v1alpha1.NetworkInterfaceList{} },
+			func(dst, src *v1alpha1.NetworkInterfaceList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.NetworkInterfaceList) []*v1alpha1.NetworkInterface {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.NetworkInterfaceList, items []*v1alpha1.NetworkInterface) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
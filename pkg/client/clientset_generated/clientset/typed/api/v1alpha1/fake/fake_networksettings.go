@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	typedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeNetworkSettingses implements NetworkSettingsInterface
+type fakeNetworkSettingses struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.NetworkSettings, *v1alpha1.NetworkSettingsList, *apiv1alpha1.NetworkSettingsApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha1
+}
+
+func newFakeNetworkSettingses(fake *FakeNetoperatorV1alpha1, namespace string) typedapiv1alpha1.NetworkSettingsInterface {
+	return &fakeNetworkSettingses{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.NetworkSettings, *v1alpha1.NetworkSettingsList, *apiv1alpha1.NetworkSettingsApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("networksettingses"),
+			v1alpha1.SchemeGroupVersion.WithKind("NetworkSettings"),
+			func() *v1alpha1.NetworkSettings { return &v1alpha1.NetworkSettings{} },
+			func() *v1alpha1.NetworkSettingsList { return &v1alpha1.NetworkSettingsList{} },
+			func(dst, src *v1alpha1.NetworkSettingsList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.NetworkSettingsList) []*v1alpha1.NetworkSettings {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.NetworkSettingsList, items []*v1alpha1.NetworkSettings) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	typedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeVMXNET3NetworkInterfaces implements VMXNET3NetworkInterfaceInterface
+type fakeVMXNET3NetworkInterfaces struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.VMXNET3NetworkInterface, *v1alpha1.VMXNET3NetworkInterfaceList, *apiv1alpha1.VMXNET3NetworkInterfaceApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha1
+}
+
+func newFakeVMXNET3NetworkInterfaces(fake *FakeNetoperatorV1alpha1, namespace string) typedapiv1alpha1.VMXNET3NetworkInterfaceInterface {
+	return &fakeVMXNET3NetworkInterfaces{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.VMXNET3NetworkInterface, *v1alpha1.VMXNET3NetworkInterfaceList, *apiv1alpha1.VMXNET3NetworkInterfaceApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("vmxnet3networkinterfaces"),
+			v1alpha1.SchemeGroupVersion.WithKind("VMXNET3NetworkInterface"),
+			func() *v1alpha1.VMXNET3NetworkInterface { return &v1alpha1.VMXNET3NetworkInterface{} },
+			func() *v1alpha1.VMXNET3NetworkInterfaceList { return &v1alpha1.VMXNET3NetworkInterfaceList{} },
+			func(dst, src *v1alpha1.VMXNET3NetworkInterfaceList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.VMXNET3NetworkInterfaceList) []*v1alpha1.VMXNET3NetworkInterface {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.VMXNET3NetworkInterfaceList, items []*v1alpha1.VMXNET3NetworkInterface) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	typedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeVSphereDistributedNetworks implements VSphereDistributedNetworkInterface
+type fakeVSphereDistributedNetworks struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.VSphereDistributedNetwork, *v1alpha1.VSphereDistributedNetworkList, *apiv1alpha1.VSphereDistributedNetworkApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha1
+}
+
+func newFakeVSphereDistributedNetworks(fake *FakeNetoperatorV1alpha1) typedapiv1alpha1.VSphereDistributedNetworkInterface {
+	return &fakeVSphereDistributedNetworks{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.VSphereDistributedNetwork, *v1alpha1.VSphereDistributedNetworkList, *apiv1alpha1.VSphereDistributedNetworkApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha1.SchemeGroupVersion.WithResource("vspheredistributednetworks"),
+			v1alpha1.SchemeGroupVersion.WithKind("VSphereDistributedNetwork"),
+			func() *v1alpha1.VSphereDistributedNetwork { return &v1alpha1.VSphereDistributedNetwork{} },
+			func() *v1alpha1.VSphereDistributedNetworkList { return &v1alpha1.VSphereDistributedNetworkList{} },
+			func(dst, src *v1alpha1.VSphereDistributedNetworkList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.VSphereDistributedNetworkList) []*v1alpha1.VSphereDistributedNetwork {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.VSphereDistributedNetworkList, items []*v1alpha1.VSphereDistributedNetwork) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
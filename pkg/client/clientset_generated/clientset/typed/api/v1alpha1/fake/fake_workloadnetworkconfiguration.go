@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	typedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeWorkloadNetworkConfigurations implements WorkloadNetworkConfigurationInterface
+type fakeWorkloadNetworkConfigurations struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.WorkloadNetworkConfiguration, *v1alpha1.WorkloadNetworkConfigurationList, *apiv1alpha1.WorkloadNetworkConfigurationApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha1
+}
+
+func newFakeWorkloadNetworkConfigurations(fake *FakeNetoperatorV1alpha1) typedapiv1alpha1.WorkloadNetworkConfigurationInterface {
+	return &fakeWorkloadNetworkConfigurations{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.WorkloadNetworkConfiguration, *v1alpha1.WorkloadNetworkConfigurationList, *apiv1alpha1.WorkloadNetworkConfigurationApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha1.SchemeGroupVersion.WithResource("workloadnetworkconfigurations"),
+			v1alpha1.SchemeGroupVersion.WithKind("WorkloadNetworkConfiguration"),
+			func() *v1alpha1.WorkloadNetworkConfiguration { return &v1alpha1.WorkloadNetworkConfiguration{} },
+			func() *v1alpha1.WorkloadNetworkConfigurationList { return &v1alpha1.WorkloadNetworkConfigurationList{} },
+			func(dst, src *v1alpha1.WorkloadNetworkConfigurationList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.WorkloadNetworkConfigurationList) []*v1alpha1.WorkloadNetworkConfiguration {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.WorkloadNetworkConfigurationList, items []*v1alpha1.WorkloadNetworkConfiguration) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	applyconfigurationgeneratedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// FoundationLoadBalancerConfigsGetter has a method to return a FoundationLoadBalancerConfigInterface.
+// A group's client should implement this interface.
+type FoundationLoadBalancerConfigsGetter interface {
+	FoundationLoadBalancerConfigs(namespace string) FoundationLoadBalancerConfigInterface
+}
+
+// FoundationLoadBalancerConfigInterface has methods to work with FoundationLoadBalancerConfig resources.
+type FoundationLoadBalancerConfigInterface interface {
+	Create(ctx context.Context, foundationLoadBalancerConfig *apiv1alpha1.FoundationLoadBalancerConfig, opts v1.CreateOptions) (*apiv1alpha1.FoundationLoadBalancerConfig, error)
+	Update(ctx context.Context, foundationLoadBalancerConfig *apiv1alpha1.FoundationLoadBalancerConfig, opts v1.UpdateOptions) (*apiv1alpha1.FoundationLoadBalancerConfig, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, foundationLoadBalancerConfig *apiv1alpha1.FoundationLoadBalancerConfig, opts v1.UpdateOptions) (*apiv1alpha1.FoundationLoadBalancerConfig, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1alpha1.FoundationLoadBalancerConfig, error)
+	List(ctx context.Context, opts v1.ListOptions) (*apiv1alpha1.FoundationLoadBalancerConfigList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1alpha1.FoundationLoadBalancerConfig, err error)
+	Apply(ctx context.Context, foundationLoadBalancerConfig *applyconfigurationgeneratedapiv1alpha1.FoundationLoadBalancerConfigApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha1.FoundationLoadBalancerConfig, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, foundationLoadBalancerConfig *applyconfigurationgeneratedapiv1alpha1.FoundationLoadBalancerConfigApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha1.FoundationLoadBalancerConfig, err error)
+	FoundationLoadBalancerConfigExpansion
+}
+
+// foundationLoadBalancerConfigs implements FoundationLoadBalancerConfigInterface
+type foundationLoadBalancerConfigs struct {
+	*gentype.ClientWithListAndApply[*apiv1alpha1.FoundationLoadBalancerConfig, *apiv1alpha1.FoundationLoadBalancerConfigList, *applyconfigurationgeneratedapiv1alpha1.FoundationLoadBalancerConfigApplyConfiguration]
+}
+
+// newFoundationLoadBalancerConfigs returns a FoundationLoadBalancerConfigs
+func newFoundationLoadBalancerConfigs(c *NetoperatorV1alpha1Client, namespace string) *foundationLoadBalancerConfigs {
+	return &foundationLoadBalancerConfigs{
+		gentype.NewClientWithListAndApply[*apiv1alpha1.FoundationLoadBalancerConfig, *apiv1alpha1.FoundationLoadBalancerConfigList, *applyconfigurationgeneratedapiv1alpha1.FoundationLoadBalancerConfigApplyConfiguration](
+			"foundationloadbalancerconfigs",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *apiv1alpha1.FoundationLoadBalancerConfig { return &apiv1alpha1.FoundationLoadBalancerConfig{} },
+			func() *apiv1alpha1.FoundationLoadBalancerConfigList {
+				return &apiv1alpha1.FoundationLoadBalancerConfigList{}
+			},
+		),
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	applyconfigurationgeneratedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// HAProxyLoadBalancerConfigsGetter has a method to return a HAProxyLoadBalancerConfigInterface.
+// A group's client should implement this interface.
+type HAProxyLoadBalancerConfigsGetter interface {
+	HAProxyLoadBalancerConfigs() HAProxyLoadBalancerConfigInterface
+}
+
+// HAProxyLoadBalancerConfigInterface has methods to work with HAProxyLoadBalancerConfig resources.
+type HAProxyLoadBalancerConfigInterface interface {
+	Create(ctx context.Context, hAProxyLoadBalancerConfig *apiv1alpha1.HAProxyLoadBalancerConfig, opts v1.CreateOptions) (*apiv1alpha1.HAProxyLoadBalancerConfig, error)
+	Update(ctx context.Context, hAProxyLoadBalancerConfig *apiv1alpha1.HAProxyLoadBalancerConfig, opts v1.UpdateOptions) (*apiv1alpha1.HAProxyLoadBalancerConfig, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, hAProxyLoadBalancerConfig *apiv1alpha1.HAProxyLoadBalancerConfig, opts v1.UpdateOptions) (*apiv1alpha1.HAProxyLoadBalancerConfig, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1alpha1.HAProxyLoadBalancerConfig, error)
+	List(ctx context.Context, opts v1.ListOptions) (*apiv1alpha1.HAProxyLoadBalancerConfigList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1alpha1.HAProxyLoadBalancerConfig, err error)
+	Apply(ctx context.Context, hAProxyLoadBalancerConfig *applyconfigurationgeneratedapiv1alpha1.HAProxyLoadBalancerConfigApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha1.HAProxyLoadBalancerConfig, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, hAProxyLoadBalancerConfig *applyconfigurationgeneratedapiv1alpha1.HAProxyLoadBalancerConfigApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha1.HAProxyLoadBalancerConfig, err error)
+	HAProxyLoadBalancerConfigExpansion
+}
+
+// hAProxyLoadBalancerConfigs implements HAProxyLoadBalancerConfigInterface
+type hAProxyLoadBalancerConfigs struct {
+	*gentype.ClientWithListAndApply[*apiv1alpha1.HAProxyLoadBalancerConfig, *apiv1alpha1.HAProxyLoadBalancerConfigList, *applyconfigurationgeneratedapiv1alpha1.HAProxyLoadBalancerConfigApplyConfiguration]
+}
+
+// newHAProxyLoadBalancerConfigs returns a HAProxyLoadBalancerConfigs
+func newHAProxyLoadBalancerConfigs(c *NetoperatorV1alpha1Client) *hAProxyLoadBalancerConfigs {
+	return &hAProxyLoadBalancerConfigs{
+		gentype.NewClientWithListAndApply[*apiv1alpha1.HAProxyLoadBalancerConfig, *apiv1alpha1.HAProxyLoadBalancerConfigList, *applyconfigurationgeneratedapiv1alpha1.HAProxyLoadBalancerConfigApplyConfiguration](
+			"haproxyloadbalancerconfigs",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *apiv1alpha1.HAProxyLoadBalancerConfig { return &apiv1alpha1.HAProxyLoadBalancerConfig{} },
+			func() *apiv1alpha1.HAProxyLoadBalancerConfigList { return &apiv1alpha1.HAProxyLoadBalancerConfigList{} },
+		),
+	}
+}
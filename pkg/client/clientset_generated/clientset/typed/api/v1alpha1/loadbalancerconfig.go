@@ -0,0 +1,62 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	applyconfigurationgeneratedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// LoadBalancerConfigsGetter has a method to return a LoadBalancerConfigInterface.
+// A group's client should implement this interface.
+type LoadBalancerConfigsGetter interface {
+	LoadBalancerConfigs() LoadBalancerConfigInterface
+}
+
+// LoadBalancerConfigInterface has methods to work with LoadBalancerConfig resources.
+type LoadBalancerConfigInterface interface {
+	Create(ctx context.Context, loadBalancerConfig *apiv1alpha1.LoadBalancerConfig, opts v1.CreateOptions) (*apiv1alpha1.LoadBalancerConfig, error)
+	Update(ctx context.Context, loadBalancerConfig *apiv1alpha1.LoadBalancerConfig, opts v1.UpdateOptions) (*apiv1alpha1.LoadBalancerConfig, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, loadBalancerConfig *apiv1alpha1.LoadBalancerConfig, opts v1.UpdateOptions) (*apiv1alpha1.LoadBalancerConfig, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1alpha1.LoadBalancerConfig, error)
+	List(ctx context.Context, opts v1.ListOptions) (*apiv1alpha1.LoadBalancerConfigList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1alpha1.LoadBalancerConfig, err error)
+	Apply(ctx context.Context, loadBalancerConfig *applyconfigurationgeneratedapiv1alpha1.LoadBalancerConfigApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha1.LoadBalancerConfig, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, loadBalancerConfig *applyconfigurationgeneratedapiv1alpha1.LoadBalancerConfigApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha1.LoadBalancerConfig, err error)
+	LoadBalancerConfigExpansion
+}
+
+// loadBalancerConfigs implements LoadBalancerConfigInterface
+type loadBalancerConfigs struct {
+	*gentype.ClientWithListAndApply[*apiv1alpha1.LoadBalancerConfig, *apiv1alpha1.LoadBalancerConfigList, *applyconfigurationgeneratedapiv1alpha1.LoadBalancerConfigApplyConfiguration]
+}
+
+// newLoadBalancerConfigs returns a LoadBalancerConfigs
+func newLoadBalancerConfigs(c *NetoperatorV1alpha1Client) *loadBalancerConfigs {
+	return &loadBalancerConfigs{
+		gentype.NewClientWithListAndApply[*apiv1alpha1.LoadBalancerConfig, *apiv1alpha1.LoadBalancerConfigList, *applyconfigurationgeneratedapiv1alpha1.LoadBalancerConfigApplyConfiguration](
+			"loadbalancerconfigs",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *apiv1alpha1.LoadBalancerConfig { return &apiv1alpha1.LoadBalancerConfig{} },
+			func() *apiv1alpha1.LoadBalancerConfigList { return &apiv1alpha1.LoadBalancerConfigList{} },
+		),
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	applyconfigurationgeneratedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// NamespaceNetworkConfigurationsGetter has a method to return a NamespaceNetworkConfigurationInterface.
+// A group's client should implement this interface.
+type NamespaceNetworkConfigurationsGetter interface {
+	NamespaceNetworkConfigurations() NamespaceNetworkConfigurationInterface
+}
+
+// NamespaceNetworkConfigurationInterface has methods to work with NamespaceNetworkConfiguration resources.
+type NamespaceNetworkConfigurationInterface interface {
+	Create(ctx context.Context, namespaceNetworkConfiguration *apiv1alpha1.NamespaceNetworkConfiguration, opts v1.CreateOptions) (*apiv1alpha1.NamespaceNetworkConfiguration, error)
+	Update(ctx context.Context, namespaceNetworkConfiguration *apiv1alpha1.NamespaceNetworkConfiguration, opts v1.UpdateOptions) (*apiv1alpha1.NamespaceNetworkConfiguration, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, namespaceNetworkConfiguration *apiv1alpha1.NamespaceNetworkConfiguration, opts v1.UpdateOptions) (*apiv1alpha1.NamespaceNetworkConfiguration, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1alpha1.NamespaceNetworkConfiguration, error)
+	List(ctx context.Context, opts v1.ListOptions) (*apiv1alpha1.NamespaceNetworkConfigurationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1alpha1.NamespaceNetworkConfiguration, err error)
+	Apply(ctx context.Context, namespaceNetworkConfiguration *applyconfigurationgeneratedapiv1alpha1.NamespaceNetworkConfigurationApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha1.NamespaceNetworkConfiguration, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, namespaceNetworkConfiguration *applyconfigurationgeneratedapiv1alpha1.NamespaceNetworkConfigurationApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha1.NamespaceNetworkConfiguration, err error)
+	NamespaceNetworkConfigurationExpansion
+}
+
+// namespaceNetworkConfigurations implements NamespaceNetworkConfigurationInterface
+type namespaceNetworkConfigurations struct {
+	*gentype.ClientWithListAndApply[*apiv1alpha1.NamespaceNetworkConfiguration, *apiv1alpha1.NamespaceNetworkConfigurationList, *applyconfigurationgeneratedapiv1alpha1.NamespaceNetworkConfigurationApplyConfiguration]
+}
+
+// newNamespaceNetworkConfigurations returns a NamespaceNetworkConfigurations
+func newNamespaceNetworkConfigurations(c *NetoperatorV1alpha1Client) *namespaceNetworkConfigurations {
+	return &namespaceNetworkConfigurations{
+		gentype.NewClientWithListAndApply[*apiv1alpha1.NamespaceNetworkConfiguration, *apiv1alpha1.NamespaceNetworkConfigurationList, *applyconfigurationgeneratedapiv1alpha1.NamespaceNetworkConfigurationApplyConfiguration](
+			"namespacenetworkconfigurations",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *apiv1alpha1.NamespaceNetworkConfiguration { return &apiv1alpha1.NamespaceNetworkConfiguration{} },
+			func() *apiv1alpha1.NamespaceNetworkConfigurationList {
+				return &apiv1alpha1.NamespaceNetworkConfigurationList{}
+			},
+		),
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	applyconfigurationgeneratedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// NetworkSettingsesGetter has a method to return a NetworkSettingsInterface.
+// A group's client should implement this interface.
+type NetworkSettingsesGetter interface {
+	NetworkSettingses(namespace string) NetworkSettingsInterface
+}
+
+// NetworkSettingsInterface has methods to work with NetworkSettings resources.
+type NetworkSettingsInterface interface {
+	Create(ctx context.Context, networkSettings *apiv1alpha1.NetworkSettings, opts v1.CreateOptions) (*apiv1alpha1.NetworkSettings, error)
+	Update(ctx context.Context, networkSettings *apiv1alpha1.NetworkSettings, opts v1.UpdateOptions) (*apiv1alpha1.NetworkSettings, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1alpha1.NetworkSettings, error)
+	List(ctx context.Context, opts v1.ListOptions) (*apiv1alpha1.NetworkSettingsList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1alpha1.NetworkSettings, err error)
+	Apply(ctx context.Context, networkSettings *applyconfigurationgeneratedapiv1alpha1.NetworkSettingsApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha1.NetworkSettings, err error)
+	NetworkSettingsExpansion
+}
+
+// networkSettingses implements NetworkSettingsInterface
+type networkSettingses struct {
+	*gentype.ClientWithListAndApply[*apiv1alpha1.NetworkSettings, *apiv1alpha1.NetworkSettingsList, *applyconfigurationgeneratedapiv1alpha1.NetworkSettingsApplyConfiguration]
+}
+
+// newNetworkSettingses returns a NetworkSettingses
+func newNetworkSettingses(c *NetoperatorV1alpha1Client, namespace string) *networkSettingses {
+	return &networkSettingses{
+		gentype.NewClientWithListAndApply[*apiv1alpha1.NetworkSettings, *apiv1alpha1.NetworkSettingsList, *applyconfigurationgeneratedapiv1alpha1.NetworkSettingsApplyConfiguration](
+			"networksettingses",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *apiv1alpha1.NetworkSettings { return &apiv1alpha1.NetworkSettings{} },
+			func() *apiv1alpha1.NetworkSettingsList { return &apiv1alpha1.NetworkSettingsList{} },
+		),
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	applyconfigurationgeneratedapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha1"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// WorkloadNetworkConfigurationsGetter has a method to return a WorkloadNetworkConfigurationInterface.
+// A group's client should implement this interface.
+type WorkloadNetworkConfigurationsGetter interface {
+	WorkloadNetworkConfigurations() WorkloadNetworkConfigurationInterface
+}
+
+// WorkloadNetworkConfigurationInterface has methods to work with WorkloadNetworkConfiguration resources.
+type WorkloadNetworkConfigurationInterface interface {
+	Create(ctx context.Context, workloadNetworkConfiguration *apiv1alpha1.WorkloadNetworkConfiguration, opts v1.CreateOptions) (*apiv1alpha1.WorkloadNetworkConfiguration, error)
+	Update(ctx context.Context, workloadNetworkConfiguration *apiv1alpha1.WorkloadNetworkConfiguration, opts v1.UpdateOptions) (*apiv1alpha1.WorkloadNetworkConfiguration, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, workloadNetworkConfiguration *apiv1alpha1.WorkloadNetworkConfiguration, opts v1.UpdateOptions) (*apiv1alpha1.WorkloadNetworkConfiguration, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1alpha1.WorkloadNetworkConfiguration, error)
+	List(ctx context.Context, opts v1.ListOptions) (*apiv1alpha1.WorkloadNetworkConfigurationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1alpha1.WorkloadNetworkConfiguration, err error)
+	Apply(ctx context.Context, workloadNetworkConfiguration *applyconfigurationgeneratedapiv1alpha1.WorkloadNetworkConfigurationApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha1.WorkloadNetworkConfiguration, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, workloadNetworkConfiguration *applyconfigurationgeneratedapiv1alpha1.WorkloadNetworkConfigurationApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha1.WorkloadNetworkConfiguration, err error)
+	WorkloadNetworkConfigurationExpansion
+}
+
+// workloadNetworkConfigurations implements WorkloadNetworkConfigurationInterface
+type workloadNetworkConfigurations struct {
+	*gentype.ClientWithListAndApply[*apiv1alpha1.WorkloadNetworkConfiguration, *apiv1alpha1.WorkloadNetworkConfigurationList, *applyconfigurationgeneratedapiv1alpha1.WorkloadNetworkConfigurationApplyConfiguration]
+}
+
+// newWorkloadNetworkConfigurations returns a WorkloadNetworkConfigurations
+func newWorkloadNetworkConfigurations(c *NetoperatorV1alpha1Client) *workloadNetworkConfigurations {
+	return &workloadNetworkConfigurations{
+		gentype.NewClientWithListAndApply[*apiv1alpha1.WorkloadNetworkConfiguration, *apiv1alpha1.WorkloadNetworkConfigurationList, *applyconfigurationgeneratedapiv1alpha1.WorkloadNetworkConfigurationApplyConfiguration](
+			"workloadnetworkconfigurations",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *apiv1alpha1.WorkloadNetworkConfiguration { return &apiv1alpha1.WorkloadNetworkConfiguration{} },
+			func() *apiv1alpha1.WorkloadNetworkConfigurationList {
+				return &apiv1alpha1.WorkloadNetworkConfigurationList{}
+			},
+		),
+	}
+}
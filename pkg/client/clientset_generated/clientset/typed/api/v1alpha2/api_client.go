@@ -0,0 +1,149 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	http "net/http"
+
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	rest "k8s.io/client-go/rest"
+)
+
+type NetoperatorV1alpha2Interface interface {
+	RESTClient() rest.Interface
+	AviLoadBalancerConfigsGetter
+	FoundationLoadBalancerConfigsGetter
+	HAProxyLoadBalancerConfigsGetter
+	IPAddressAllocationsGetter
+	IPPoolsGetter
+	LoadBalancerConfigsGetter
+	NamespaceNetworkConfigurationsGetter
+	NetworksGetter
+	NetworkInterfacesGetter
+	NetworkSettingsesGetter
+	VMXNET3NetworkInterfacesGetter
+	VSphereDistributedNetworksGetter
+	WorkloadNetworkConfigurationsGetter
+}
+
+// NetoperatorV1alpha2Client is used to interact with features provided by the netoperator.vmware.com group.
+type NetoperatorV1alpha2Client struct {
+	restClient rest.Interface
+}
+
+func (c *NetoperatorV1alpha2Client) AviLoadBalancerConfigs() AviLoadBalancerConfigInterface {
+	return newAviLoadBalancerConfigs(c)
+}
+
+func (c *NetoperatorV1alpha2Client) FoundationLoadBalancerConfigs(namespace string) FoundationLoadBalancerConfigInterface {
+	return newFoundationLoadBalancerConfigs(c, namespace)
+}
+
+func (c *NetoperatorV1alpha2Client) HAProxyLoadBalancerConfigs() HAProxyLoadBalancerConfigInterface {
+	return newHAProxyLoadBalancerConfigs(c)
+}
+
+func (c *NetoperatorV1alpha2Client) IPAddressAllocations(namespace string) IPAddressAllocationInterface {
+	return newIPAddressAllocations(c, namespace)
+}
+
+func (c *NetoperatorV1alpha2Client) IPPools() IPPoolInterface {
+	return newIPPools(c)
+}
+
+func (c *NetoperatorV1alpha2Client) LoadBalancerConfigs() LoadBalancerConfigInterface {
+	return newLoadBalancerConfigs(c)
+}
+
+func (c *NetoperatorV1alpha2Client) NamespaceNetworkConfigurations() NamespaceNetworkConfigurationInterface {
+	return newNamespaceNetworkConfigurations(c)
+}
+
+func (c *NetoperatorV1alpha2Client) Networks(namespace string) NetworkInterface {
+	return newNetworks(c, namespace)
+}
+
+func (c *NetoperatorV1alpha2Client) NetworkInterfaces(namespace string) NetworkInterfaceInterface {
+	return newNetworkInterfaces(c, namespace)
+}
+
+func (c *NetoperatorV1alpha2Client) NetworkSettingses(namespace string) NetworkSettingsInterface {
+	return newNetworkSettingses(c, namespace)
+}
+
+func (c *NetoperatorV1alpha2Client) VMXNET3NetworkInterfaces(namespace string) VMXNET3NetworkInterfaceInterface {
+	return newVMXNET3NetworkInterfaces(c, namespace)
+}
+
+func (c *NetoperatorV1alpha2Client) VSphereDistributedNetworks() VSphereDistributedNetworkInterface {
+	return newVSphereDistributedNetworks(c)
+}
+
+func (c *NetoperatorV1alpha2Client) WorkloadNetworkConfigurations() WorkloadNetworkConfigurationInterface {
+	return newWorkloadNetworkConfigurations(c)
+}
+
+// NewForConfig creates a new NetoperatorV1alpha2Client for the given config.
+// NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
+// where httpClient was generated with rest.HTTPClientFor(c).
+func NewForConfig(c *rest.Config) (*NetoperatorV1alpha2Client, error) {
+	config := *c
+	setConfigDefaults(&config)
+	httpClient, err := rest.HTTPClientFor(&config)
+	if err != nil {
+		return nil, err
+	}
+	return NewForConfigAndClient(&config, httpClient)
+}
+
+// NewForConfigAndClient creates a new NetoperatorV1alpha2Client for the given config and http client.
+// Note the http client provided takes precedence over the configured transport values.
+func NewForConfigAndClient(c *rest.Config, h *http.Client) (*NetoperatorV1alpha2Client, error) {
+	config := *c
+	setConfigDefaults(&config)
+	client, err := rest.RESTClientForConfigAndClient(&config, h)
+	if err != nil {
+		return nil, err
+	}
+	return &NetoperatorV1alpha2Client{client}, nil
+}
+
+// NewForConfigOrDie creates a new NetoperatorV1alpha2Client for the given config and
+// panics if there is an error in the config.
+func NewForConfigOrDie(c *rest.Config) *NetoperatorV1alpha2Client {
+	client, err := NewForConfig(c)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// New creates a new NetoperatorV1alpha2Client for the given RESTClient.
+func New(c rest.Interface) *NetoperatorV1alpha2Client {
+	return &NetoperatorV1alpha2Client{c}
+}
+
+func setConfigDefaults(config *rest.Config) {
+	gv := apiv1alpha2.SchemeGroupVersion
+	config.GroupVersion = &gv
+	config.APIPath = "/apis"
+	config.NegotiatedSerializer = rest.CodecFactoryForGeneratedClient(scheme.Scheme, scheme.Codecs).WithoutConversion()
+
+	if config.UserAgent == "" {
+		config.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *NetoperatorV1alpha2Client) RESTClient() rest.Interface {
+	if c == nil {
+		return nil
+	}
+	return c.restClient
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	context "context"
+
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	applyconfigurationgeneratedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// AviLoadBalancerConfigsGetter has a method to return a AviLoadBalancerConfigInterface.
+// A group's client should implement this interface.
+type AviLoadBalancerConfigsGetter interface {
+	AviLoadBalancerConfigs() AviLoadBalancerConfigInterface
+}
+
+// AviLoadBalancerConfigInterface has methods to work with AviLoadBalancerConfig resources.
+type AviLoadBalancerConfigInterface interface {
+	Create(ctx context.Context, aviLoadBalancerConfig *apiv1alpha2.AviLoadBalancerConfig, opts v1.CreateOptions) (*apiv1alpha2.AviLoadBalancerConfig, error)
+	Update(ctx context.Context, aviLoadBalancerConfig *apiv1alpha2.AviLoadBalancerConfig, opts v1.UpdateOptions) (*apiv1alpha2.AviLoadBalancerConfig, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, aviLoadBalancerConfig *apiv1alpha2.AviLoadBalancerConfig, opts v1.UpdateOptions) (*apiv1alpha2.AviLoadBalancerConfig, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1alpha2.AviLoadBalancerConfig, error)
+	List(ctx context.Context, opts v1.ListOptions) (*apiv1alpha2.AviLoadBalancerConfigList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1alpha2.AviLoadBalancerConfig, err error)
+	Apply(ctx context.Context, aviLoadBalancerConfig *applyconfigurationgeneratedapiv1alpha2.AviLoadBalancerConfigApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha2.AviLoadBalancerConfig, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, aviLoadBalancerConfig *applyconfigurationgeneratedapiv1alpha2.AviLoadBalancerConfigApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha2.AviLoadBalancerConfig, err error)
+	AviLoadBalancerConfigExpansion
+}
+
+// aviLoadBalancerConfigs implements AviLoadBalancerConfigInterface
+type aviLoadBalancerConfigs struct {
+	*gentype.ClientWithListAndApply[*apiv1alpha2.AviLoadBalancerConfig, *apiv1alpha2.AviLoadBalancerConfigList, *applyconfigurationgeneratedapiv1alpha2.AviLoadBalancerConfigApplyConfiguration]
+}
+
+// newAviLoadBalancerConfigs returns a AviLoadBalancerConfigs
+func newAviLoadBalancerConfigs(c *NetoperatorV1alpha2Client) *aviLoadBalancerConfigs {
+	return &aviLoadBalancerConfigs{
+		gentype.NewClientWithListAndApply[*apiv1alpha2.AviLoadBalancerConfig, *apiv1alpha2.AviLoadBalancerConfigList, *applyconfigurationgeneratedapiv1alpha2.AviLoadBalancerConfigApplyConfiguration](
+			"aviloadbalancerconfigs",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *apiv1alpha2.AviLoadBalancerConfig { return &apiv1alpha2.AviLoadBalancerConfig{} },
+			func() *apiv1alpha2.AviLoadBalancerConfigList { return &apiv1alpha2.AviLoadBalancerConfigList{} },
+		),
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha2"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeNetoperatorV1alpha2 struct {
+	*testing.Fake
+}
+
+func (c *FakeNetoperatorV1alpha2) AviLoadBalancerConfigs() v1alpha2.AviLoadBalancerConfigInterface {
+	return newFakeAviLoadBalancerConfigs(c)
+}
+
+func (c *FakeNetoperatorV1alpha2) FoundationLoadBalancerConfigs(namespace string) v1alpha2.FoundationLoadBalancerConfigInterface {
+	return newFakeFoundationLoadBalancerConfigs(c, namespace)
+}
+
+func (c *FakeNetoperatorV1alpha2) HAProxyLoadBalancerConfigs() v1alpha2.HAProxyLoadBalancerConfigInterface {
+	return newFakeHAProxyLoadBalancerConfigs(c)
+}
+
+func (c *FakeNetoperatorV1alpha2) IPAddressAllocations(namespace string) v1alpha2.IPAddressAllocationInterface {
+	return newFakeIPAddressAllocations(c, namespace)
+}
+
+func (c *FakeNetoperatorV1alpha2) IPPools() v1alpha2.IPPoolInterface {
+	return newFakeIPPools(c)
+}
+
+func (c *FakeNetoperatorV1alpha2) LoadBalancerConfigs() v1alpha2.LoadBalancerConfigInterface {
+	return newFakeLoadBalancerConfigs(c)
+}
+
+func (c *FakeNetoperatorV1alpha2) NamespaceNetworkConfigurations() v1alpha2.NamespaceNetworkConfigurationInterface {
+	return newFakeNamespaceNetworkConfigurations(c)
+}
+
+func (c *FakeNetoperatorV1alpha2) Networks(namespace string) v1alpha2.NetworkInterface {
+	return newFakeNetworks(c, namespace)
+}
+
+func (c *FakeNetoperatorV1alpha2) NetworkInterfaces(namespace string) v1alpha2.NetworkInterfaceInterface {
+	return newFakeNetworkInterfaces(c, namespace)
+}
+
+func (c *FakeNetoperatorV1alpha2) NetworkSettingses(namespace string) v1alpha2.NetworkSettingsInterface {
+	return newFakeNetworkSettingses(c, namespace)
+}
+
+func (c *FakeNetoperatorV1alpha2) VMXNET3NetworkInterfaces(namespace string) v1alpha2.VMXNET3NetworkInterfaceInterface {
+	return newFakeVMXNET3NetworkInterfaces(c, namespace)
+}
+
+func (c *FakeNetoperatorV1alpha2) VSphereDistributedNetworks() v1alpha2.VSphereDistributedNetworkInterface {
+	return newFakeVSphereDistributedNetworks(c)
+}
+
+func (c *FakeNetoperatorV1alpha2) WorkloadNetworkConfigurations() v1alpha2.WorkloadNetworkConfigurationInterface {
+	return newFakeWorkloadNetworkConfigurations(c)
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeNetoperatorV1alpha2) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	typedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeAviLoadBalancerConfigs implements AviLoadBalancerConfigInterface
+type fakeAviLoadBalancerConfigs struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha2.AviLoadBalancerConfig, *v1alpha2.AviLoadBalancerConfigList, *apiv1alpha2.AviLoadBalancerConfigApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha2
+}
+
+func newFakeAviLoadBalancerConfigs(fake *FakeNetoperatorV1alpha2) typedapiv1alpha2.AviLoadBalancerConfigInterface {
+	return &fakeAviLoadBalancerConfigs{
+		gentype.NewFakeClientWithListAndApply[*v1alpha2.AviLoadBalancerConfig, *v1alpha2.AviLoadBalancerConfigList, *apiv1alpha2.AviLoadBalancerConfigApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha2.SchemeGroupVersion.WithResource("aviloadbalancerconfigs"),
+			v1alpha2.SchemeGroupVersion.WithKind("AviLoadBalancerConfig"),
+			func() *v1alpha2.AviLoadBalancerConfig { return &v1alpha2.AviLoadBalancerConfig{} },
+			func() *v1alpha2.AviLoadBalancerConfigList { return &v1alpha2.AviLoadBalancerConfigList{} },
+			func(dst, src *v1alpha2.AviLoadBalancerConfigList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha2.AviLoadBalancerConfigList) []*v1alpha2.AviLoadBalancerConfig {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha2.AviLoadBalancerConfigList, items []*v1alpha2.AviLoadBalancerConfig) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
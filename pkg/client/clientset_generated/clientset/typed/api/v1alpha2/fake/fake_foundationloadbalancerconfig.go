@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	typedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeFoundationLoadBalancerConfigs implements FoundationLoadBalancerConfigInterface
+type fakeFoundationLoadBalancerConfigs struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha2.FoundationLoadBalancerConfig, *v1alpha2.FoundationLoadBalancerConfigList, *apiv1alpha2.FoundationLoadBalancerConfigApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha2
+}
+
+func newFakeFoundationLoadBalancerConfigs(fake *FakeNetoperatorV1alpha2, namespace string) typedapiv1alpha2.FoundationLoadBalancerConfigInterface {
+	return &fakeFoundationLoadBalancerConfigs{
+		gentype.NewFakeClientWithListAndApply[*v1alpha2.FoundationLoadBalancerConfig, *v1alpha2.FoundationLoadBalancerConfigList, *apiv1alpha2.FoundationLoadBalancerConfigApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha2.SchemeGroupVersion.WithResource("foundationloadbalancerconfigs"),
+			v1alpha2.SchemeGroupVersion.WithKind("FoundationLoadBalancerConfig"),
+			func() *v1alpha2.FoundationLoadBalancerConfig { return &v1alpha2.FoundationLoadBalancerConfig{} },
+			func() *v1alpha2.FoundationLoadBalancerConfigList { return &v1alpha2.FoundationLoadBalancerConfigList{} },
+			func(dst, src *v1alpha2.FoundationLoadBalancerConfigList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha2.FoundationLoadBalancerConfigList) []*v1alpha2.FoundationLoadBalancerConfig {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha2.FoundationLoadBalancerConfigList, items []*v1alpha2.FoundationLoadBalancerConfig) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	typedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeHAProxyLoadBalancerConfigs implements HAProxyLoadBalancerConfigInterface
+type fakeHAProxyLoadBalancerConfigs struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha2.HAProxyLoadBalancerConfig, *v1alpha2.HAProxyLoadBalancerConfigList, *apiv1alpha2.HAProxyLoadBalancerConfigApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha2
+}
+
+func newFakeHAProxyLoadBalancerConfigs(fake *FakeNetoperatorV1alpha2) typedapiv1alpha2.HAProxyLoadBalancerConfigInterface {
+	return &fakeHAProxyLoadBalancerConfigs{
+		gentype.NewFakeClientWithListAndApply[*v1alpha2.HAProxyLoadBalancerConfig, *v1alpha2.HAProxyLoadBalancerConfigList, *apiv1alpha2.HAProxyLoadBalancerConfigApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha2.SchemeGroupVersion.WithResource("haproxyloadbalancerconfigs"),
+			v1alpha2.SchemeGroupVersion.WithKind("HAProxyLoadBalancerConfig"),
+			func() *v1alpha2.HAProxyLoadBalancerConfig { return &v1alpha2.HAProxyLoadBalancerConfig{} },
+			func() *v1alpha2.HAProxyLoadBalancerConfigList { return &v1alpha2.HAProxyLoadBalancerConfigList{} },
+			func(dst, src *v1alpha2.HAProxyLoadBalancerConfigList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha2.HAProxyLoadBalancerConfigList) []*v1alpha2.HAProxyLoadBalancerConfig {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha2.HAProxyLoadBalancerConfigList, items []*v1alpha2.HAProxyLoadBalancerConfig) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	typedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeIPAddressAllocations implements IPAddressAllocationInterface
+type fakeIPAddressAllocations struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha2.IPAddressAllocation, *v1alpha2.IPAddressAllocationList, *apiv1alpha2.IPAddressAllocationApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha2
+}
+
+func newFakeIPAddressAllocations(fake *FakeNetoperatorV1alpha2, namespace string) typedapiv1alpha2.IPAddressAllocationInterface {
+	return &fakeIPAddressAllocations{
+		gentype.NewFakeClientWithListAndApply[*v1alpha2.IPAddressAllocation, *v1alpha2.IPAddressAllocationList, *apiv1alpha2.IPAddressAllocationApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha2.SchemeGroupVersion.WithResource("ipaddressallocations"),
+			v1alpha2.SchemeGroupVersion.WithKind("IPAddressAllocation"),
+			func() *v1alpha2.IPAddressAllocation { return &v1alpha2.IPAddressAllocation{} },
+			func() *v1alpha2.IPAddressAllocationList { return &v1alpha2.IPAddressAllocationList{} },
+			func(dst, src *v1alpha2.IPAddressAllocationList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha2.IPAddressAllocationList) []*v1alpha2.IPAddressAllocation {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha2.IPAddressAllocationList, items []*v1alpha2.IPAddressAllocation) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
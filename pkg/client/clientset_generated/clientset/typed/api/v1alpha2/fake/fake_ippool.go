@@ -0,0 +1,39 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	typedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeIPPools implements IPPoolInterface
+type fakeIPPools struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha2.IPPool, *v1alpha2.IPPoolList, *apiv1alpha2.IPPoolApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha2
+}
+
+func newFakeIPPools(fake *FakeNetoperatorV1alpha2) typedapiv1alpha2.IPPoolInterface {
+	return &fakeIPPools{
+		gentype.NewFakeClientWithListAndApply[*v1alpha2.IPPool, *v1alpha2.IPPoolList, *apiv1alpha2.IPPoolApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha2.SchemeGroupVersion.WithResource("ippools"),
+			v1alpha2.SchemeGroupVersion.WithKind("IPPool"),
+			func() *v1alpha2.IPPool { return &v1alpha2.IPPool{} },
+			func() *v1alpha2.IPPoolList { return &v1alpha2.IPPoolList{} },
+			func(dst, src *v1alpha2.IPPoolList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha2.IPPoolList) []*v1alpha2.IPPool { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1alpha2.IPPoolList, items []*v1alpha2.IPPool) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
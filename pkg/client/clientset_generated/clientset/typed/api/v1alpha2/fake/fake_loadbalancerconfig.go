@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	typedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeLoadBalancerConfigs implements LoadBalancerConfigInterface
+type fakeLoadBalancerConfigs struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha2.LoadBalancerConfig, *v1alpha2.LoadBalancerConfigList, *apiv1alpha2.LoadBalancerConfigApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha2
+}
+
+func newFakeLoadBalancerConfigs(fake *FakeNetoperatorV1alpha2) typedapiv1alpha2.LoadBalancerConfigInterface {
+	return &fakeLoadBalancerConfigs{
+		gentype.NewFakeClientWithListAndApply[*v1alpha2.LoadBalancerConfig, *v1alpha2.LoadBalancerConfigList, *apiv1alpha2.LoadBalancerConfigApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha2.SchemeGroupVersion.WithResource("loadbalancerconfigs"),
+			v1alpha2.SchemeGroupVersion.WithKind("LoadBalancerConfig"),
+			func() *v1alpha2.LoadBalancerConfig { return &v1alpha2.LoadBalancerConfig{} },
+			func() *v1alpha2.LoadBalancerConfigList { return &v1alpha2.LoadBalancerConfigList{} },
+			func(dst, src *v1alpha2.LoadBalancerConfigList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha2.LoadBalancerConfigList) []*v1alpha2.LoadBalancerConfig {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha2.LoadBalancerConfigList, items []*v1alpha2.LoadBalancerConfig) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
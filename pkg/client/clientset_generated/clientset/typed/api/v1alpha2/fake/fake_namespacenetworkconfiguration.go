@@ -0,0 +1,43 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	typedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeNamespaceNetworkConfigurations implements NamespaceNetworkConfigurationInterface
+type fakeNamespaceNetworkConfigurations struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha2.NamespaceNetworkConfiguration, *v1alpha2.NamespaceNetworkConfigurationList, *apiv1alpha2.NamespaceNetworkConfigurationApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha2
+}
+
+func newFakeNamespaceNetworkConfigurations(fake *FakeNetoperatorV1alpha2) typedapiv1alpha2.NamespaceNetworkConfigurationInterface {
+	return &fakeNamespaceNetworkConfigurations{
+		gentype.NewFakeClientWithListAndApply[*v1alpha2.NamespaceNetworkConfiguration, *v1alpha2.NamespaceNetworkConfigurationList, *apiv1alpha2.NamespaceNetworkConfigurationApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha2.SchemeGroupVersion.WithResource("namespacenetworkconfigurations"),
+			v1alpha2.SchemeGroupVersion.WithKind("NamespaceNetworkConfiguration"),
+			func() *v1alpha2.NamespaceNetworkConfiguration { return &v1alpha2.NamespaceNetworkConfiguration{} },
+			func() *v1alpha2.NamespaceNetworkConfigurationList {
+				return &v1alpha2.NamespaceNetworkConfigurationList{}
+			},
+			func(dst, src *v1alpha2.NamespaceNetworkConfigurationList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha2.NamespaceNetworkConfigurationList) []*v1alpha2.NamespaceNetworkConfiguration {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha2.NamespaceNetworkConfigurationList, items []*v1alpha2.NamespaceNetworkConfiguration) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	typedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeNetworks implements NetworkInterface
+type fakeNetworks struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha2.Network, *v1alpha2.NetworkList, *apiv1alpha2.NetworkApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha2
+}
+
+func newFakeNetworks(fake *FakeNetoperatorV1alpha2, namespace string) typedapiv1alpha2.NetworkInterface {
+	return &fakeNetworks{
+		gentype.NewFakeClientWithListAndApply[*v1alpha2.Network, *v1alpha2.NetworkList, *apiv1alpha2.NetworkApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha2.SchemeGroupVersion.WithResource("networks"),
+			v1alpha2.SchemeGroupVersion.WithKind("Network"),
+			func() *v1alpha2.Network { return &v1alpha2.Network{} },
+			func() *v1alpha2.NetworkList { return &v1alpha2.NetworkList{} },
+			func(dst, src *v1alpha2.NetworkList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha2.NetworkList) []*v1alpha2.Network { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1alpha2.NetworkList, items []*v1alpha2.Network) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	typedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeNetworkInterfaces implements NetworkInterfaceInterface
+type fakeNetworkInterfaces struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha2.NetworkInterface, *v1alpha2.NetworkInterfaceList, *apiv1alpha2.NetworkInterfaceApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha2
+}
+
+func newFakeNetworkInterfaces(fake *FakeNetoperatorV1alpha2, namespace string) typedapiv1alpha2.NetworkInterfaceInterface {
+	return &fakeNetworkInterfaces{
+		gentype.NewFakeClientWithListAndApply[*v1alpha2.NetworkInterface, *v1alpha2.NetworkInterfaceList, *apiv1alpha2.NetworkInterfaceApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha2.SchemeGroupVersion.WithResource("networkinterfaces"),
+			v1alpha2.SchemeGroupVersion.WithKind("NetworkInterface"),
+			func() *v1alpha2.NetworkInterface { return &v1alpha2.NetworkInterface{} },
+			func() *v1alpha2.NetworkInterfaceList { return &v1alpha2.NetworkInterfaceList{} },
+			func(dst, src *v1alpha2.NetworkInterfaceList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha2.NetworkInterfaceList) []*v1alpha2.NetworkInterface {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha2.NetworkInterfaceList, items []*v1alpha2.NetworkInterface) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	typedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeNetworkSettingses implements NetworkSettingsInterface
+type fakeNetworkSettingses struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha2.NetworkSettings, *v1alpha2.NetworkSettingsList, *apiv1alpha2.NetworkSettingsApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha2
+}
+
+func newFakeNetworkSettingses(fake *FakeNetoperatorV1alpha2, namespace string) typedapiv1alpha2.NetworkSettingsInterface {
+	return &fakeNetworkSettingses{
+		gentype.NewFakeClientWithListAndApply[*v1alpha2.NetworkSettings, *v1alpha2.NetworkSettingsList, *apiv1alpha2.NetworkSettingsApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha2.SchemeGroupVersion.WithResource("networksettingses"),
+			v1alpha2.SchemeGroupVersion.WithKind("NetworkSettings"),
+			func() *v1alpha2.NetworkSettings { return &v1alpha2.NetworkSettings{} },
+			func() *v1alpha2.NetworkSettingsList { return &v1alpha2.NetworkSettingsList{} },
+			func(dst, src *v1alpha2.NetworkSettingsList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha2.NetworkSettingsList) []*v1alpha2.NetworkSettings {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha2.NetworkSettingsList, items []*v1alpha2.NetworkSettings) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
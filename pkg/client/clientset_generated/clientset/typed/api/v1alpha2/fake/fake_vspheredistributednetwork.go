@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	typedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeVSphereDistributedNetworks implements VSphereDistributedNetworkInterface
+type fakeVSphereDistributedNetworks struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha2.VSphereDistributedNetwork, *v1alpha2.VSphereDistributedNetworkList, *apiv1alpha2.VSphereDistributedNetworkApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha2
+}
+
+func newFakeVSphereDistributedNetworks(fake *FakeNetoperatorV1alpha2) typedapiv1alpha2.VSphereDistributedNetworkInterface {
+	return &fakeVSphereDistributedNetworks{
+		gentype.NewFakeClientWithListAndApply[*v1alpha2.VSphereDistributedNetwork, *v1alpha2.VSphereDistributedNetworkList, *apiv1alpha2.VSphereDistributedNetworkApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha2.SchemeGroupVersion.WithResource("vspheredistributednetworks"),
+			v1alpha2.SchemeGroupVersion.WithKind("VSphereDistributedNetwork"),
+			func() *v1alpha2.VSphereDistributedNetwork { return &v1alpha2.VSphereDistributedNetwork{} },
+			func() *v1alpha2.VSphereDistributedNetworkList { return &v1alpha2.VSphereDistributedNetworkList{} },
+			func(dst, src *v1alpha2.VSphereDistributedNetworkList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha2.VSphereDistributedNetworkList) []*v1alpha2.VSphereDistributedNetwork {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha2.VSphereDistributedNetworkList, items []*v1alpha2.VSphereDistributedNetwork) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	typedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/typed/api/v1alpha2"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeWorkloadNetworkConfigurations implements WorkloadNetworkConfigurationInterface
+type fakeWorkloadNetworkConfigurations struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha2.WorkloadNetworkConfiguration, *v1alpha2.WorkloadNetworkConfigurationList, *apiv1alpha2.WorkloadNetworkConfigurationApplyConfiguration]
+	Fake *FakeNetoperatorV1alpha2
+}
+
+func newFakeWorkloadNetworkConfigurations(fake *FakeNetoperatorV1alpha2) typedapiv1alpha2.WorkloadNetworkConfigurationInterface {
+	return &fakeWorkloadNetworkConfigurations{
+		gentype.NewFakeClientWithListAndApply[*v1alpha2.WorkloadNetworkConfiguration, *v1alpha2.WorkloadNetworkConfigurationList, *apiv1alpha2.WorkloadNetworkConfigurationApplyConfiguration](
+			fake.Fake,
+			"",
+			v1alpha2.SchemeGroupVersion.WithResource("workloadnetworkconfigurations"),
+			v1alpha2.SchemeGroupVersion.WithKind("WorkloadNetworkConfiguration"),
+			func() *v1alpha2.WorkloadNetworkConfiguration { return &v1alpha2.WorkloadNetworkConfiguration{} },
+			func() *v1alpha2.WorkloadNetworkConfigurationList { return &v1alpha2.WorkloadNetworkConfigurationList{} },
+			func(dst, src *v1alpha2.WorkloadNetworkConfigurationList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha2.WorkloadNetworkConfigurationList) []*v1alpha2.WorkloadNetworkConfiguration {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha2.WorkloadNetworkConfigurationList, items []*v1alpha2.WorkloadNetworkConfiguration) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	context "context"
+
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	applyconfigurationgeneratedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// IPAddressAllocationsGetter has a method to return a IPAddressAllocationInterface.
+// A group's client should implement this interface.
+type IPAddressAllocationsGetter interface {
+	IPAddressAllocations(namespace string) IPAddressAllocationInterface
+}
+
+// IPAddressAllocationInterface has methods to work with IPAddressAllocation resources.
+type IPAddressAllocationInterface interface {
+	Create(ctx context.Context, iPAddressAllocation *apiv1alpha2.IPAddressAllocation, opts v1.CreateOptions) (*apiv1alpha2.IPAddressAllocation, error)
+	Update(ctx context.Context, iPAddressAllocation *apiv1alpha2.IPAddressAllocation, opts v1.UpdateOptions) (*apiv1alpha2.IPAddressAllocation, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, iPAddressAllocation *apiv1alpha2.IPAddressAllocation, opts v1.UpdateOptions) (*apiv1alpha2.IPAddressAllocation, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1alpha2.IPAddressAllocation, error)
+	List(ctx context.Context, opts v1.ListOptions) (*apiv1alpha2.IPAddressAllocationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1alpha2.IPAddressAllocation, err error)
+	Apply(ctx context.Context, iPAddressAllocation *applyconfigurationgeneratedapiv1alpha2.IPAddressAllocationApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha2.IPAddressAllocation, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, iPAddressAllocation *applyconfigurationgeneratedapiv1alpha2.IPAddressAllocationApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha2.IPAddressAllocation, err error)
+	IPAddressAllocationExpansion
+}
+
+// iPAddressAllocations implements IPAddressAllocationInterface
+type iPAddressAllocations struct {
+	*gentype.ClientWithListAndApply[*apiv1alpha2.IPAddressAllocation, *apiv1alpha2.IPAddressAllocationList, *applyconfigurationgeneratedapiv1alpha2.IPAddressAllocationApplyConfiguration]
+}
+
+// newIPAddressAllocations returns a IPAddressAllocations
+func newIPAddressAllocations(c *NetoperatorV1alpha2Client, namespace string) *iPAddressAllocations {
+	return &iPAddressAllocations{
+		gentype.NewClientWithListAndApply[*apiv1alpha2.IPAddressAllocation, *apiv1alpha2.IPAddressAllocationList, *applyconfigurationgeneratedapiv1alpha2.IPAddressAllocationApplyConfiguration](
+			"ipaddressallocations",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *apiv1alpha2.IPAddressAllocation { return &apiv1alpha2.IPAddressAllocation{} },
+			func() *apiv1alpha2.IPAddressAllocationList { return &apiv1alpha2.IPAddressAllocationList{} },
+		),
+	}
+}
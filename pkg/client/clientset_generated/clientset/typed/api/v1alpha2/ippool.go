@@ -0,0 +1,62 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	context "context"
+
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	applyconfigurationgeneratedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// IPPoolsGetter has a method to return a IPPoolInterface.
+// A group's client should implement this interface.
+type IPPoolsGetter interface {
+	IPPools() IPPoolInterface
+}
+
+// IPPoolInterface has methods to work with IPPool resources.
+type IPPoolInterface interface {
+	Create(ctx context.Context, iPPool *apiv1alpha2.IPPool, opts v1.CreateOptions) (*apiv1alpha2.IPPool, error)
+	Update(ctx context.Context, iPPool *apiv1alpha2.IPPool, opts v1.UpdateOptions) (*apiv1alpha2.IPPool, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, iPPool *apiv1alpha2.IPPool, opts v1.UpdateOptions) (*apiv1alpha2.IPPool, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1alpha2.IPPool, error)
+	List(ctx context.Context, opts v1.ListOptions) (*apiv1alpha2.IPPoolList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1alpha2.IPPool, err error)
+	Apply(ctx context.Context, iPPool *applyconfigurationgeneratedapiv1alpha2.IPPoolApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha2.IPPool, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, iPPool *applyconfigurationgeneratedapiv1alpha2.IPPoolApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha2.IPPool, err error)
+	IPPoolExpansion
+}
+
+// iPPools implements IPPoolInterface
+type iPPools struct {
+	*gentype.ClientWithListAndApply[*apiv1alpha2.IPPool, *apiv1alpha2.IPPoolList, *applyconfigurationgeneratedapiv1alpha2.IPPoolApplyConfiguration]
+}
+
+// newIPPools returns a IPPools
+func newIPPools(c *NetoperatorV1alpha2Client) *iPPools {
+	return &iPPools{
+		gentype.NewClientWithListAndApply[*apiv1alpha2.IPPool, *apiv1alpha2.IPPoolList, *applyconfigurationgeneratedapiv1alpha2.IPPoolApplyConfiguration](
+			"ippools",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *apiv1alpha2.IPPool { return &apiv1alpha2.IPPool{} },
+			func() *apiv1alpha2.IPPoolList { return &apiv1alpha2.IPPoolList{} },
+		),
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	context "context"
+
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	applyconfigurationgeneratedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// NamespaceNetworkConfigurationsGetter has a method to return a NamespaceNetworkConfigurationInterface.
+// A group's client should implement this interface.
+type NamespaceNetworkConfigurationsGetter interface {
+	NamespaceNetworkConfigurations() NamespaceNetworkConfigurationInterface
+}
+
+// NamespaceNetworkConfigurationInterface has methods to work with NamespaceNetworkConfiguration resources.
+type NamespaceNetworkConfigurationInterface interface {
+	Create(ctx context.Context, namespaceNetworkConfiguration *apiv1alpha2.NamespaceNetworkConfiguration, opts v1.CreateOptions) (*apiv1alpha2.NamespaceNetworkConfiguration, error)
+	Update(ctx context.Context, namespaceNetworkConfiguration *apiv1alpha2.NamespaceNetworkConfiguration, opts v1.UpdateOptions) (*apiv1alpha2.NamespaceNetworkConfiguration, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, namespaceNetworkConfiguration *apiv1alpha2.NamespaceNetworkConfiguration, opts v1.UpdateOptions) (*apiv1alpha2.NamespaceNetworkConfiguration, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1alpha2.NamespaceNetworkConfiguration, error)
+	List(ctx context.Context, opts v1.ListOptions) (*apiv1alpha2.NamespaceNetworkConfigurationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1alpha2.NamespaceNetworkConfiguration, err error)
+	Apply(ctx context.Context, namespaceNetworkConfiguration *applyconfigurationgeneratedapiv1alpha2.NamespaceNetworkConfigurationApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha2.NamespaceNetworkConfiguration, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, namespaceNetworkConfiguration *applyconfigurationgeneratedapiv1alpha2.NamespaceNetworkConfigurationApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha2.NamespaceNetworkConfiguration, err error)
+	NamespaceNetworkConfigurationExpansion
+}
+
+// namespaceNetworkConfigurations implements NamespaceNetworkConfigurationInterface
+type namespaceNetworkConfigurations struct {
+	*gentype.ClientWithListAndApply[*apiv1alpha2.NamespaceNetworkConfiguration, *apiv1alpha2.NamespaceNetworkConfigurationList, *applyconfigurationgeneratedapiv1alpha2.NamespaceNetworkConfigurationApplyConfiguration]
+}
+
+// newNamespaceNetworkConfigurations returns a NamespaceNetworkConfigurations
+func newNamespaceNetworkConfigurations(c *NetoperatorV1alpha2Client) *namespaceNetworkConfigurations {
+	return &namespaceNetworkConfigurations{
+		gentype.NewClientWithListAndApply[*apiv1alpha2.NamespaceNetworkConfiguration, *apiv1alpha2.NamespaceNetworkConfigurationList, *applyconfigurationgeneratedapiv1alpha2.NamespaceNetworkConfigurationApplyConfiguration](
+			"namespacenetworkconfigurations",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *apiv1alpha2.NamespaceNetworkConfiguration { return &apiv1alpha2.NamespaceNetworkConfiguration{} },
+			func() *apiv1alpha2.NamespaceNetworkConfigurationList {
+				return &apiv1alpha2.NamespaceNetworkConfigurationList{}
+			},
+		),
+	}
+}
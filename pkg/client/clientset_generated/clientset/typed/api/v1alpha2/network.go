@@ -0,0 +1,62 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	context "context"
+
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	applyconfigurationgeneratedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// NetworksGetter has a method to return a NetworkInterface.
+// A group's client should implement this interface.
+type NetworksGetter interface {
+	Networks(namespace string) NetworkInterface
+}
+
+// NetworkInterface has methods to work with Network resources.
+type NetworkInterface interface {
+	Create(ctx context.Context, network *apiv1alpha2.Network, opts v1.CreateOptions) (*apiv1alpha2.Network, error)
+	Update(ctx context.Context, network *apiv1alpha2.Network, opts v1.UpdateOptions) (*apiv1alpha2.Network, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, network *apiv1alpha2.Network, opts v1.UpdateOptions) (*apiv1alpha2.Network, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1alpha2.Network, error)
+	List(ctx context.Context, opts v1.ListOptions) (*apiv1alpha2.NetworkList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1alpha2.Network, err error)
+	Apply(ctx context.Context, network *applyconfigurationgeneratedapiv1alpha2.NetworkApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha2.Network, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, network *applyconfigurationgeneratedapiv1alpha2.NetworkApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha2.Network, err error)
+	NetworkExpansion
+}
+
+// networks implements NetworkInterface
+type networks struct {
+	*gentype.ClientWithListAndApply[*apiv1alpha2.Network, *apiv1alpha2.NetworkList, *applyconfigurationgeneratedapiv1alpha2.NetworkApplyConfiguration]
+}
+
+// newNetworks returns a Networks
+func newNetworks(c *NetoperatorV1alpha2Client, namespace string) *networks {
+	return &networks{
+		gentype.NewClientWithListAndApply[*apiv1alpha2.Network, *apiv1alpha2.NetworkList, *applyconfigurationgeneratedapiv1alpha2.NetworkApplyConfiguration](
+			"networks",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *apiv1alpha2.Network { return &apiv1alpha2.Network{} },
+			func() *apiv1alpha2.NetworkList { return &apiv1alpha2.NetworkList{} },
+		),
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	context "context"
+
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	applyconfigurationgeneratedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VMXNET3NetworkInterfacesGetter has a method to return a VMXNET3NetworkInterfaceInterface.
+// A group's client should implement this interface.
+type VMXNET3NetworkInterfacesGetter interface {
+	VMXNET3NetworkInterfaces(namespace string) VMXNET3NetworkInterfaceInterface
+}
+
+// VMXNET3NetworkInterfaceInterface has methods to work with VMXNET3NetworkInterface resources.
+type VMXNET3NetworkInterfaceInterface interface {
+	Create(ctx context.Context, vMXNET3NetworkInterface *apiv1alpha2.VMXNET3NetworkInterface, opts v1.CreateOptions) (*apiv1alpha2.VMXNET3NetworkInterface, error)
+	Update(ctx context.Context, vMXNET3NetworkInterface *apiv1alpha2.VMXNET3NetworkInterface, opts v1.UpdateOptions) (*apiv1alpha2.VMXNET3NetworkInterface, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, vMXNET3NetworkInterface *apiv1alpha2.VMXNET3NetworkInterface, opts v1.UpdateOptions) (*apiv1alpha2.VMXNET3NetworkInterface, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1alpha2.VMXNET3NetworkInterface, error)
+	List(ctx context.Context, opts v1.ListOptions) (*apiv1alpha2.VMXNET3NetworkInterfaceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1alpha2.VMXNET3NetworkInterface, err error)
+	Apply(ctx context.Context, vMXNET3NetworkInterface *applyconfigurationgeneratedapiv1alpha2.VMXNET3NetworkInterfaceApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha2.VMXNET3NetworkInterface, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, vMXNET3NetworkInterface *applyconfigurationgeneratedapiv1alpha2.VMXNET3NetworkInterfaceApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha2.VMXNET3NetworkInterface, err error)
+	VMXNET3NetworkInterfaceExpansion
+}
+
+// vMXNET3NetworkInterfaces implements VMXNET3NetworkInterfaceInterface
+type vMXNET3NetworkInterfaces struct {
+	*gentype.ClientWithListAndApply[*apiv1alpha2.VMXNET3NetworkInterface, *apiv1alpha2.VMXNET3NetworkInterfaceList, *applyconfigurationgeneratedapiv1alpha2.VMXNET3NetworkInterfaceApplyConfiguration]
+}
+
+// newVMXNET3NetworkInterfaces returns a VMXNET3NetworkInterfaces
+func newVMXNET3NetworkInterfaces(c *NetoperatorV1alpha2Client, namespace string) *vMXNET3NetworkInterfaces {
+	return &vMXNET3NetworkInterfaces{
+		gentype.NewClientWithListAndApply[*apiv1alpha2.VMXNET3NetworkInterface, *apiv1alpha2.VMXNET3NetworkInterfaceList, *applyconfigurationgeneratedapiv1alpha2.VMXNET3NetworkInterfaceApplyConfiguration](
+			"vmxnet3networkinterfaces",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *apiv1alpha2.VMXNET3NetworkInterface { return &apiv1alpha2.VMXNET3NetworkInterface{} },
+			func() *apiv1alpha2.VMXNET3NetworkInterfaceList { return &apiv1alpha2.VMXNET3NetworkInterfaceList{} },
+		),
+	}
+}
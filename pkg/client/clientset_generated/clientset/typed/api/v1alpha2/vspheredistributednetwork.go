@@ -0,0 +1,62 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	context "context"
+
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	applyconfigurationgeneratedapiv1alpha2 "github.com/vmware-tanzu/net-operator-api/pkg/client/applyconfiguration_generated/api/v1alpha2"
+	scheme "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// VSphereDistributedNetworksGetter has a method to return a VSphereDistributedNetworkInterface.
+// A group's client should implement this interface.
+type VSphereDistributedNetworksGetter interface {
+	VSphereDistributedNetworks() VSphereDistributedNetworkInterface
+}
+
+// VSphereDistributedNetworkInterface has methods to work with VSphereDistributedNetwork resources.
+type VSphereDistributedNetworkInterface interface {
+	Create(ctx context.Context, vSphereDistributedNetwork *apiv1alpha2.VSphereDistributedNetwork, opts v1.CreateOptions) (*apiv1alpha2.VSphereDistributedNetwork, error)
+	Update(ctx context.Context, vSphereDistributedNetwork *apiv1alpha2.VSphereDistributedNetwork, opts v1.UpdateOptions) (*apiv1alpha2.VSphereDistributedNetwork, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, vSphereDistributedNetwork *apiv1alpha2.VSphereDistributedNetwork, opts v1.UpdateOptions) (*apiv1alpha2.VSphereDistributedNetwork, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1alpha2.VSphereDistributedNetwork, error)
+	List(ctx context.Context, opts v1.ListOptions) (*apiv1alpha2.VSphereDistributedNetworkList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1alpha2.VSphereDistributedNetwork, err error)
+	Apply(ctx context.Context, vSphereDistributedNetwork *applyconfigurationgeneratedapiv1alpha2.VSphereDistributedNetworkApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha2.VSphereDistributedNetwork, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, vSphereDistributedNetwork *applyconfigurationgeneratedapiv1alpha2.VSphereDistributedNetworkApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha2.VSphereDistributedNetwork, err error)
+	VSphereDistributedNetworkExpansion
+}
+
+// vSphereDistributedNetworks implements VSphereDistributedNetworkInterface
+type vSphereDistributedNetworks struct {
+	*gentype.ClientWithListAndApply[*apiv1alpha2.VSphereDistributedNetwork, *apiv1alpha2.VSphereDistributedNetworkList, *applyconfigurationgeneratedapiv1alpha2.VSphereDistributedNetworkApplyConfiguration]
+}
+
+// newVSphereDistributedNetworks returns a VSphereDistributedNetworks
+func newVSphereDistributedNetworks(c *NetoperatorV1alpha2Client) *vSphereDistributedNetworks {
+	return &vSphereDistributedNetworks{
+		gentype.NewClientWithListAndApply[*apiv1alpha2.VSphereDistributedNetwork, *apiv1alpha2.VSphereDistributedNetworkList, *applyconfigurationgeneratedapiv1alpha2.VSphereDistributedNetworkApplyConfiguration](
+			"vspheredistributednetworks",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *apiv1alpha2.VSphereDistributedNetwork { return &apiv1alpha2.VSphereDistributedNetwork{} },
+			func() *apiv1alpha2.VSphereDistributedNetworkList { return &apiv1alpha2.VSphereDistributedNetworkList{} },
+		),
+	}
+}
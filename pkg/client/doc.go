@@ -0,0 +1,24 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Package client is the root of the generated Go clients for the
+// netoperator.vmware.com API group:
+//
+//   - clientset_generated/clientset is the typed clientset, and
+//     clientset_generated/clientset/fake its fake for unit tests.
+//   - listers_generated contains the listers.
+//   - informers_generated contains the shared informer factory.
+//   - applyconfiguration_generated contains the apply configurations used
+//     for server-side apply.
+//
+// The clients are generated by hack/client-gen.sh and checked in, so consumers
+// import them rather than generating their own. They are a separate Go module
+// pinned to the k8s.io/client-go minor version they were generated against,
+// currently v0.35, which keeps the API types module free of a client-go
+// dependency.
+//
+// Do not edit the generated packages by hand. Run "make generate-client" after
+// changing the API types; "make verify-client" fails if the checked-in clients
+// are out of date.
+package client
@@ -0,0 +1,89 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+	time "time"
+
+	netoperatorapiapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	clientset "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset"
+	internalinterfaces "github.com/vmware-tanzu/net-operator-api/pkg/client/informers_generated/internalinterfaces"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/listers_generated/api/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// AviLoadBalancerConfigInformer provides access to a shared informer and lister for
+// AviLoadBalancerConfigs.
+type AviLoadBalancerConfigInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() apiv1alpha1.AviLoadBalancerConfigLister
+}
+
+type aviLoadBalancerConfigInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewAviLoadBalancerConfigInformer constructs a new informer for AviLoadBalancerConfig type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewAviLoadBalancerConfigInformer(client clientset.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredAviLoadBalancerConfigInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredAviLoadBalancerConfigInformer constructs a new informer for AviLoadBalancerConfig type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredAviLoadBalancerConfigInformer(client clientset.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetoperatorV1alpha1().AviLoadBalancerConfigs().List(context.Background(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetoperatorV1alpha1().AviLoadBalancerConfigs().Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetoperatorV1alpha1().AviLoadBalancerConfigs().List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetoperatorV1alpha1().AviLoadBalancerConfigs().Watch(ctx, options)
+			},
+		}, client),
+		&netoperatorapiapiv1alpha1.AviLoadBalancerConfig{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *aviLoadBalancerConfigInformer) defaultInformer(client clientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredAviLoadBalancerConfigInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *aviLoadBalancerConfigInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&netoperatorapiapiv1alpha1.AviLoadBalancerConfig{}, f.defaultInformer)
+}
+
+func (f *aviLoadBalancerConfigInformer) Lister() apiv1alpha1.AviLoadBalancerConfigLister {
+	return apiv1alpha1.NewAviLoadBalancerConfigLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+	time "time"
+
+	netoperatorapiapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	clientset "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset"
+	internalinterfaces "github.com/vmware-tanzu/net-operator-api/pkg/client/informers_generated/internalinterfaces"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/listers_generated/api/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// HAProxyLoadBalancerConfigInformer provides access to a shared informer and lister for
+// HAProxyLoadBalancerConfigs.
+type HAProxyLoadBalancerConfigInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() apiv1alpha1.HAProxyLoadBalancerConfigLister
+}
+
+type hAProxyLoadBalancerConfigInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// NewHAProxyLoadBalancerConfigInformer constructs a new informer for HAProxyLoadBalancerConfig type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewHAProxyLoadBalancerConfigInformer(client clientset.Interface, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredHAProxyLoadBalancerConfigInformer(client, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredHAProxyLoadBalancerConfigInformer constructs a new informer for HAProxyLoadBalancerConfig type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredHAProxyLoadBalancerConfigInformer(client clientset.Interface, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetoperatorV1alpha1().HAProxyLoadBalancerConfigs().List(context.Background(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetoperatorV1alpha1().HAProxyLoadBalancerConfigs().Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetoperatorV1alpha1().HAProxyLoadBalancerConfigs().List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetoperatorV1alpha1().HAProxyLoadBalancerConfigs().Watch(ctx, options)
+			},
+		}, client),
+		&netoperatorapiapiv1alpha1.HAProxyLoadBalancerConfig{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *hAProxyLoadBalancerConfigInformer) defaultInformer(client clientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredHAProxyLoadBalancerConfigInformer(client, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *hAProxyLoadBalancerConfigInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&netoperatorapiapiv1alpha1.HAProxyLoadBalancerConfig{}, f.defaultInformer)
+}
+
+func (f *hAProxyLoadBalancerConfigInformer) Lister() apiv1alpha1.HAProxyLoadBalancerConfigLister {
+	return apiv1alpha1.NewHAProxyLoadBalancerConfigLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+	time "time"
+
+	netoperatorapiapiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	clientset "github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset"
+	internalinterfaces "github.com/vmware-tanzu/net-operator-api/pkg/client/informers_generated/internalinterfaces"
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/pkg/client/listers_generated/api/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// IPAddressAllocationInformer provides access to a shared informer and lister for
+// IPAddressAllocations.
+type IPAddressAllocationInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() apiv1alpha1.IPAddressAllocationLister
+}
+
+type iPAddressAllocationInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewIPAddressAllocationInformer constructs a new informer for IPAddressAllocation type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewIPAddressAllocationInformer(client clientset.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredIPAddressAllocationInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredIPAddressAllocationInformer constructs a new informer for IPAddressAllocation type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredIPAddressAllocationInformer(client clientset.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetoperatorV1alpha1().IPAddressAllocations(namespace).List(context.Background(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetoperatorV1alpha1().IPAddressAllocations(namespace).Watch(context.Background(), options)
+			},
+			ListWithContextFunc: func(ctx context.Context, options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetoperatorV1alpha1().IPAddressAllocations(namespace).List(ctx, options)
+			},
+			WatchFuncWithContext: func(ctx context.Context, options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.NetoperatorV1alpha1().IPAddressAllocations(namespace).Watch(ctx, options)
+			},
+		}, client),
+		&netoperatorapiapiv1alpha1.IPAddressAllocation{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *iPAddressAllocationInformer) defaultInformer(client clientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredIPAddressAllocationInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *iPAddressAllocationInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&netoperatorapiapiv1alpha1.IPAddressAllocation{}, f.defaultInformer)
+}
+
+func (f *iPAddressAllocationInformer) Lister() apiv1alpha1.IPAddressAllocationLister {
+	return apiv1alpha1.NewIPAddressAllocationLister(f.Informer().GetIndexer())
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// FoundationLoadBalancerConfigLister helps list FoundationLoadBalancerConfigs.
+// All objects returned here must be treated as read-only.
+type FoundationLoadBalancerConfigLister interface {
+	// List lists all FoundationLoadBalancerConfigs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha1.FoundationLoadBalancerConfig, err error)
+	// FoundationLoadBalancerConfigs returns an object that can list and get FoundationLoadBalancerConfigs.
+	FoundationLoadBalancerConfigs(namespace string) FoundationLoadBalancerConfigNamespaceLister
+	FoundationLoadBalancerConfigListerExpansion
+}
+
+// foundationLoadBalancerConfigLister implements the FoundationLoadBalancerConfigLister interface.
+type foundationLoadBalancerConfigLister struct {
+	listers.ResourceIndexer[*apiv1alpha1.FoundationLoadBalancerConfig]
+}
+
+// NewFoundationLoadBalancerConfigLister returns a new FoundationLoadBalancerConfigLister.
+func NewFoundationLoadBalancerConfigLister(indexer cache.Indexer) FoundationLoadBalancerConfigLister {
+	return &foundationLoadBalancerConfigLister{listers.New[*apiv1alpha1.FoundationLoadBalancerConfig](indexer, apiv1alpha1.Resource("foundationloadbalancerconfig"))}
+}
+
+// FoundationLoadBalancerConfigs returns an object that can list and get FoundationLoadBalancerConfigs.
+func (s *foundationLoadBalancerConfigLister) FoundationLoadBalancerConfigs(namespace string) FoundationLoadBalancerConfigNamespaceLister {
+	return foundationLoadBalancerConfigNamespaceLister{listers.NewNamespaced[*apiv1alpha1.FoundationLoadBalancerConfig](s.ResourceIndexer, namespace)}
+}
+
+// FoundationLoadBalancerConfigNamespaceLister helps list and get FoundationLoadBalancerConfigs.
+// All objects returned here must be treated as read-only.
+type FoundationLoadBalancerConfigNamespaceLister interface {
+	// List lists all FoundationLoadBalancerConfigs in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha1.FoundationLoadBalancerConfig, err error)
+	// Get retrieves the FoundationLoadBalancerConfig from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1alpha1.FoundationLoadBalancerConfig, error)
+	FoundationLoadBalancerConfigNamespaceListerExpansion
+}
+
+// foundationLoadBalancerConfigNamespaceLister implements the FoundationLoadBalancerConfigNamespaceLister
+// interface.
+type foundationLoadBalancerConfigNamespaceLister struct {
+	listers.ResourceIndexer[*apiv1alpha1.FoundationLoadBalancerConfig]
+}
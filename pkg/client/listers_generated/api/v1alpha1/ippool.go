@@ -0,0 +1,36 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// IPPoolLister helps list IPPools.
+// All objects returned here must be treated as read-only.
+type IPPoolLister interface {
+	// List lists all IPPools in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha1.IPPool, err error)
+	// Get retrieves the IPPool from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1alpha1.IPPool, error)
+	IPPoolListerExpansion
+}
+
+// iPPoolLister implements the IPPoolLister interface.
+type iPPoolLister struct {
+	listers.ResourceIndexer[*apiv1alpha1.IPPool]
+}
+
+// NewIPPoolLister returns a new IPPoolLister.
+func NewIPPoolLister(indexer cache.Indexer) IPPoolLister {
+	return &iPPoolLister{listers.New[*apiv1alpha1.IPPool](indexer, apiv1alpha1.Resource("ippool"))}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	apiv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// WorkloadNetworkConfigurationLister helps list WorkloadNetworkConfigurations.
+// All objects returned here must be treated as read-only.
+type WorkloadNetworkConfigurationLister interface {
+	// List lists all WorkloadNetworkConfigurations in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha1.WorkloadNetworkConfiguration, err error)
+	// Get retrieves the WorkloadNetworkConfiguration from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1alpha1.WorkloadNetworkConfiguration, error)
+	WorkloadNetworkConfigurationListerExpansion
+}
+
+// workloadNetworkConfigurationLister implements the WorkloadNetworkConfigurationLister interface.
+type workloadNetworkConfigurationLister struct {
+	listers.ResourceIndexer[*apiv1alpha1.WorkloadNetworkConfiguration]
+}
+
+// NewWorkloadNetworkConfigurationLister returns a new WorkloadNetworkConfigurationLister.
+func NewWorkloadNetworkConfigurationLister(indexer cache.Indexer) WorkloadNetworkConfigurationLister {
+	return &workloadNetworkConfigurationLister{listers.New[*apiv1alpha1.WorkloadNetworkConfiguration](indexer, apiv1alpha1.Resource("workloadnetworkconfiguration"))}
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// AviLoadBalancerConfigLister helps list AviLoadBalancerConfigs.
+// All objects returned here must be treated as read-only.
+type AviLoadBalancerConfigLister interface {
+	// List lists all AviLoadBalancerConfigs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha2.AviLoadBalancerConfig, err error)
+	// Get retrieves the AviLoadBalancerConfig from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1alpha2.AviLoadBalancerConfig, error)
+	AviLoadBalancerConfigListerExpansion
+}
+
+// aviLoadBalancerConfigLister implements the AviLoadBalancerConfigLister interface.
+type aviLoadBalancerConfigLister struct {
+	listers.ResourceIndexer[*apiv1alpha2.AviLoadBalancerConfig]
+}
+
+// NewAviLoadBalancerConfigLister returns a new AviLoadBalancerConfigLister.
+func NewAviLoadBalancerConfigLister(indexer cache.Indexer) AviLoadBalancerConfigLister {
+	return &aviLoadBalancerConfigLister{listers.New[*apiv1alpha2.AviLoadBalancerConfig](indexer, apiv1alpha2.Resource("aviloadbalancerconfig"))}
+}
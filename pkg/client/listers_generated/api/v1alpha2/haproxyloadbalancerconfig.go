@@ -0,0 +1,36 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// HAProxyLoadBalancerConfigLister helps list HAProxyLoadBalancerConfigs.
+// All objects returned here must be treated as read-only.
+type HAProxyLoadBalancerConfigLister interface {
+	// List lists all HAProxyLoadBalancerConfigs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha2.HAProxyLoadBalancerConfig, err error)
+	// Get retrieves the HAProxyLoadBalancerConfig from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1alpha2.HAProxyLoadBalancerConfig, error)
+	HAProxyLoadBalancerConfigListerExpansion
+}
+
+// hAProxyLoadBalancerConfigLister implements the HAProxyLoadBalancerConfigLister interface.
+type hAProxyLoadBalancerConfigLister struct {
+	listers.ResourceIndexer[*apiv1alpha2.HAProxyLoadBalancerConfig]
+}
+
+// NewHAProxyLoadBalancerConfigLister returns a new HAProxyLoadBalancerConfigLister.
+func NewHAProxyLoadBalancerConfigLister(indexer cache.Indexer) HAProxyLoadBalancerConfigLister {
+	return &hAProxyLoadBalancerConfigLister{listers.New[*apiv1alpha2.HAProxyLoadBalancerConfig](indexer, apiv1alpha2.Resource("haproxyloadbalancerconfig"))}
+}
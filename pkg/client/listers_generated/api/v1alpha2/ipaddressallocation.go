@@ -0,0 +1,58 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// IPAddressAllocationLister helps list IPAddressAllocations.
+// All objects returned here must be treated as read-only.
+type IPAddressAllocationLister interface {
+	// List lists all IPAddressAllocations in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha2.IPAddressAllocation, err error)
+	// IPAddressAllocations returns an object that can list and get IPAddressAllocations.
+	IPAddressAllocations(namespace string) IPAddressAllocationNamespaceLister
+	IPAddressAllocationListerExpansion
+}
+
+// iPAddressAllocationLister implements the IPAddressAllocationLister interface.
+type iPAddressAllocationLister struct {
+	listers.ResourceIndexer[*apiv1alpha2.IPAddressAllocation]
+}
+
+// NewIPAddressAllocationLister returns a new IPAddressAllocationLister.
+func NewIPAddressAllocationLister(indexer cache.Indexer) IPAddressAllocationLister {
+	return &iPAddressAllocationLister{listers.New[*apiv1alpha2.IPAddressAllocation](indexer, apiv1alpha2.Resource("ipaddressallocation"))}
+}
+
+// IPAddressAllocations returns an object that can list and get IPAddressAllocations.
+func (s *iPAddressAllocationLister) IPAddressAllocations(namespace string) IPAddressAllocationNamespaceLister {
+	return iPAddressAllocationNamespaceLister{listers.NewNamespaced[*apiv1alpha2.IPAddressAllocation](s.ResourceIndexer, namespace)}
+}
+
+// IPAddressAllocationNamespaceLister helps list and get IPAddressAllocations.
+// All objects returned here must be treated as read-only.
+type IPAddressAllocationNamespaceLister interface {
+	// List lists all IPAddressAllocations in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha2.IPAddressAllocation, err error)
+	// Get retrieves the IPAddressAllocation from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1alpha2.IPAddressAllocation, error)
+	IPAddressAllocationNamespaceListerExpansion
+}
+
+// iPAddressAllocationNamespaceLister implements the IPAddressAllocationNamespaceLister
+// interface.
+type iPAddressAllocationNamespaceLister struct {
+	listers.ResourceIndexer[*apiv1alpha2.IPAddressAllocation]
+}
@@ -0,0 +1,36 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// LoadBalancerConfigLister helps list LoadBalancerConfigs.
+// All objects returned here must be treated as read-only.
+type LoadBalancerConfigLister interface {
+	// List lists all LoadBalancerConfigs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha2.LoadBalancerConfig, err error)
+	// Get retrieves the LoadBalancerConfig from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1alpha2.LoadBalancerConfig, error)
+	LoadBalancerConfigListerExpansion
+}
+
+// loadBalancerConfigLister implements the LoadBalancerConfigLister interface.
+type loadBalancerConfigLister struct {
+	listers.ResourceIndexer[*apiv1alpha2.LoadBalancerConfig]
+}
+
+// NewLoadBalancerConfigLister returns a new LoadBalancerConfigLister.
+func NewLoadBalancerConfigLister(indexer cache.Indexer) LoadBalancerConfigLister {
+	return &loadBalancerConfigLister{listers.New[*apiv1alpha2.LoadBalancerConfig](indexer, apiv1alpha2.Resource("loadbalancerconfig"))}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// NetworkLister helps list Networks.
+// All objects returned here must be treated as read-only.
+type NetworkLister interface {
+	// List lists all Networks in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha2.Network, err error)
+	// Networks returns an object that can list and get Networks.
+	Networks(namespace string) NetworkNamespaceLister
+	NetworkListerExpansion
+}
+
+// networkLister implements the NetworkLister interface.
+type networkLister struct {
+	listers.ResourceIndexer[*apiv1alpha2.Network]
+}
+
+// NewNetworkLister returns a new NetworkLister.
+func NewNetworkLister(indexer cache.Indexer) NetworkLister {
+	return &networkLister{listers.New[*apiv1alpha2.Network](indexer, apiv1alpha2.Resource("network"))}
+}
+
+// Networks returns an object that can list and get Networks.
+func (s *networkLister) Networks(namespace string) NetworkNamespaceLister {
+	return networkNamespaceLister{listers.NewNamespaced[*apiv1alpha2.Network](s.ResourceIndexer, namespace)}
+}
+
+// NetworkNamespaceLister helps list and get Networks.
+// All objects returned here must be treated as read-only.
+type NetworkNamespaceLister interface {
+	// List lists all Networks in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha2.Network, err error)
+	// Get retrieves the Network from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1alpha2.Network, error)
+	NetworkNamespaceListerExpansion
+}
+
+// networkNamespaceLister implements the NetworkNamespaceLister
+// interface.
+type networkNamespaceLister struct {
+	listers.ResourceIndexer[*apiv1alpha2.Network]
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// NetworkInterfaceLister helps list NetworkInterfaces.
+// All objects returned here must be treated as read-only.
+type NetworkInterfaceLister interface {
+	// List lists all NetworkInterfaces in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha2.NetworkInterface, err error)
+	// NetworkInterfaces returns an object that can list and get NetworkInterfaces.
+	NetworkInterfaces(namespace string) NetworkInterfaceNamespaceLister
+	NetworkInterfaceListerExpansion
+}
+
+// networkInterfaceLister implements the NetworkInterfaceLister interface.
+type networkInterfaceLister struct {
+	listers.ResourceIndexer[*apiv1alpha2.NetworkInterface]
+}
+
+// NewNetworkInterfaceLister returns a new NetworkInterfaceLister.
+func NewNetworkInterfaceLister(indexer cache.Indexer) NetworkInterfaceLister {
+	return &networkInterfaceLister{listers.New[*apiv1alpha2.NetworkInterface](indexer, apiv1alpha2.Resource("networkinterface"))}
+}
+
+// NetworkInterfaces returns an object that can list and get NetworkInterfaces.
+func (s *networkInterfaceLister) NetworkInterfaces(namespace string) NetworkInterfaceNamespaceLister {
+	return networkInterfaceNamespaceLister{listers.NewNamespaced[*apiv1alpha2.NetworkInterface](s.ResourceIndexer, namespace)}
+}
+
+// NetworkInterfaceNamespaceLister helps list and get NetworkInterfaces.
+// All objects returned here must be treated as read-only.
+type NetworkInterfaceNamespaceLister interface {
+	// List lists all NetworkInterfaces in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha2.NetworkInterface, err error)
+	// Get retrieves the NetworkInterface from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1alpha2.NetworkInterface, error)
+	NetworkInterfaceNamespaceListerExpansion
+}
+
+// networkInterfaceNamespaceLister implements the NetworkInterfaceNamespaceLister
+// interface.
+type networkInterfaceNamespaceLister struct {
+	listers.ResourceIndexer[*apiv1alpha2.NetworkInterface]
+}
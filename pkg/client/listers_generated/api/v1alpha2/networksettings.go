@@ -0,0 +1,58 @@
+// Copyright (c) 2020-2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha2
+
+import (
+	apiv1alpha2 "github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// NetworkSettingsLister helps list NetworkSettingses.
+// All objects returned here must be treated as read-only.
+type NetworkSettingsLister interface {
+	// List lists all NetworkSettingses in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha2.NetworkSettings, err error)
+	// NetworkSettingses returns an object that can list and get NetworkSettingses.
+	NetworkSettingses(namespace string) NetworkSettingsNamespaceLister
+	NetworkSettingsListerExpansion
+}
+
+// networkSettingsLister implements the NetworkSettingsLister interface.
+type networkSettingsLister struct {
+	listers.ResourceIndexer[*apiv1alpha2.NetworkSettings]
+}
+
+// NewNetworkSettingsLister returns a new NetworkSettingsLister.
+func NewNetworkSettingsLister(indexer cache.Indexer) NetworkSettingsLister {
+	return &networkSettingsLister{listers.New[*apiv1alpha2.NetworkSettings](indexer, apiv1alpha2.Resource("networksettings"))}
+}
+
+// NetworkSettingses returns an object that can list and get NetworkSettingses.
+func (s *networkSettingsLister) NetworkSettingses(namespace string) NetworkSettingsNamespaceLister {
+	return networkSettingsNamespaceLister{listers.NewNamespaced[*apiv1alpha2.NetworkSettings](s.ResourceIndexer, namespace)}
+}
+
+// NetworkSettingsNamespaceLister helps list and get NetworkSettingses.
+// All objects returned here must be treated as read-only.
+type NetworkSettingsNamespaceLister interface {
+	// List lists all NetworkSettingses in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha2.NetworkSettings, err error)
+	// Get retrieves the NetworkSettings from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1alpha2.NetworkSettings, error)
+	NetworkSettingsNamespaceListerExpansion
+}
+
+// networkSettingsNamespaceLister implements the NetworkSettingsNamespaceLister
+// interface.
+type networkSettingsNamespaceLister struct {
+	listers.ResourceIndexer[*apiv1alpha2.NetworkSettings]
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Package conditions provides helpers to read and update the conditions of
+// the netoperator.vmware.com kinds. The helpers work with metav1.Condition,
+// which every kind uses from v1alpha2 on, and with the per-kind condition types
+// of v1alpha1, such as NetworkInterfaceCondition:
+//
+//	conditions.MarkTrue(&nif.Status.Conditions, v1alpha1.NetworkInterfaceReady, "", "")
+//	if conditions.IsTrue(nif.Status.Conditions, v1alpha1.NetworkInterfaceReady) {
+//		...
+//	}
+//
+// Setting a condition keeps its LastTransitionTime unless its status changes.
+package conditions
+
+import (
+	"reflect"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition is the set of condition types of the netoperator.vmware.com API.
+type Condition interface {
+	metav1.Condition |
+		v1alpha1.NetworkCondition |
+		v1alpha1.NetworkInterfaceCondition |
+		v1alpha1.IPPoolCondition |
+		v1alpha1.IPAddressAllocationCondition |
+		v1alpha1.VSphereDistributedNetworkCondition |
+		v1alpha1.LoadBalancerConfigCondition
+}
+
+// Get returns the condition of the given type, or nil if conditions has none.
+// The result points into conditions.
+func Get[T Condition, K ~string](conditions []T, conditionType K) *T {
+	for i := range conditions {
+		if fieldsOf(&conditions[i]).get("Type") == string(conditionType) {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// IsTrue reports whether conditions has a condition of the given type with
+// status True.
+func IsTrue[T Condition, K ~string](conditions []T, conditionType K) bool {
+	return hasStatus(conditions, conditionType, metav1.ConditionTrue)
+}
+
+// IsFalse reports whether conditions has a condition of the given type with
+// status False.
+func IsFalse[T Condition, K ~string](conditions []T, conditionType K) bool {
+	return hasStatus(conditions, conditionType, metav1.ConditionFalse)
+}
+
+// Set adds condition to conditions, or replaces the condition of the same type.
+// If the status of the condition does not change, its LastTransitionTime is
+// kept and the one of condition is ignored. Otherwise the LastTransitionTime
+// of condition is used, or the current time if it is zero. Set reports
+// whether conditions changed.
+func Set[T Condition](conditions *[]T, condition T) bool {
+	f := fieldsOf(&condition)
+	existing := Get(*conditions, f.get("Type"))
+	if existing == nil {
+		if f.transitionTime().Time.IsZero() {
+			f.setTransitionTime(metav1.Now())
+		}
+		*conditions = append(*conditions, condition)
+		return true
+	}
+
+	e := fieldsOf(existing)
+	switch {
+	case e.get("Status") == f.get("Status"):
+		f.setTransitionTime(e.transitionTime())
+	case f.transitionTime().Time.IsZero():
+		f.setTransitionTime(metav1.Now())
+	}
+	if reflect.DeepEqual(*existing, condition) {
+		return false
+	}
+	*existing = condition
+	return true
+}
+
+// MarkTrue sets the condition of the given type to True with the reason and
+// message. See Set.
+func MarkTrue[T Condition, K, R ~string](conditions *[]T, conditionType K, reason R, message string) bool {
+	return mark(conditions, conditionType, metav1.ConditionTrue, reason, message)
+}
+
+// MarkFalse sets the condition of the given type to False with the reason and
+// message. See Set.
+func MarkFalse[T Condition, K, R ~string](conditions *[]T, conditionType K, reason R, message string) bool {
+	return mark(conditions, conditionType, metav1.ConditionFalse, reason, message)
+}
+
+func mark[T Condition, K, R ~string](conditions *[]T, conditionType K, status metav1.ConditionStatus, reason R, message string) bool {
+	var condition T
+	f := fieldsOf(&condition)
+	f.set("Type", string(conditionType))
+	f.set("Status", string(status))
+	f.set("Reason", string(reason))
+	f.set("Message", message)
+	if existing := Get(*conditions, conditionType); existing != nil {
+		// Only metav1.Condition has an ObservedGeneration, which MarkTrue and
+		// MarkFalse do not know about, so keep the one of the existing condition.
+		if g := fieldsOf(existing).v.FieldByName("ObservedGeneration"); g.IsValid() {
+			f.v.FieldByName("ObservedGeneration").Set(g)
+		}
+	}
+	return Set(conditions, condition)
+}
+
+func hasStatus[T Condition, K ~string](conditions []T, conditionType K, status metav1.ConditionStatus) bool {
+	c := Get(conditions, conditionType)
+	return c != nil && fieldsOf(c).get("Status") == string(status)
+}
+
+// fields accesses the fields of a condition. The types in Condition all have
+// string-kinded Type, Status, Reason and Message fields, and all but
+// IPPoolCondition have a LastTransitionTime.
+type fields struct {
+	v reflect.Value
+}
+
+func fieldsOf[T Condition](c *T) fields {
+	return fields{v: reflect.ValueOf(c).Elem()}
+}
+
+func (f fields) get(name string) string {
+	return f.v.FieldByName(name).String()
+}
+
+func (f fields) set(name, value string) {
+	f.v.FieldByName(name).SetString(value)
+}
+
+func (f fields) transitionTime() metav1.Time {
+	if t := f.v.FieldByName("LastTransitionTime"); t.IsValid() {
+		return t.Interface().(metav1.Time)
+	}
+	return metav1.Time{}
+}
+
+func (f fields) setTransitionTime(value metav1.Time) {
+	if t := f.v.FieldByName("LastTransitionTime"); t.IsValid() {
+		t.Set(reflect.ValueOf(value))
+	}
+}
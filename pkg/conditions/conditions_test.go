@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package conditions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/conditions"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var past = metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+
+func TestMetav1Condition(t *testing.T) {
+	var conds []metav1.Condition
+	if conditions.IsTrue(conds, "Ready") || conditions.IsFalse(conds, "Ready") {
+		t.Fatal("expected a missing condition to be neither True nor False")
+	}
+
+	if !conditions.Set(&conds, metav1.Condition{Type: "Ready", Status: metav1.ConditionFalse, Reason: "Pending", ObservedGeneration: 2}) {
+		t.Fatal("expected Set to report a change when adding a condition")
+	}
+	ready := conditions.Get(conds, "Ready")
+	if ready == nil || ready.LastTransitionTime.IsZero() {
+		t.Fatalf("expected the added condition to get a LastTransitionTime, got %+v", ready)
+	}
+	ready.LastTransitionTime = past
+
+	// Same status: the transition time is kept, the reason is updated.
+	if !conditions.MarkFalse(&conds, "Ready", "Failed", "boom") {
+		t.Fatal("expected MarkFalse to report a changed reason")
+	}
+	ready = conditions.Get(conds, "Ready")
+	if !ready.LastTransitionTime.Equal(&past) || ready.Reason != "Failed" || ready.Message != "boom" {
+		t.Errorf("expected the transition time to be kept and the reason updated, got %+v", ready)
+	}
+	if ready.ObservedGeneration != 2 {
+		t.Errorf("expected MarkFalse to keep the observedGeneration, got %d", ready.ObservedGeneration)
+	}
+	if conditions.MarkFalse(&conds, "Ready", "Failed", "boom") {
+		t.Error("expected MarkFalse to report no change")
+	}
+
+	// Status change: a new transition time.
+	if !conditions.MarkTrue(&conds, "Ready", "Realized", "") {
+		t.Fatal("expected MarkTrue to report a change")
+	}
+	ready = conditions.Get(conds, "Ready")
+	if ready.LastTransitionTime.Equal(&past) {
+		t.Error("expected the transition time to change with the status")
+	}
+	if !conditions.IsTrue(conds, "Ready") {
+		t.Error("expected Ready to be True")
+	}
+	if len(conds) != 1 {
+		t.Errorf("expected a single condition, got %d", len(conds))
+	}
+}
+
+func TestSet_ExplicitTransitionTime(t *testing.T) {
+	conds := []metav1.Condition{{Type: "Ready", Status: metav1.ConditionFalse, LastTransitionTime: past}}
+	later := metav1.NewTime(past.Add(time.Hour))
+
+	conditions.Set(&conds, metav1.Condition{Type: "Ready", Status: metav1.ConditionFalse, LastTransitionTime: later})
+	if got := conds[0].LastTransitionTime; !got.Equal(&past) {
+		t.Errorf("expected the transition time to be kept when the status is unchanged, got %v", got)
+	}
+	conditions.Set(&conds, metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue, LastTransitionTime: later})
+	if got := conds[0].LastTransitionTime; !got.Equal(&later) {
+		t.Errorf("expected the given transition time when the status changes, got %v", got)
+	}
+}
+
+func TestLegacyConditions(t *testing.T) {
+	t.Run("NetworkInterfaceCondition", func(t *testing.T) {
+		var conds []v1alpha1.NetworkInterfaceCondition
+		conditions.MarkFalse(&conds, v1alpha1.NetworkInterfaceFailure, v1alpha1.NetworkInterfaceFailureReasonCannotAllocIP, "pool is full")
+		conds[0].LastTransitionTime = past
+
+		conditions.MarkFalse(&conds, v1alpha1.NetworkInterfaceFailure, v1alpha1.NetworkInterfaceFailureReasonCannotAllocIP, "still full")
+		failure := conditions.Get(conds, v1alpha1.NetworkInterfaceFailure)
+		if failure.Status != corev1.ConditionFalse || failure.Message != "still full" || !failure.LastTransitionTime.Equal(&past) {
+			t.Errorf("unexpected condition %+v", failure)
+		}
+
+		conditions.MarkTrue(&conds, v1alpha1.NetworkInterfaceReady, "", "")
+		if !conditions.IsTrue(conds, v1alpha1.NetworkInterfaceReady) || !conditions.IsFalse(conds, v1alpha1.NetworkInterfaceFailure) {
+			t.Errorf("unexpected conditions %+v", conds)
+		}
+	})
+
+	t.Run("IPPoolCondition", func(t *testing.T) {
+		// IPPoolCondition has no LastTransitionTime.
+		var conds []v1alpha1.IPPoolCondition
+		if !conditions.MarkTrue(&conds, v1alpha1.IPPoolReady, "", "") {
+			t.Fatal("expected MarkTrue to report a change")
+		}
+		if conditions.MarkTrue(&conds, v1alpha1.IPPoolReady, "", "") {
+			t.Error("expected MarkTrue to report no change")
+		}
+		if !conditions.IsTrue(conds, v1alpha1.IPPoolReady) {
+			t.Errorf("unexpected conditions %+v", conds)
+		}
+	})
+
+	t.Run("IPAddressAllocationCondition", func(t *testing.T) {
+		conds := []v1alpha1.IPAddressAllocationCondition{
+			{Type: v1alpha1.IPAddressAllocationReady, Status: corev1.ConditionTrue, LastTransitionTime: past},
+		}
+		conditions.MarkFalse(&conds, v1alpha1.IPAddressAllocationReady, v1alpha1.IPAddressAllocationConditionFailureReasonCannotAllocIP, "")
+		ready := conditions.Get(conds, v1alpha1.IPAddressAllocationReady)
+		if ready.Status != corev1.ConditionFalse || ready.Reason != v1alpha1.IPAddressAllocationConditionFailureReasonCannotAllocIP || ready.LastTransitionTime.Equal(&past) {
+			t.Errorf("unexpected condition %+v", ready)
+		}
+	})
+}
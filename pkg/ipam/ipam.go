@@ -0,0 +1,360 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Package ipam is an in-memory reference implementation of net-operator's IP
+// address management. It picks the IPPool and the address for a
+// NetworkInterface or an IPAddressAllocation the way net-operator does, so
+// alternative network providers and test stand-ins behave alike:
+//
+//	allocator, err := ipam.NewAllocator(pools.Items...)
+//	if err != nil {
+//		...
+//	}
+//	allocations, err := allocator.Allocate(ipam.NetworkInterfaceRequest(nif, vds.Spec.IPPools))
+//	if errors.Is(err, v1alpha1.ErrCannotAllocIP) {
+//		// The pools of the network are exhausted.
+//	}
+//
+// The pools are chosen by usage. A pool is labeled with IPPoolUsageLabelKeyName,
+// and is a general pool if it has no such label. A requester labeled with a
+// usage other than general is given an address from the pools of that usage,
+// and from the general pools once those are exhausted, unless it is annotated
+// with IPPoolUsageAnnotationStrictKeyName. Any other requester is only given
+// addresses from the general pools. Within the same usage, pools are tried in
+// the order of the request, and the lowest free address of a pool is taken.
+//
+// Requests made for a GatewayClass annotated with
+// IPAMDisabledAnnotationKeyName are not given any address.
+package ipam
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/iprange"
+	"github.com/vmware-tanzu/net-operator-api/pkg/ipset"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Request is a request for addresses.
+type Request struct {
+	// Owner identifies the requester. Allocating again for the same owner
+	// returns the addresses it already has, and Release frees them.
+	Owner string
+	// Labels and Annotations are those of the requester. They select the
+	// pools by usage.
+	Labels      map[string]string
+	Annotations map[string]string
+	// IPFamilyPolicy is the families to allocate an address of. If empty, an
+	// address is allocated for every family of the candidate pools.
+	IPFamilyPolicy v1alpha1.NetworkInterfaceIPFamilyPolicy
+	// RequestedIP is the address to allocate. If set, only this address is
+	// allocated, regardless of IPFamilyPolicy.
+	RequestedIP string
+	// Pools are the pools to allocate from, in order, typically those of the
+	// network of the requester. If nil, every pool of the allocator is used,
+	// in the order of their names.
+	Pools []v1alpha1.IPPoolReference
+	// GatewayClass is the GatewayClass the request is made for, if any.
+	GatewayClass metav1.Object
+}
+
+// NetworkInterfaceRequest returns the request for the addresses of nif from
+// pools.
+func NetworkInterfaceRequest(nif *v1alpha1.NetworkInterface, pools []v1alpha1.IPPoolReference) Request {
+	return Request{
+		Owner:          "NetworkInterface " + nif.Namespace + "/" + nif.Name,
+		Labels:         nif.Labels,
+		Annotations:    nif.Annotations,
+		IPFamilyPolicy: nif.Spec.IPFamilyPolicy,
+		Pools:          pools,
+	}
+}
+
+// IPAddressAllocationRequest returns the request for the address of alloc from
+// pools, which are those of the network referenced by its PoolRef. A single
+// address is allocated, of the family of the first candidate pool with a free
+// address unless alloc requests a specific address.
+func IPAddressAllocationRequest(alloc *v1alpha1.IPAddressAllocation, pools []v1alpha1.IPPoolReference) Request {
+	return Request{
+		Owner:       "IPAddressAllocation " + alloc.Namespace + "/" + alloc.Name,
+		Labels:      alloc.Labels,
+		Annotations: alloc.Annotations,
+		// The first family with a free address is the one allocated.
+		IPFamilyPolicy: ipFamilyPolicyAny,
+		RequestedIP:    alloc.Spec.RequestedIP,
+		Pools:          pools,
+	}
+}
+
+// ipFamilyPolicyAny allocates a single address of any family.
+const ipFamilyPolicyAny v1alpha1.NetworkInterfaceIPFamilyPolicy = "any"
+
+// Allocation is an address allocated from a pool.
+type Allocation struct {
+	// Pool is the name of the IPPool.
+	Pool string
+	Addr netip.Addr
+}
+
+// IPAMDisabled reports whether obj, a GatewayClass, does not participate in
+// net-operator's IPAM.
+func IPAMDisabled(obj metav1.Object) bool {
+	if obj == nil {
+		return false
+	}
+	_, ok := obj.GetAnnotations()[v1alpha1.IPAMDisabledAnnotationKeyName]
+	return ok
+}
+
+// Allocator allocates addresses from a fixed set of IPPools. It is safe for
+// concurrent use.
+type Allocator struct {
+	mu     sync.Mutex
+	pools  map[string]*pool
+	names  []string
+	owners map[string][]Allocation
+}
+
+type pool struct {
+	rng       iprange.Range
+	usage     v1alpha1.IPPoolUsageLabelValue
+	allocated map[netip.Addr]string
+}
+
+// NewAllocator returns an allocator for pools. It fails if a pool has an
+// invalid range or if two pools overlap.
+func NewAllocator(pools ...v1alpha1.IPPool) (*Allocator, error) {
+	a := &Allocator{pools: map[string]*pool{}, owners: map[string][]Allocation{}}
+	var all ipset.Set
+	for i := range pools {
+		p := &pools[i]
+		if _, ok := a.pools[p.Name]; ok {
+			return nil, fmt.Errorf("duplicate IPPool %s", p.Name)
+		}
+		rng, err := p.Spec.Range()
+		if err != nil {
+			return nil, fmt.Errorf("invalid IPPool %s: %w", p.Name, err)
+		}
+		s := ipset.Of(rng)
+		if all.Overlaps(s) {
+			return nil, fmt.Errorf("IPPool %s overlaps another IPPool at %s", p.Name, all.Intersect(s))
+		}
+		all = all.Union(s)
+		usage := v1alpha1.IPPoolUsageLabelValue(p.Labels[v1alpha1.IPPoolUsageLabelKeyName])
+		if usage == "" {
+			usage = v1alpha1.IPPoolUsageLabelGeneralValue
+		}
+		a.pools[p.Name] = &pool{rng: rng, usage: usage, allocated: map[netip.Addr]string{}}
+		a.names = append(a.names, p.Name)
+	}
+	slices.Sort(a.names)
+	return a, nil
+}
+
+// Allocate allocates the addresses of req. It returns the addresses req.Owner
+// already has if any, and nothing if req.GatewayClass has IPAM disabled.
+//
+// The errors wrap the errors of the v1alpha1 package for the Failure reasons:
+// v1alpha1.ErrIPPoolRefRetrievalFailed if a pool of req is not known,
+// v1alpha1.ErrInvalidRequestedIP if req.RequestedIP is not a free address of a
+// candidate pool, v1alpha1.ErrUnsupportedIPFamilyPolicy if no candidate pool
+// is of a family required by req.IPFamilyPolicy, and v1alpha1.ErrCannotAllocIP
+// if the candidate pools of a family are exhausted. Nothing is allocated when
+// an error is returned.
+func (a *Allocator) Allocate(req Request) ([]Allocation, error) {
+	if IPAMDisabled(req.GatewayClass) {
+		return nil, nil
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if allocations, ok := a.owners[req.Owner]; ok {
+		return slices.Clone(allocations), nil
+	}
+	candidates, err := a.candidates(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("%w: no IPPool available", v1alpha1.ErrCannotAllocIP)
+	}
+
+	var allocations []Allocation
+	if req.RequestedIP != "" {
+		allocation, err := a.allocateRequested(req.RequestedIP, candidates)
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, allocation)
+	} else {
+		families, err := requiredFamilies(req.IPFamilyPolicy, candidates, a.pools)
+		if err != nil {
+			return nil, err
+		}
+		for _, family := range families {
+			allocation, ok := a.allocateFree(family, candidates)
+			if !ok {
+				return nil, fmt.Errorf("%w: no free %saddress in IPPools %s", v1alpha1.ErrCannotAllocIP, familyPrefix(family), strings.Join(candidates, ", "))
+			}
+			allocations = append(allocations, allocation)
+		}
+	}
+
+	for _, allocation := range allocations {
+		a.pools[allocation.Pool].allocated[allocation.Addr] = req.Owner
+	}
+	a.owners[req.Owner] = allocations
+	return slices.Clone(allocations), nil
+}
+
+// Release frees the addresses of owner.
+func (a *Allocator) Release(owner string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, allocation := range a.owners[owner] {
+		delete(a.pools[allocation.Pool].allocated, allocation.Addr)
+	}
+	delete(a.owners, owner)
+}
+
+// Allocated returns the number of addresses allocated from the pool name, as
+// reported in the Allocated field of its status.
+func (a *Allocator) Allocated(name string) int64 {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if p, ok := a.pools[name]; ok {
+		return int64(len(p.allocated))
+	}
+	return 0
+}
+
+// candidates returns the names of the pools of req in the order they are
+// tried: those of the usage of the requester, then the general ones if the
+// requester may fall back to them.
+func (a *Allocator) candidates(req Request) ([]string, error) {
+	names := a.names
+	if req.Pools != nil {
+		names = make([]string, 0, len(req.Pools))
+		for _, ref := range req.Pools {
+			if _, ok := a.pools[ref.Name]; !ok {
+				return nil, fmt.Errorf("%w: IPPool %s not found", v1alpha1.ErrIPPoolRefRetrievalFailed, ref.Name)
+			}
+			names = append(names, ref.Name)
+		}
+	}
+
+	usage := v1alpha1.IPPoolUsageLabelValue(req.Labels[v1alpha1.IPPoolUsageLabelKeyName])
+	if usage == "" {
+		usage = v1alpha1.IPPoolUsageLabelGeneralValue
+	}
+	_, strict := req.Annotations[v1alpha1.IPPoolUsageAnnotationStrictKeyName]
+
+	var candidates, general []string
+	for _, name := range names {
+		switch a.pools[name].usage {
+		case usage:
+			candidates = append(candidates, name)
+		case v1alpha1.IPPoolUsageLabelGeneralValue:
+			general = append(general, name)
+		}
+	}
+	if !strict {
+		candidates = append(candidates, general...)
+	}
+	return candidates, nil
+}
+
+// requiredFamilies returns the families to allocate an address of for policy,
+// with "" standing for any family.
+func requiredFamilies(policy v1alpha1.NetworkInterfaceIPFamilyPolicy, candidates []string, pools map[string]*pool) ([]corev1.IPFamily, error) {
+	var available []corev1.IPFamily
+	for _, name := range candidates {
+		if family := familyOf(pools[name].rng.First); !slices.Contains(available, family) {
+			available = append(available, family)
+		}
+	}
+	// IPv4 is allocated first.
+	slices.Sort(available)
+
+	var required []corev1.IPFamily
+	switch policy {
+	case "":
+		return available, nil
+	case ipFamilyPolicyAny:
+		return []corev1.IPFamily{""}, nil
+	case v1alpha1.NetworkInterfaceIPFamilyPolicyIPv4Only:
+		required = []corev1.IPFamily{corev1.IPv4Protocol}
+	case v1alpha1.NetworkInterfaceIPFamilyPolicyIPv6Only:
+		required = []corev1.IPFamily{corev1.IPv6Protocol}
+	case v1alpha1.NetworkInterfaceIPFamilyPolicyDualStack:
+		required = []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol}
+	default:
+		return nil, fmt.Errorf("%w: unknown IPFamilyPolicy %s", v1alpha1.ErrUnsupportedIPFamilyPolicy, policy)
+	}
+	for _, family := range required {
+		if !slices.Contains(available, family) {
+			return nil, fmt.Errorf("%w: %s requires an %s IPPool", v1alpha1.ErrUnsupportedIPFamilyPolicy, policy, family)
+		}
+	}
+	return required, nil
+}
+
+func (a *Allocator) allocateRequested(requestedIP string, candidates []string) (Allocation, error) {
+	addr, err := netip.ParseAddr(requestedIP)
+	if err != nil {
+		return Allocation{}, fmt.Errorf("%w: %w", v1alpha1.ErrInvalidRequestedIP, err)
+	}
+	for _, name := range candidates {
+		p := a.pools[name]
+		if !p.rng.Contains(addr) {
+			continue
+		}
+		if owner, ok := p.allocated[addr]; ok {
+			return Allocation{}, fmt.Errorf("%w: %s is already allocated to %s", v1alpha1.ErrInvalidRequestedIP, addr, owner)
+		}
+		return Allocation{Pool: name, Addr: addr}, nil
+	}
+	return Allocation{}, fmt.Errorf("%w: %s is not in IPPools %s", v1alpha1.ErrInvalidRequestedIP, addr, strings.Join(candidates, ", "))
+}
+
+// allocateFree returns the lowest free address of family, "" for any, in the
+// first candidate pool that has one.
+func (a *Allocator) allocateFree(family corev1.IPFamily, candidates []string) (Allocation, bool) {
+	for _, name := range candidates {
+		p := a.pools[name]
+		if family != "" && familyOf(p.rng.First) != family {
+			continue
+		}
+		for addr := range p.rng.All() {
+			if _, ok := p.allocated[addr]; !ok {
+				return Allocation{Pool: name, Addr: addr}, true
+			}
+		}
+	}
+	return Allocation{}, false
+}
+
+// familyPrefix returns family followed by a space, or "" for any family.
+func familyPrefix(family corev1.IPFamily) string {
+	if family == "" {
+		return ""
+	}
+	return string(family) + " "
+}
+
+func familyOf(addr netip.Addr) corev1.IPFamily {
+	if addr.Is4() {
+		return corev1.IPv4Protocol
+	}
+	return corev1.IPv6Protocol
+}
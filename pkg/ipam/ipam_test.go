@@ -0,0 +1,233 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package ipam_test
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/ipam"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ipPool(name, start string, count int64, usage v1alpha1.IPPoolUsageLabelValue) v1alpha1.IPPool {
+	p := v1alpha1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       v1alpha1.IPPoolSpec{StartingAddress: start, AddressCount: count},
+	}
+	if usage != "" {
+		p.Labels = map[string]string{v1alpha1.IPPoolUsageLabelKeyName: string(usage)}
+	}
+	return p
+}
+
+func refs(names ...string) []v1alpha1.IPPoolReference {
+	var refs []v1alpha1.IPPoolReference
+	for _, name := range names {
+		refs = append(refs, v1alpha1.IPPoolReference{Name: name})
+	}
+	return refs
+}
+
+func networkInterface(name string, usage v1alpha1.IPPoolUsageLabelValue, strict bool) *v1alpha1.NetworkInterface {
+	nif := &v1alpha1.NetworkInterface{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name}}
+	if usage != "" {
+		nif.Labels = map[string]string{v1alpha1.IPPoolUsageLabelKeyName: string(usage)}
+	}
+	if strict {
+		nif.Annotations = map[string]string{v1alpha1.IPPoolUsageAnnotationStrictKeyName: ""}
+	}
+	return nif
+}
+
+func newAllocator(t *testing.T, pools ...v1alpha1.IPPool) *ipam.Allocator {
+	t.Helper()
+	a, err := ipam.NewAllocator(pools...)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return a
+}
+
+func allocate(t *testing.T, a *ipam.Allocator, req ipam.Request, want ...string) {
+	t.Helper()
+	allocations, err := a.Allocate(req)
+	if err != nil {
+		t.Fatalf("%s: unexpected error: %v", req.Owner, err)
+	}
+	var got []string
+	for _, allocation := range allocations {
+		got = append(got, allocation.Pool+"="+allocation.Addr.String())
+	}
+	if len(got) != len(want) {
+		t.Fatalf("%s: expected %v, got %v", req.Owner, want, got)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("%s: expected %v, got %v", req.Owner, want, got)
+		}
+	}
+}
+
+func TestGeneralRequesterOnlyUsesGeneralPools(t *testing.T) {
+	a := newAllocator(t,
+		ipPool("vip", "10.0.1.0", 4, v1alpha1.IPPoolUsageLabelVIPValue),
+		ipPool("general", "10.0.0.0", 1, ""),
+	)
+	pools := refs("vip", "general")
+	allocate(t, a, ipam.NetworkInterfaceRequest(networkInterface("a", "", false), pools), "general=10.0.0.0")
+	_, err := a.Allocate(ipam.NetworkInterfaceRequest(networkInterface("b", v1alpha1.IPPoolUsageLabelGeneralValue, false), pools))
+	if !errors.Is(err, v1alpha1.ErrCannotAllocIP) {
+		t.Errorf("expected ErrCannotAllocIP, got %v", err)
+	}
+}
+
+func TestUsageFallsBackToGeneralPools(t *testing.T) {
+	a := newAllocator(t,
+		ipPool("general", "10.0.0.0", 4, v1alpha1.IPPoolUsageLabelGeneralValue),
+		ipPool("vip", "10.0.1.0", 2, v1alpha1.IPPoolUsageLabelVIPValue),
+	)
+	pools := refs("general", "vip")
+	allocate(t, a, ipam.NetworkInterfaceRequest(networkInterface("a", "vip", false), pools), "vip=10.0.1.0")
+	allocate(t, a, ipam.NetworkInterfaceRequest(networkInterface("b", "vip", false), pools), "vip=10.0.1.1")
+	allocate(t, a, ipam.NetworkInterfaceRequest(networkInterface("c", "vip", false), pools), "general=10.0.0.0")
+	if got := a.Allocated("vip"); got != 2 {
+		t.Errorf("expected 2 addresses allocated from vip, got %d", got)
+	}
+}
+
+func TestStrictUsageDoesNotFallBack(t *testing.T) {
+	a := newAllocator(t,
+		ipPool("general", "10.0.0.0", 4, ""),
+		ipPool("vip", "10.0.1.0", 1, v1alpha1.IPPoolUsageLabelVIPValue),
+	)
+	pools := refs("general", "vip")
+	allocate(t, a, ipam.NetworkInterfaceRequest(networkInterface("a", "vip", true), pools), "vip=10.0.1.0")
+	_, err := a.Allocate(ipam.NetworkInterfaceRequest(networkInterface("b", "vip", true), pools))
+	if !errors.Is(err, v1alpha1.ErrCannotAllocIP) {
+		t.Errorf("expected ErrCannotAllocIP, got %v", err)
+	}
+	if got := a.Allocated("general"); got != 0 {
+		t.Errorf("expected no address allocated from general, got %d", got)
+	}
+}
+
+func TestIPFamilyPolicy(t *testing.T) {
+	pools := []v1alpha1.IPPool{
+		ipPool("v4", "10.0.0.0", 4, ""),
+		ipPool("v6", "fd00::", 1<<40, ""),
+	}
+	a := newAllocator(t, pools...)
+
+	dual := networkInterface("dual", "", false)
+	allocate(t, a, ipam.NetworkInterfaceRequest(dual, refs("v6", "v4")), "v4=10.0.0.0", "v6=fd00::")
+	v6 := networkInterface("v6", "", false)
+	v6.Spec.IPFamilyPolicy = v1alpha1.NetworkInterfaceIPFamilyPolicyIPv6Only
+	allocate(t, a, ipam.NetworkInterfaceRequest(v6, refs("v4", "v6")), "v6=fd00::1")
+	unset := networkInterface("unset", "", false)
+	allocate(t, a, ipam.NetworkInterfaceRequest(unset, refs("v4")), "v4=10.0.0.1")
+
+	unsupported := networkInterface("unsupported", "", false)
+	unsupported.Spec.IPFamilyPolicy = v1alpha1.NetworkInterfaceIPFamilyPolicyDualStack
+	if _, err := a.Allocate(ipam.NetworkInterfaceRequest(unsupported, refs("v4"))); !errors.Is(err, v1alpha1.ErrUnsupportedIPFamilyPolicy) {
+		t.Errorf("expected ErrUnsupportedIPFamilyPolicy, got %v", err)
+	}
+}
+
+func TestAllocateIsIdempotentAndAtomic(t *testing.T) {
+	a := newAllocator(t, ipPool("v4", "10.0.0.0", 1, ""), ipPool("v6", "fd00::", 1, ""))
+	req := ipam.NetworkInterfaceRequest(networkInterface("a", "", false), nil)
+	allocate(t, a, req, "v4=10.0.0.0", "v6=fd00::")
+	allocate(t, a, req, "v4=10.0.0.0", "v6=fd00::")
+
+	// The IPv4 pool is exhausted, so the IPv6 address is not allocated either.
+	if _, err := a.Allocate(ipam.NetworkInterfaceRequest(networkInterface("b", "", false), nil)); !errors.Is(err, v1alpha1.ErrCannotAllocIP) {
+		t.Fatalf("expected ErrCannotAllocIP, got %v", err)
+	}
+	if got := a.Allocated("v6"); got != 1 {
+		t.Errorf("expected 1 address allocated from v6, got %d", got)
+	}
+
+	a.Release(req.Owner)
+	allocate(t, a, ipam.NetworkInterfaceRequest(networkInterface("b", "", false), nil), "v4=10.0.0.0", "v6=fd00::")
+}
+
+func TestIPAddressAllocation(t *testing.T) {
+	a := newAllocator(t, ipPool("v6", "fd00::", 16, ""), ipPool("v4", "10.0.0.0", 16, ""))
+	alloc := func(name, requestedIP string) *v1alpha1.IPAddressAllocation {
+		return &v1alpha1.IPAddressAllocation{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+			Spec:       v1alpha1.IPAddressAllocationSpec{RequestedIP: requestedIP},
+		}
+	}
+	pools := refs("v6", "v4")
+	allocate(t, a, ipam.IPAddressAllocationRequest(alloc("any", ""), pools), "v6=fd00::")
+	allocate(t, a, ipam.IPAddressAllocationRequest(alloc("requested", "10.0.0.7"), pools), "v4=10.0.0.7")
+
+	tests := []struct {
+		name, requestedIP string
+		pools             []v1alpha1.IPPoolReference
+		want              error
+	}{
+		{"taken", "10.0.0.7", pools, v1alpha1.ErrInvalidRequestedIP},
+		{"outside", "10.0.1.0", pools, v1alpha1.ErrInvalidRequestedIP},
+		{"other-pool", "fd00::1", refs("v4"), v1alpha1.ErrInvalidRequestedIP},
+		{"invalid", "10.0.0", pools, v1alpha1.ErrInvalidRequestedIP},
+		{"missing-pool", "", refs("v4", "missing"), v1alpha1.ErrIPPoolRefRetrievalFailed},
+	}
+	for _, tt := range tests {
+		if _, err := a.Allocate(ipam.IPAddressAllocationRequest(alloc(tt.name, tt.requestedIP), tt.pools)); !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestIPAMDisabled(t *testing.T) {
+	a := newAllocator(t, ipPool("general", "10.0.0.0", 4, ""))
+	req := ipam.NetworkInterfaceRequest(networkInterface("a", "", false), nil)
+	req.GatewayClass = &metav1.ObjectMeta{
+		Name:        "class",
+		Annotations: map[string]string{v1alpha1.IPAMDisabledAnnotationKeyName: ""},
+	}
+	allocate(t, a, req)
+	if got := a.Allocated("general"); got != 0 {
+		t.Errorf("expected no address allocated, got %d", got)
+	}
+	if ipam.IPAMDisabled(&metav1.ObjectMeta{Name: "class"}) || ipam.IPAMDisabled(nil) {
+		t.Error("expected IPAM to be enabled without the annotation")
+	}
+}
+
+func TestNewAllocatorRejectsInvalidPools(t *testing.T) {
+	tests := map[string][]v1alpha1.IPPool{
+		"overlap":   {ipPool("a", "10.0.0.0", 16, ""), ipPool("b", "10.0.0.15", 16, "")},
+		"duplicate": {ipPool("a", "10.0.0.0", 16, ""), ipPool("a", "10.0.1.0", 16, "")},
+		"invalid":   {ipPool("a", "10.0.0", 16, "")},
+		"empty":     {ipPool("a", "10.0.0.0", 0, "")},
+	}
+	for name, pools := range tests {
+		if _, err := ipam.NewAllocator(pools...); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+	if _, err := ipam.NewAllocator(ipPool("a", "10.0.0.0", 16, ""), ipPool("b", "10.0.0.16", 16, "")); err != nil {
+		t.Errorf("unexpected error for adjacent pools: %v", err)
+	}
+}
+
+func TestAllocationAddr(t *testing.T) {
+	a := newAllocator(t, ipPool("general", "192.168.0.254", 4, ""))
+	for i, want := range []string{"192.168.0.254", "192.168.0.255", "192.168.1.0"} {
+		allocations, err := a.Allocate(ipam.Request{Owner: string(rune('a' + i))})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if allocations[0].Addr != netip.MustParseAddr(want) {
+			t.Errorf("expected %s, got %s", want, allocations[0].Addr)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Package iprange provides arithmetic on contiguous ranges of IP addresses,
+// which the netoperator.vmware.com API describes as a starting address and a
+// count, such as IPRange, IPPoolSpec and VSphereDistributedNetworkIPRange:
+//
+//	r, err := pool.Spec.Range()
+//	if err != nil {
+//		...
+//	}
+//	if r.Contains(addr) {
+//		...
+//	}
+//	for _, p := range r.Prefixes() {
+//		...
+//	}
+//
+// The arithmetic is done on 128 bits, so IPv6 ranges of any size are handled.
+package iprange
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math/big"
+	"math/bits"
+	"net/netip"
+)
+
+// Range is a contiguous, non-empty range of IP addresses of a single family,
+// from First to Last inclusive.
+type Range struct {
+	First netip.Addr
+	Last  netip.Addr
+}
+
+// New returns the range of count addresses that starts at start. It fails if
+// start is not valid or has a zone, if count is not positive, or if the range
+// goes past the last address of the family of start.
+func New(start netip.Addr, count int64) (Range, error) {
+	if !start.IsValid() {
+		return Range{}, fmt.Errorf("invalid starting address")
+	}
+	if start.Zone() != "" {
+		return Range{}, fmt.Errorf("starting address %s has a zone", start)
+	}
+	if count < 1 {
+		return Range{}, fmt.Errorf("address count %d must be at least 1", count)
+	}
+	last, overflow := fromAddr(start).add(uint128{lo: uint64(count - 1)})
+	if overflow || !last.fits(start.BitLen()) {
+		return Range{}, fmt.Errorf("range of %d addresses starting at %s goes past the last %s address", count, start, family(start))
+	}
+	return Range{First: start, Last: last.addr(start.Is4())}, nil
+}
+
+// Parse returns the range of count addresses that starts at the address start.
+// See New.
+func Parse(start string, count int64) (Range, error) {
+	addr, err := netip.ParseAddr(start)
+	if err != nil {
+		return Range{}, fmt.Errorf("invalid starting address %q: %w", start, err)
+	}
+	return New(addr, count)
+}
+
+// FromPrefix returns the range of the addresses of the prefix p, which must be
+// valid.
+func FromPrefix(p netip.Prefix) Range {
+	first := p.Masked().Addr()
+	last := fromAddr(first).or(hostMask(first.BitLen() - p.Bits()))
+	return Range{First: first, Last: last.addr(first.Is4())}
+}
+
+// IsValid reports whether r is a non-empty range of a single family.
+func (r Range) IsValid() bool {
+	return r.First.IsValid() && r.Last.IsValid() && r.First.Is4() == r.Last.Is4() && r.First.Compare(r.Last) <= 0
+}
+
+// Is4 reports whether r is a range of IPv4 addresses.
+func (r Range) Is4() bool {
+	return r.First.Is4()
+}
+
+// Size returns the number of addresses in r.
+func (r Range) Size() *big.Int {
+	size := new(big.Int).Sub(fromAddr(r.Last).big(), fromAddr(r.First).big())
+	return size.Add(size, big.NewInt(1))
+}
+
+// Contains reports whether addr is in r.
+func (r Range) Contains(addr netip.Addr) bool {
+	return addr.IsValid() && addr.Is4() == r.Is4() && r.First.Compare(addr.WithZone("")) <= 0 && addr.WithZone("").Compare(r.Last) <= 0
+}
+
+// ContainsRange reports whether every address of o is in r.
+func (r Range) ContainsRange(o Range) bool {
+	return r.Contains(o.First) && r.Contains(o.Last)
+}
+
+// Overlaps reports whether r and o have any address in common.
+func (r Range) Overlaps(o Range) bool {
+	return r.Is4() == o.Is4() && r.First.Compare(o.Last) <= 0 && o.First.Compare(r.Last) <= 0
+}
+
+// All returns an iterator over the addresses of r, in order. The iterator may
+// be stopped early, which matters for the largest IPv6 ranges.
+func (r Range) All() iter.Seq[netip.Addr] {
+	return func(yield func(netip.Addr) bool) {
+		for addr := r.First; addr.IsValid() && addr.Compare(r.Last) <= 0; addr = addr.Next() {
+			if !yield(addr) {
+				return
+			}
+		}
+	}
+}
+
+// Prefixes returns the smallest list of prefixes that covers exactly the
+// addresses of r, in order.
+func (r Range) Prefixes() []netip.Prefix {
+	var prefixes []netip.Prefix
+	is4, bitLen := r.Is4(), r.First.BitLen()
+	first, last := fromAddr(r.First), fromAddr(r.Last)
+	for {
+		// The largest block that starts at first is limited by the alignment
+		// of first and by the addresses left in the range.
+		span, _ := last.sub(first)
+		k := min(first.trailingZeros(), bitLen)
+		for k > 0 && hostMask(k).cmp(span) > 0 {
+			k--
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(first.addr(is4), bitLen-k))
+		end, _ := first.add(hostMask(k))
+		if end.cmp(last) >= 0 {
+			return prefixes
+		}
+		first, _ = end.add(uint128{lo: 1})
+	}
+}
+
+// String returns r as "first-last".
+func (r Range) String() string {
+	return r.First.String() + "-" + r.Last.String()
+}
+
+func family(addr netip.Addr) string {
+	if addr.Is4() {
+		return "IPv4"
+	}
+	return "IPv6"
+}
+
+// uint128 is an address as an unsigned integer. IPv4 addresses only use the
+// low 32 bits.
+type uint128 struct {
+	hi, lo uint64
+}
+
+func fromAddr(addr netip.Addr) uint128 {
+	if addr.Is4() {
+		b := addr.As4()
+		return uint128{lo: uint64(b[0])<<24 | uint64(b[1])<<16 | uint64(b[2])<<8 | uint64(b[3])}
+	}
+	b := addr.As16()
+	var u uint128
+	for i := 0; i < 8; i++ {
+		u.hi = u.hi<<8 | uint64(b[i])
+		u.lo = u.lo<<8 | uint64(b[i+8])
+	}
+	return u
+}
+
+func (u uint128) addr(is4 bool) netip.Addr {
+	if is4 {
+		return netip.AddrFrom4([4]byte{byte(u.lo >> 24), byte(u.lo >> 16), byte(u.lo >> 8), byte(u.lo)})
+	}
+	var b [16]byte
+	for i := 0; i < 8; i++ {
+		b[7-i] = byte(u.hi >> (8 * i))
+		b[15-i] = byte(u.lo >> (8 * i))
+	}
+	return netip.AddrFrom16(b)
+}
+
+// hostMask returns the value with the low k bits set.
+func hostMask(k int) uint128 {
+	return uint128{hi: ^uint64(0), lo: ^uint64(0)}.rsh(uint(128 - k))
+}
+
+func (u uint128) fits(bitLen int) bool {
+	return bitLen == 128 || (u.hi == 0 && u.lo>>bitLen == 0)
+}
+
+func (u uint128) add(v uint128) (uint128, bool) {
+	lo, carry := bits.Add64(u.lo, v.lo, 0)
+	hi, carry := bits.Add64(u.hi, v.hi, carry)
+	return uint128{hi: hi, lo: lo}, carry != 0
+}
+
+func (u uint128) sub(v uint128) (uint128, bool) {
+	lo, borrow := bits.Sub64(u.lo, v.lo, 0)
+	hi, borrow := bits.Sub64(u.hi, v.hi, borrow)
+	return uint128{hi: hi, lo: lo}, borrow != 0
+}
+
+func (u uint128) or(v uint128) uint128 {
+	return uint128{hi: u.hi | v.hi, lo: u.lo | v.lo}
+}
+
+func (u uint128) rsh(n uint) uint128 {
+	switch {
+	case n >= 128:
+		return uint128{}
+	case n >= 64:
+		return uint128{lo: u.hi >> (n - 64)}
+	default:
+		return uint128{hi: u.hi >> n, lo: u.lo>>n | u.hi<<(64-n)}
+	}
+}
+
+func (u uint128) cmp(v uint128) int {
+	if u.hi != v.hi {
+		return cmp.Compare(u.hi, v.hi)
+	}
+	return cmp.Compare(u.lo, v.lo)
+}
+
+func (u uint128) trailingZeros() int {
+	if u.lo != 0 {
+		return bits.TrailingZeros64(u.lo)
+	}
+	return 64 + bits.TrailingZeros64(u.hi)
+}
+
+func (u uint128) big() *big.Int {
+	b := new(big.Int).SetUint64(u.hi)
+	return b.Lsh(b, 64).Or(b, new(big.Int).SetUint64(u.lo))
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package iprange_test
+
+import (
+	"math/big"
+	"net/netip"
+	"slices"
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/pkg/iprange"
+)
+
+func mustParse(t *testing.T, start string, count int64) iprange.Range {
+	t.Helper()
+	r, err := iprange.Parse(start, count)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return r
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		start    string
+		count    int64
+		wantLast string
+		wantErr  bool
+	}{
+		{start: "10.0.0.1", count: 1, wantLast: "10.0.0.1"},
+		{start: "10.0.0.250", count: 10, wantLast: "10.0.1.3"},
+		{start: "255.255.255.0", count: 256, wantLast: "255.255.255.255"},
+		{start: "0.0.0.0", count: 1 << 32, wantLast: "255.255.255.255"},
+		{start: "fd00::", count: 1 << 62, wantLast: "fd00::3fff:ffff:ffff:ffff"},
+		{start: "fd00::ffff:ffff:ffff:ffff", count: 2, wantLast: "fd00:0:0:1::"},
+		{start: "ffff:ffff:ffff:ffff:ffff:ffff:ffff:fff0", count: 16, wantLast: "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff"},
+		{start: "255.255.255.0", count: 257, wantErr: true},
+		{start: "ffff:ffff:ffff:ffff:ffff:ffff:ffff:fff0", count: 17, wantErr: true},
+		{start: "10.0.0.1", count: 0, wantErr: true},
+		{start: "10.0.0.1", count: -1, wantErr: true},
+		{start: "fe80::1%eth0", count: 1, wantErr: true},
+		{start: "10.0.0", count: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		r, err := iprange.Parse(tt.start, tt.count)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Parse(%s, %d): expected an error, got %s", tt.start, tt.count, r)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Parse(%s, %d): unexpected error: %v", tt.start, tt.count, err)
+			continue
+		}
+		if r.Last != netip.MustParseAddr(tt.wantLast) {
+			t.Errorf("Parse(%s, %d): expected last address %s, got %s", tt.start, tt.count, tt.wantLast, r.Last)
+		}
+		if r.Size().Cmp(big.NewInt(tt.count)) != 0 {
+			t.Errorf("Parse(%s, %d): expected size %d, got %s", tt.start, tt.count, tt.count, r.Size())
+		}
+	}
+}
+
+func TestSizeBeyondInt64(t *testing.T) {
+	r := iprange.FromPrefix(netip.MustParsePrefix("::/0"))
+	want := new(big.Int).Lsh(big.NewInt(1), 128)
+	if r.Size().Cmp(want) != 0 {
+		t.Errorf("expected size %s, got %s", want, r.Size())
+	}
+	r = iprange.FromPrefix(netip.MustParsePrefix("fd00::/56"))
+	want = new(big.Int).Lsh(big.NewInt(1), 72)
+	if r.Size().Cmp(want) != 0 {
+		t.Errorf("expected size %s, got %s", want, r.Size())
+	}
+}
+
+func TestFromPrefix(t *testing.T) {
+	tests := []struct {
+		prefix, want string
+	}{
+		{"192.168.1.77/24", "192.168.1.0-192.168.1.255"},
+		{"10.0.0.1/32", "10.0.0.1-10.0.0.1"},
+		{"0.0.0.0/0", "0.0.0.0-255.255.255.255"},
+		{"fd00::1/64", "fd00::-fd00::ffff:ffff:ffff:ffff"},
+		{"::/0", "::-ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff"},
+	}
+	for _, tt := range tests {
+		if got := iprange.FromPrefix(netip.MustParsePrefix(tt.prefix)).String(); got != tt.want {
+			t.Errorf("FromPrefix(%s): expected %s, got %s", tt.prefix, tt.want, got)
+		}
+	}
+}
+
+func TestContains(t *testing.T) {
+	r := mustParse(t, "10.0.0.10", 10)
+	for addr, want := range map[string]bool{
+		"10.0.0.9":          false,
+		"10.0.0.10":         true,
+		"10.0.0.19":         true,
+		"10.0.0.20":         false,
+		"::ffff:10.0.0.10":  false,
+		"fd00::10":          false,
+		"255.255.255.255":   false,
+		"0.0.0.0":           false,
+		"10.0.0.15":         true,
+		"ffff:ffff::ffff:1": false,
+	} {
+		if got := r.Contains(netip.MustParseAddr(addr)); got != want {
+			t.Errorf("Contains(%s): expected %t, got %t", addr, want, got)
+		}
+	}
+	if r.Contains(netip.Addr{}) {
+		t.Error("expected the zero address not to be contained")
+	}
+	if !r.ContainsRange(mustParse(t, "10.0.0.12", 8)) || r.ContainsRange(mustParse(t, "10.0.0.12", 9)) {
+		t.Error("unexpected ContainsRange result")
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	tests := []struct {
+		a, b iprange.Range
+		want bool
+	}{
+		{mustParse(t, "10.0.0.0", 10), mustParse(t, "10.0.0.9", 1), true},
+		{mustParse(t, "10.0.0.0", 10), mustParse(t, "10.0.0.10", 1), false},
+		{mustParse(t, "10.0.0.5", 1), mustParse(t, "10.0.0.0", 256), true},
+		{mustParse(t, "fd00::", 1<<40), mustParse(t, "fd00::ff:ffff:ffff", 2), true},
+		{mustParse(t, "fd00::", 1<<40), mustParse(t, "fd00::100:0:0", 2), false},
+		{mustParse(t, "0.0.0.0", 1<<32), mustParse(t, "::", 1), false},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Overlaps(tt.b); got != tt.want {
+			t.Errorf("%s overlaps %s: expected %t, got %t", tt.a, tt.b, tt.want, got)
+		}
+		if got := tt.b.Overlaps(tt.a); got != tt.want {
+			t.Errorf("%s overlaps %s: expected %t, got %t", tt.b, tt.a, tt.want, got)
+		}
+	}
+}
+
+func TestAll(t *testing.T) {
+	var got []string
+	for addr := range mustParse(t, "10.0.0.254", 4).All() {
+		got = append(got, addr.String())
+	}
+	want := []string{"10.0.0.254", "10.0.0.255", "10.0.1.0", "10.0.1.1"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	got = nil
+	for addr := range iprange.FromPrefix(netip.MustParsePrefix("ffff:ffff:ffff:ffff:ffff:ffff:ffff:fffe/127")).All() {
+		got = append(got, addr.String())
+	}
+	want = []string{"ffff:ffff:ffff:ffff:ffff:ffff:ffff:fffe", "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff"}
+	if !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	n := 0
+	for range iprange.FromPrefix(netip.MustParsePrefix("fd00::/64")).All() {
+		if n++; n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Errorf("expected the iteration to stop after 3 addresses, got %d", n)
+	}
+}
+
+func TestPrefixes(t *testing.T) {
+	tests := []struct {
+		start string
+		count int64
+		want  []string
+	}{
+		{"10.0.0.0", 256, []string{"10.0.0.0/24"}},
+		{"10.0.0.1", 1, []string{"10.0.0.1/32"}},
+		{"10.0.0.1", 6, []string{"10.0.0.1/32", "10.0.0.2/31", "10.0.0.4/31", "10.0.0.6/32"}},
+		{"10.0.0.250", 10, []string{"10.0.0.250/31", "10.0.0.252/30", "10.0.1.0/30"}},
+		{"0.0.0.0", 1 << 32, []string{"0.0.0.0/0"}},
+		{"255.255.255.255", 1, []string{"255.255.255.255/32"}},
+		{"fd00::", 1 << 62, []string{"fd00::/66"}},
+		{"fd00::ffff:ffff:ffff:ffff", 2, []string{"fd00::ffff:ffff:ffff:ffff/128", "fd00:0:0:1::/128"}},
+		{"ffff:ffff:ffff:ffff:ffff:ffff:ffff:fff0", 16, []string{"ffff:ffff:ffff:ffff:ffff:ffff:ffff:fff0/124"}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, p := range mustParse(t, tt.start, tt.count).Prefixes() {
+			got = append(got, p.String())
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Prefixes(%s, %d): expected %v, got %v", tt.start, tt.count, tt.want, got)
+		}
+	}
+
+	for _, p := range []string{"::/0", "0.0.0.0/0", "fd00::/8"} {
+		got := iprange.FromPrefix(netip.MustParsePrefix(p)).Prefixes()
+		if len(got) != 1 || got[0].String() != p {
+			t.Errorf("expected %s to round trip, got %v", p, got)
+		}
+	}
+}
+
+func TestIsValid(t *testing.T) {
+	if (iprange.Range{}).IsValid() {
+		t.Error("expected the zero range not to be valid")
+	}
+	r := iprange.Range{First: netip.MustParseAddr("10.0.0.2"), Last: netip.MustParseAddr("10.0.0.1")}
+	if r.IsValid() {
+		t.Errorf("expected %s not to be valid", r)
+	}
+	if !mustParse(t, "fd00::", 1).IsValid() {
+		t.Error("expected a parsed range to be valid")
+	}
+}
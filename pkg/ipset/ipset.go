@@ -0,0 +1,212 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Package ipset provides sets of IP addresses built from ranges and prefixes,
+// with union, intersection and subtraction. It lets callers check placement
+// rules of the netoperator.vmware.com API, such as pools that must fall within
+// a subnet or CIDRs that must not overlap:
+//
+//	subnets := ipset.OfPrefixes(serverSubnets...)
+//	pools := ipset.Of(poolRanges...)
+//	if outside := pools.Subtract(subnets); !outside.IsEmpty() {
+//		return fmt.Errorf("addresses %s are outside of the subnets", outside)
+//	}
+//
+// A Set may hold both IPv4 and IPv6 addresses. Sets are values: the
+// operations return a new Set and never change their operands.
+package ipset
+
+import (
+	"math/big"
+	"net/netip"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/vmware-tanzu/net-operator-api/pkg/iprange"
+)
+
+// Set is a set of IP addresses. The zero Set is empty.
+type Set struct {
+	// ranges are sorted, disjoint and not adjacent, IPv4 before IPv6.
+	ranges []iprange.Range
+}
+
+// Of returns the set of the addresses of ranges. Ranges that are not valid are
+// ignored.
+func Of(ranges ...iprange.Range) Set {
+	return Set{ranges: normalize(slices.Clone(ranges))}
+}
+
+// OfPrefixes returns the set of the addresses of prefixes. Prefixes that are
+// not valid are ignored.
+func OfPrefixes(prefixes ...netip.Prefix) Set {
+	ranges := make([]iprange.Range, 0, len(prefixes))
+	for _, p := range prefixes {
+		if p.IsValid() {
+			ranges = append(ranges, iprange.FromPrefix(p))
+		}
+	}
+	return Set{ranges: normalize(ranges)}
+}
+
+// IsEmpty reports whether s has no address.
+func (s Set) IsEmpty() bool {
+	return len(s.ranges) == 0
+}
+
+// Ranges returns the smallest list of ranges that covers exactly the
+// addresses of s, in order.
+func (s Set) Ranges() []iprange.Range {
+	return slices.Clone(s.ranges)
+}
+
+// Prefixes returns the smallest list of prefixes that covers exactly the
+// addresses of s, in order.
+func (s Set) Prefixes() []netip.Prefix {
+	var prefixes []netip.Prefix
+	for _, r := range s.ranges {
+		prefixes = append(prefixes, r.Prefixes()...)
+	}
+	return prefixes
+}
+
+// Size returns the number of addresses in s.
+func (s Set) Size() *big.Int {
+	size := new(big.Int)
+	for _, r := range s.ranges {
+		size.Add(size, r.Size())
+	}
+	return size
+}
+
+// Contains reports whether addr is in s.
+func (s Set) Contains(addr netip.Addr) bool {
+	addr = addr.WithZone("")
+	i := sort.Search(len(s.ranges), func(i int) bool {
+		return s.ranges[i].Last.Compare(addr) >= 0
+	})
+	return i < len(s.ranges) && s.ranges[i].Contains(addr)
+}
+
+// ContainsSet reports whether every address of o is in s.
+func (s Set) ContainsSet(o Set) bool {
+	return o.Subtract(s).IsEmpty()
+}
+
+// Overlaps reports whether s and o have any address in common.
+func (s Set) Overlaps(o Set) bool {
+	return !s.Intersect(o).IsEmpty()
+}
+
+// Equal reports whether s and o have the same addresses.
+func (s Set) Equal(o Set) bool {
+	return slices.Equal(s.ranges, o.ranges)
+}
+
+// Union returns the addresses that are in s or in o.
+func (s Set) Union(o Set) Set {
+	return Set{ranges: normalize(slices.Concat(s.ranges, o.ranges))}
+}
+
+// Intersect returns the addresses that are in both s and o.
+func (s Set) Intersect(o Set) Set {
+	var ranges []iprange.Range
+	for i, j := 0, 0; i < len(s.ranges) && j < len(o.ranges); {
+		a, b := s.ranges[i], o.ranges[j]
+		// Addresses of different families never compare between a first and
+		// a last address, so no range is added for them.
+		first, last := maxAddr(a.First, b.First), minAddr(a.Last, b.Last)
+		if first.Compare(last) <= 0 {
+			ranges = append(ranges, iprange.Range{First: first, Last: last})
+		}
+		if a.Last.Compare(b.Last) < 0 {
+			i++
+		} else {
+			j++
+		}
+	}
+	return Set{ranges: ranges}
+}
+
+// Subtract returns the addresses that are in s but not in o.
+func (s Set) Subtract(o Set) Set {
+	var ranges []iprange.Range
+	j := 0
+	for _, r := range s.ranges {
+		for j < len(o.ranges) && o.ranges[j].Last.Compare(r.First) < 0 {
+			j++
+		}
+		remaining := true
+		for k := j; k < len(o.ranges) && o.ranges[k].First.Compare(r.Last) <= 0; k++ {
+			cut := o.ranges[k]
+			if cut.First.Compare(r.First) > 0 {
+				ranges = append(ranges, iprange.Range{First: r.First, Last: cut.First.Prev()})
+			}
+			if cut.Last.Compare(r.Last) >= 0 {
+				remaining = false
+				break
+			}
+			r.First = cut.Last.Next()
+		}
+		if remaining {
+			ranges = append(ranges, r)
+		}
+	}
+	return Set{ranges: ranges}
+}
+
+// String returns the ranges of s separated by commas, with the ranges that
+// are prefixes written as such.
+func (s Set) String() string {
+	parts := make([]string, 0, len(s.ranges))
+	for _, r := range s.ranges {
+		if prefixes := r.Prefixes(); len(prefixes) == 1 {
+			parts = append(parts, prefixes[0].String())
+		} else {
+			parts = append(parts, r.String())
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// normalize sorts ranges and merges the ones that overlap or are adjacent,
+// dropping the ones that are not valid. It reuses the array of ranges.
+func normalize(ranges []iprange.Range) []iprange.Range {
+	ranges = slices.DeleteFunc(ranges, func(r iprange.Range) bool {
+		return !r.IsValid()
+	})
+	slices.SortFunc(ranges, func(a, b iprange.Range) int {
+		return a.First.Compare(b.First)
+	})
+	merged := ranges[:0]
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && merged[n-1].Is4() == r.Is4() {
+			last := &merged[n-1]
+			if next := last.Last.Next(); !next.IsValid() || r.First.Compare(next) <= 0 {
+				last.Last = maxAddr(last.Last, r.Last)
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+	if len(merged) == 0 {
+		return nil
+	}
+	return merged
+}
+
+func minAddr(a, b netip.Addr) netip.Addr {
+	if a.Compare(b) <= 0 {
+		return a
+	}
+	return b
+}
+
+func maxAddr(a, b netip.Addr) netip.Addr {
+	if a.Compare(b) >= 0 {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package ipset_test
+
+import (
+	"math/big"
+	"math/rand/v2"
+	"net/netip"
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/pkg/iprange"
+	"github.com/vmware-tanzu/net-operator-api/pkg/ipset"
+)
+
+func rng(t *testing.T, start string, count int64) iprange.Range {
+	t.Helper()
+	r, err := iprange.Parse(start, count)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return r
+}
+
+func prefixes(ps ...string) ipset.Set {
+	var prefixes []netip.Prefix
+	for _, p := range ps {
+		prefixes = append(prefixes, netip.MustParsePrefix(p))
+	}
+	return ipset.OfPrefixes(prefixes...)
+}
+
+func TestOfMergesRanges(t *testing.T) {
+	s := ipset.Of(
+		rng(t, "10.0.0.8", 8),
+		rng(t, "fd00::", 4),
+		rng(t, "10.0.0.0", 8),
+		rng(t, "10.0.0.4", 2),
+		rng(t, "10.0.0.20", 1),
+		rng(t, "fd00::4", 1),
+		iprange.Range{},
+	)
+	if got, want := s.String(), "10.0.0.0/28,10.0.0.20/32,fd00::-fd00::4"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	if got := s.Size(); got.Cmp(big.NewInt(22)) != 0 {
+		t.Errorf("expected 22 addresses, got %s", got)
+	}
+	if got := len(s.Ranges()); got != 3 {
+		t.Errorf("expected 3 ranges, got %d", got)
+	}
+	if got := len(s.Prefixes()); got != 4 {
+		t.Errorf("expected 4 prefixes, got %v", s.Prefixes())
+	}
+}
+
+func TestOfMergesAtTheEndOfTheFamily(t *testing.T) {
+	s := ipset.Of(rng(t, "255.255.255.0", 256), rng(t, "255.255.255.255", 1), rng(t, "::", 1))
+	if got, want := s.String(), "255.255.255.0/24,::/128"; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestContains(t *testing.T) {
+	s := prefixes("10.0.0.0/24", "10.0.2.0/24", "fd00::/64")
+	for addr, want := range map[string]bool{
+		"10.0.0.0":        true,
+		"10.0.0.255":      true,
+		"10.0.1.0":        false,
+		"10.0.2.7":        true,
+		"10.0.3.0":        false,
+		"9.255.255.1":     false,
+		"fd00::1":         true,
+		"fd00:0:0:1::":    false,
+		"::ffff:10.0.0.1": false,
+	} {
+		if got := s.Contains(netip.MustParseAddr(addr)); got != want {
+			t.Errorf("Contains(%s): expected %t, got %t", addr, want, got)
+		}
+	}
+}
+
+func TestAlgebra(t *testing.T) {
+	subnets := prefixes("192.168.0.0/24", "192.168.2.0/24", "fd00::/64")
+	pools := ipset.Of(rng(t, "192.168.0.200", 100), rng(t, "fd00::10", 16))
+
+	if got, want := pools.Subtract(subnets).String(), "192.168.1.0-192.168.1.43"; got != want {
+		t.Errorf("Subtract: expected %s, got %s", want, got)
+	}
+	if subnets.ContainsSet(pools) {
+		t.Error("expected the pools not to be within the subnets")
+	}
+	if !subnets.Union(prefixes("192.168.1.0/24")).ContainsSet(pools) {
+		t.Error("expected the pools to be within the extended subnets")
+	}
+	if got, want := pools.Intersect(subnets).String(), "192.168.0.200-192.168.0.255,fd00::10/124"; got != want {
+		t.Errorf("Intersect: expected %s, got %s", want, got)
+	}
+	if !pools.Overlaps(subnets) || pools.Overlaps(prefixes("192.168.3.0/24", "fd00:0:0:1::/64")) {
+		t.Error("unexpected Overlaps result")
+	}
+	if got, want := subnets.Subtract(pools).String(), "192.168.0.0-192.168.0.199,192.168.2.0/24,fd00::/124,fd00::20-fd00::ffff:ffff:ffff:ffff"; got != want {
+		t.Errorf("Subtract: expected %s, got %s", want, got)
+	}
+}
+
+func TestFamiliesDoNotMix(t *testing.T) {
+	v4 := prefixes("0.0.0.0/0")
+	v6 := prefixes("::/0")
+	if v4.Overlaps(v6) {
+		t.Error("expected IPv4 and IPv6 not to overlap")
+	}
+	if !v4.Subtract(v6).Equal(v4) || !v6.Subtract(v4).Equal(v6) {
+		t.Error("expected subtracting the other family to be a no-op")
+	}
+	all := v4.Union(v6)
+	want := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 128), new(big.Int).Lsh(big.NewInt(1), 32))
+	if all.Size().Cmp(want) != 0 {
+		t.Errorf("expected %s addresses, got %s", want, all.Size())
+	}
+	if !all.Subtract(v4).Equal(v6) {
+		t.Errorf("expected %s, got %s", v6, all.Subtract(v4))
+	}
+	if !all.Subtract(all).IsEmpty() {
+		t.Error("expected subtracting a set from itself to be empty")
+	}
+}
+
+func TestZeroSet(t *testing.T) {
+	var zero ipset.Set
+	s := prefixes("10.0.0.0/8")
+	if !zero.IsEmpty() || zero.Size().Sign() != 0 || zero.String() != "" {
+		t.Error("expected the zero set to be empty")
+	}
+	if !zero.Union(s).Equal(s) || !s.Subtract(zero).Equal(s) || !s.Intersect(zero).IsEmpty() {
+		t.Error("unexpected result with the zero set")
+	}
+	if !s.ContainsSet(zero) || zero.ContainsSet(s) {
+		t.Error("unexpected ContainsSet result with the zero set")
+	}
+}
+
+// TestAgainstMembership checks the operations on random sets of a small space
+// against the membership of every address.
+func TestAgainstMembership(t *testing.T) {
+	random := rand.New(rand.NewPCG(1, 2))
+	base := netip.MustParseAddr("10.0.0.0")
+	randomSet := func() (ipset.Set, map[netip.Addr]bool) {
+		var ranges []iprange.Range
+		members := map[netip.Addr]bool{}
+		for range random.IntN(5) {
+			start := base
+			for range random.IntN(64) {
+				start = start.Next()
+			}
+			r, err := iprange.New(start, int64(1+random.IntN(16)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			ranges = append(ranges, r)
+			for addr := range r.All() {
+				members[addr] = true
+			}
+		}
+		return ipset.Of(ranges...), members
+	}
+
+	for range 500 {
+		a, inA := randomSet()
+		b, inB := randomSet()
+		union, intersect, subtract := a.Union(b), a.Intersect(b), a.Subtract(b)
+		for addr := base; addr.Compare(netip.MustParseAddr("10.0.0.100")) < 0; addr = addr.Next() {
+			if got, want := union.Contains(addr), inA[addr] || inB[addr]; got != want {
+				t.Fatalf("%s union %s: %s expected %t", a, b, addr, want)
+			}
+			if got, want := intersect.Contains(addr), inA[addr] && inB[addr]; got != want {
+				t.Fatalf("%s intersect %s: %s expected %t", a, b, addr, want)
+			}
+			if got, want := subtract.Contains(addr), inA[addr] && !inB[addr]; got != want {
+				t.Fatalf("%s subtract %s: %s expected %t", a, b, addr, want)
+			}
+		}
+		if !ipset.Of(union.Ranges()...).Equal(union) || !ipset.Of(subtract.Ranges()...).Equal(subtract) {
+			t.Fatalf("expected the results to be normalized")
+		}
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package testing
+
+import (
+	"reflect"
+	"sort"
+)
+
+// StatusSubresourceKinds returns the sorted kinds of statusSubresources,
+// qualified by their version, such as v1alpha1.Network.
+func StatusSubresourceKinds() []string {
+	kinds := make([]string, 0, len(statusSubresources))
+	for _, obj := range statusSubresources {
+		kinds = append(kinds, reflect.TypeOf(obj).Elem().String())
+	}
+	sort.Strings(kinds)
+	return kinds
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package testing
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DecodeObjects decodes the multi-document YAML or JSON read from r into
+// objects of the types registered by NewScheme. Empty documents are skipped.
+// Every document must set apiVersion and kind.
+func DecodeObjects(r io.Reader) ([]client.Object, error) {
+	scheme, err := NewScheme()
+	if err != nil {
+		return nil, err
+	}
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+
+	var objs []client.Object
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	for i := 0; ; i++ {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return objs, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read document %d: %w", i, err)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 || isComment(doc) {
+			continue
+		}
+
+		obj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode document %d: %w", i, err)
+		}
+		cobj, ok := obj.(client.Object)
+		if !ok {
+			return nil, fmt.Errorf("document %d: %T is not an object", i, obj)
+		}
+		objs = append(objs, cobj)
+	}
+}
+
+// LoadObjects creates the objects decoded from r by DecodeObjects with c, in
+// the order they appear.
+func LoadObjects(ctx context.Context, c client.Client, r io.Reader) error {
+	objs, err := DecodeObjects(r)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		if err := c.Create(ctx, obj); err != nil {
+			return fmt.Errorf("failed to create %s %s: %w",
+				obj.GetObjectKind().GroupVersionKind().Kind, client.ObjectKeyFromObject(obj), err)
+		}
+	}
+	return nil
+}
+
+// LoadFile is LoadObjects reading from the file at path.
+func LoadFile(ctx context.Context, c client.Client, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := LoadObjects(ctx, c, f); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// NewFakeClientFromYAML returns a fake client, see NewFakeClient, that
+// initially holds the objects decoded from r by DecodeObjects. Unlike
+// LoadObjects, the status of the objects is kept as written.
+func NewFakeClientFromYAML(r io.Reader) (client.WithWatch, error) {
+	objs, err := DecodeObjects(r)
+	if err != nil {
+		return nil, err
+	}
+	return NewFakeClient(objs...)
+}
+
+// isComment reports whether every non-blank line of doc is a YAML comment.
+func isComment(doc []byte) bool {
+	for _, line := range bytes.Split(doc, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) != 0 && line[0] != '#' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Package testing provides fakes and fixtures for unit testing controllers
+// written against the netoperator.vmware.com API:
+//
+//   - NewFakeClient returns a controller-runtime fake client with the
+//     netoperator.vmware.com v1alpha1 and v1alpha2 types and the built-in
+//     Kubernetes types registered. The fake client stores each version
+//     separately and does not convert between them.
+//   - NewFakeClientset returns the generated fake clientset.
+//   - DecodeObjects, LoadObjects and NewFakeClientFromYAML read multi-document
+//     YAML manifests of net-operator objects, so fixtures can be kept as files
+//     rather than built in Go.
+//
+// For example:
+//
+//	c, err := nettesting.NewFakeClientFromYAML(strings.NewReader(`
+//	apiVersion: netoperator.vmware.com/v1alpha1
+//	kind: Network
+//	metadata:
+//	  name: primary
+//	  namespace: ns
+//	spec:
+//	  type: vsphere-distributed
+//	`))
+//
+// Like pkg/client, this package is a separate Go module pinned to the
+// k8s.io/client-go minor version the clients were generated against.
+package testing
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"github.com/vmware-tanzu/net-operator-api/pkg/client/clientset_generated/clientset/fake"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// statusSubresources are the kinds whose CustomResourceDefinition enables the
+// status subresource. It must match the +kubebuilder:subresource:status
+// markers in api/v1alpha1 and api/v1alpha2, so that the fake client only updates their status
+// through the status client, as the kube-apiserver does.
+var statusSubresources = []client.Object{
+	&v1alpha1.AviLoadBalancerConfig{},
+	&v1alpha1.FoundationLoadBalancerConfig{},
+	&v1alpha1.HAProxyLoadBalancerConfig{},
+	&v1alpha1.IPAddressAllocation{},
+	&v1alpha1.IPPool{},
+	&v1alpha1.LoadBalancerConfig{},
+	&v1alpha1.NamespaceNetworkConfiguration{},
+	&v1alpha1.Network{},
+	&v1alpha1.NetworkInterface{},
+	&v1alpha1.VMXNET3NetworkInterface{},
+	&v1alpha1.VSphereDistributedNetwork{},
+	&v1alpha1.WorkloadNetworkConfiguration{},
+	&v1alpha2.AviLoadBalancerConfig{},
+	&v1alpha2.FoundationLoadBalancerConfig{},
+	&v1alpha2.HAProxyLoadBalancerConfig{},
+	&v1alpha2.IPAddressAllocation{},
+	&v1alpha2.IPPool{},
+	&v1alpha2.LoadBalancerConfig{},
+	&v1alpha2.NamespaceNetworkConfiguration{},
+	&v1alpha2.Network{},
+	&v1alpha2.NetworkInterface{},
+	&v1alpha2.VMXNET3NetworkInterface{},
+	&v1alpha2.VSphereDistributedNetwork{},
+	&v1alpha2.WorkloadNetworkConfiguration{},
+}
+
+// NewScheme returns a scheme with the built-in Kubernetes types and the
+// netoperator.vmware.com v1alpha1 and v1alpha2 types registered.
+func NewScheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if err := v1alpha2.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	return scheme, nil
+}
+
+// NewFakeClientBuilder returns a controller-runtime fake client builder that
+// uses the scheme returned by NewScheme and models the status subresource of
+// the net-operator kinds that have one. Use it instead of NewFakeClient to
+// configure the client further, for example with interceptors or indexes.
+func NewFakeClientBuilder() (*ctrlfake.ClientBuilder, error) {
+	scheme, err := NewScheme()
+	if err != nil {
+		return nil, err
+	}
+	return ctrlfake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(statusSubresources...), nil
+}
+
+// NewFakeClient returns a controller-runtime fake client that initially holds
+// objs. See NewFakeClientBuilder.
+func NewFakeClient(objs ...client.Object) (client.WithWatch, error) {
+	builder, err := NewFakeClientBuilder()
+	if err != nil {
+		return nil, err
+	}
+	return builder.WithObjects(objs...).Build(), nil
+}
+
+// NewFakeClientset returns the generated fake clientset that initially holds
+// objs. It serves every version of the netoperator.vmware.com group.
+func NewFakeClientset(objs ...runtime.Object) *fake.Clientset {
+	return fake.NewClientset(objs...)
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package testing_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	nettesting "github.com/vmware-tanzu/net-operator-api/pkg/testing"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const fixtures = "testdata/objects.yaml"
+
+func TestDecodeObjects(t *testing.T) {
+	f, err := os.Open(fixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	objs, err := nettesting.DecodeObjects(f)
+	if err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(objs) != 4 {
+		t.Fatalf("expected 4 objects, got %d", len(objs))
+	}
+	nif, ok := objs[1].(*v1alpha1.NetworkInterface)
+	if !ok {
+		t.Fatalf("expected a *v1alpha1.NetworkInterface, got %T", objs[1])
+	}
+	if nif.Spec.NetworkName != "primary" || len(nif.Status.IPConfigs) != 1 {
+		t.Errorf("unexpected NetworkInterface: %+v", nif)
+	}
+	if _, ok := objs[2].(*corev1.Namespace); !ok {
+		t.Errorf("expected a *corev1.Namespace, got %T", objs[2])
+	}
+	alloc, ok := objs[3].(*v1alpha2.IPAddressAllocation)
+	if !ok {
+		t.Fatalf("expected a *v1alpha2.IPAddressAllocation, got %T", objs[3])
+	}
+	if alloc.Spec.PoolRef.Name != "pool" || len(alloc.Status.Conditions) != 1 {
+		t.Errorf("unexpected IPAddressAllocation: %+v", alloc)
+	}
+}
+
+func TestDecodeObjects_UnknownKind(t *testing.T) {
+	_, err := nettesting.DecodeObjects(strings.NewReader(`
+apiVersion: netoperator.vmware.com/v1alpha1
+kind: Network
+metadata:
+  name: a
+---
+apiVersion: netoperator.vmware.com/v1alpha1
+kind: NoSuchKind
+metadata:
+  name: b
+`))
+	if err == nil || !strings.Contains(err.Error(), "document 1") {
+		t.Errorf("expected an error naming document 1, got %v", err)
+	}
+}
+
+func TestNewFakeClientFromYAML(t *testing.T) {
+	f, err := os.Open(fixtures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	c, err := nettesting.NewFakeClientFromYAML(f)
+	if err != nil {
+		t.Fatalf("new fake client: %v", err)
+	}
+	network := &v1alpha1.Network{}
+	if err := c.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: "primary"}, network); err != nil {
+		t.Fatalf("get network: %v", err)
+	}
+	if len(network.Status.Conditions) != 1 {
+		t.Errorf("expected the status to be kept, got %+v", network.Status)
+	}
+	alloc := &v1alpha2.IPAddressAllocation{}
+	if err := c.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: "vm-ip"}, alloc); err != nil {
+		t.Fatalf("get allocation: %v", err)
+	}
+	if alloc.Status.IPAddress != "192.168.1.20" {
+		t.Errorf("expected the status to be kept, got %+v", alloc.Status)
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	ctx := context.Background()
+	c, err := nettesting.NewFakeClient()
+	if err != nil {
+		t.Fatalf("new fake client: %v", err)
+	}
+	if err := nettesting.LoadFile(ctx, c, fixtures); err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	list := &v1alpha1.NetworkInterfaceList{}
+	if err := c.List(ctx, list, client.InNamespace("ns")); err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "vm-nic0" {
+		t.Errorf("expected NetworkInterface vm-nic0, got %+v", list.Items)
+	}
+
+	// Loading again fails on the first object that already exists.
+	if err := nettesting.LoadFile(ctx, c, fixtures); err == nil || !strings.Contains(err.Error(), "ns/primary") {
+		t.Errorf("expected an already exists error naming ns/primary, got %v", err)
+	}
+}
+
+func TestNewFakeClient_StatusSubresource(t *testing.T) {
+	ctx := context.Background()
+	network := &v1alpha1.Network{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "primary"},
+		Spec:       v1alpha1.NetworkSpec{Type: v1alpha1.NetworkTypeVDS},
+	}
+	c, err := nettesting.NewFakeClient(network)
+	if err != nil {
+		t.Fatalf("new fake client: %v", err)
+	}
+
+	network.Status.SupportedIPFamilies = []corev1.IPFamily{corev1.IPv4Protocol}
+	if err := c.Update(ctx, network); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(network), network); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if len(network.Status.SupportedIPFamilies) != 0 {
+		t.Errorf("expected Update to ignore the status, got %+v", network.Status)
+	}
+
+	network.Status.SupportedIPFamilies = []corev1.IPFamily{corev1.IPv4Protocol}
+	if err := c.Status().Update(ctx, network); err != nil {
+		t.Fatalf("update status: %v", err)
+	}
+	if err := c.Get(ctx, client.ObjectKeyFromObject(network), network); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if len(network.Status.SupportedIPFamilies) != 1 {
+		t.Errorf("expected the status to be updated, got %+v", network.Status)
+	}
+}
+
+func TestNewFakeClientset(t *testing.T) {
+	network := &v1alpha1.Network{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "primary"}}
+	cs := nettesting.NewFakeClientset(network)
+	got, err := cs.NetoperatorV1alpha1().Networks("ns").Get(context.Background(), "primary", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Name != "primary" {
+		t.Errorf("expected network primary, got %s", got.Name)
+	}
+}
+
+var statusMarker = regexp.MustCompile(`(?m)^// \+kubebuilder:subresource:status\n(?:(?://.*)?\n)*type (\w+) struct`)
+
+// TestStatusSubresourcesMatchMarkers checks that the fake client models the
+// status subresource of exactly the kinds whose CRD enables it.
+func TestStatusSubresourcesMatchMarkers(t *testing.T) {
+	var want []string
+	for _, version := range []string{"v1alpha1", "v1alpha2"} {
+		files, err := filepath.Glob(filepath.Join("..", "..", "api", version, "*_types.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, m := range statusMarker.FindAllSubmatch(data, -1) {
+				want = append(want, version+"."+string(m[1]))
+			}
+		}
+	}
+	sort.Strings(want)
+
+	got := nettesting.StatusSubresourceKinds()
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("status subresources do not match the +kubebuilder:subresource:status markers:\nwant: %v\ngot:  %v", want, got)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package validation
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateFoundationLoadBalancerConfig validates flb, and the transition from
+// old if old is not nil.
+func ValidateFoundationLoadBalancerConfig(old, flb *v1alpha1.FoundationLoadBalancerConfig) field.ErrorList {
+	allErrs := validateObjectMeta(&flb.ObjectMeta, true)
+
+	var oldSpec *v1alpha1.FoundationLoadBalancerConfigSpec
+	if old != nil {
+		oldSpec = &old.Spec
+	}
+	allErrs = append(allErrs, validateFoundationLoadBalancerConfigSpec(oldSpec, &flb.Spec, field.NewPath("spec"))...)
+	return append(allErrs, validateFoundationLoadBalancerConfigStatus(&flb.Status, field.NewPath("status"))...)
+}
+
+func validateFoundationLoadBalancerConfigSpec(old, spec *v1alpha1.FoundationLoadBalancerConfigSpec, fldPath *field.Path) field.ErrorList {
+	deployment := &spec.DeploymentSpec
+	deploymentPath := fldPath.Child("deploymentSpec")
+	allErrs := validateEnum(deploymentPath.Child("size"), deployment.Size,
+		v1alpha1.FoundationLoadBalancerSizeSmall, v1alpha1.FoundationLoadBalancerSizeMedium,
+		v1alpha1.FoundationLoadBalancerSizeLarge, v1alpha1.FoundationLoadBalancerSizeXL)
+	if deployment.StoragePolicy != "" {
+		allErrs = append(allErrs, validateString(deploymentPath.Child("storagePolicy"), deployment.StoragePolicy, 1, 253, nil)...)
+	}
+	zonesPath := deploymentPath.Child("zones")
+	allErrs = append(allErrs, validateMaxItems(zonesPath, len(deployment.Zones), 256)...)
+	for i, zone := range deployment.Zones {
+		allErrs = append(allErrs, validateString(zonesPath.Index(i), zone, -1, 253, nil)...)
+	}
+	allErrs = append(allErrs, validateEnum(deploymentPath.Child("availabilityMode"), deployment.AvailabilityMode,
+		v1alpha1.FoundationAvailabilityModeActivePassive, v1alpha1.FoundationAvailabilityModeSingleNode)...)
+	if ap := deployment.ActivePassiveAvailabilityMode; ap != nil {
+		allErrs = append(allErrs, validateRange(deploymentPath.Child("activePassiveSpec", "replicas"), ap.Replicas, 0, 2)...)
+	}
+	if sn := deployment.SingleNodeAvailabilityMode; sn != nil {
+		allErrs = append(allErrs, validateRange(deploymentPath.Child("singleNodeSpec", "replicas"), sn.Replicas, 0, 1)...)
+	}
+
+	if old != nil && old.DeploymentSpec.AvailabilityMode == v1alpha1.FoundationAvailabilityModeActivePassive &&
+		deployment.AvailabilityMode == v1alpha1.FoundationAvailabilityModeSingleNode {
+		allErrs = append(allErrs, field.Invalid(fldPath, deployment.AvailabilityMode, "cannot downgrade from active-passive to single-node"))
+	}
+	if deployment.ActivePassiveAvailabilityMode != nil && deployment.SingleNodeAvailabilityMode != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, deployment, "singleNodeSpec and activePassiveSpec are mutually exclusive"))
+	}
+
+	allErrs = append(allErrs, validateMaxItems(fldPath.Child("workloadNetworks"), len(spec.WorkloadNetworks), 1)...)
+
+	var oldNetworkSpec *v1alpha1.FoundationLoadBalancerNetworkConfigSpec
+	if old != nil {
+		oldNetworkSpec = &old.NetworkSpec
+	}
+	return append(allErrs, validateFoundationLoadBalancerNetworkConfigSpec(oldNetworkSpec, &spec.NetworkSpec, fldPath.Child("networkSpec"))...)
+}
+
+func validateFoundationLoadBalancerNetworkConfigSpec(old, spec *v1alpha1.FoundationLoadBalancerNetworkConfigSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	poolsPath := fldPath.Child("virtualServerIPPools")
+	allErrs = append(allErrs, validateMaxItems(poolsPath, len(spec.VirtualServerIPPools), 256)...)
+	for i, ref := range spec.VirtualServerIPPools {
+		allErrs = append(allErrs, validateString(poolsPath.Index(i).Child("name"), ref.Name, -1, 253, nil)...)
+	}
+	rangesPath := fldPath.Child("virtualServerIPRanges")
+	allErrs = append(allErrs, validateMaxItems(rangesPath, len(spec.VirtualServerIPRanges), 256)...)
+	for i := range spec.VirtualServerIPRanges {
+		allErrs = append(allErrs, ValidateIPRange(&spec.VirtualServerIPRanges[i], rangesPath.Index(i))...)
+	}
+	allErrs = append(allErrs, validateUniqueKeys(fldPath.Child("virtualServerSubnets"), spec.VirtualServerSubnets,
+		func(subnet string) string { return subnet })...)
+
+	hasPools := len(spec.VirtualServerIPPools) > 0
+	hasRanges := len(spec.VirtualServerIPRanges) > 0
+	if !hasPools && !hasRanges {
+		allErrs = append(allErrs, field.Invalid(fldPath, spec, "at least one of virtualServerIPPools or virtualServerIPRanges must be non-empty"))
+	}
+	if hasPools && hasRanges {
+		allErrs = append(allErrs, field.Invalid(fldPath, spec, "virtualServerIPPools and virtualServerIPRanges are mutually exclusive"))
+	}
+
+	if old == nil {
+		return allErrs
+	}
+	for _, oldPool := range old.VirtualServerIPPools {
+		if _, ok := correlate(spec.VirtualServerIPPools, ipPoolReferenceName, oldPool); !ok {
+			allErrs = append(allErrs, field.Invalid(fldPath, spec.VirtualServerIPPools, "entries may not be removed from virtualServerIPPools"))
+			break
+		}
+	}
+	for _, oldRange := range old.VirtualServerIPRanges {
+		if _, ok := correlate(spec.VirtualServerIPRanges, ipRangeStartingAddress, oldRange); !ok {
+			allErrs = append(allErrs, field.Invalid(fldPath, spec.VirtualServerIPRanges, "entries may not be removed from virtualServerIPRanges"))
+			break
+		}
+	}
+	return allErrs
+}
+
+func ipRangeStartingAddress(r v1alpha1.IPRange) string {
+	return r.StartingAddress
+}
+
+func validateFoundationLoadBalancerConfigStatus(status *v1alpha1.FoundationLoadBalancerConfigStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := validateConditions(fldPath.Child("conditions"), status.Conditions, -1)
+	allErrs = append(allErrs, validateUniqueKeys(fldPath.Child("nodes"), status.Nodes,
+		func(node v1alpha1.FoundationLoadBalancerNodeStatus) string { return node.NodeID })...)
+	poolsPath := fldPath.Child("effectiveVirtualServerIPPools")
+	allErrs = append(allErrs, validateMaxItems(poolsPath, len(status.EffectiveVirtualServerIPPools), 1024)...)
+	for i, pool := range status.EffectiveVirtualServerIPPools {
+		allErrs = append(allErrs, validateString(poolsPath.Index(i), pool, -1, 253, nil)...)
+	}
+	return allErrs
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package validation
+
+import (
+	"net/netip"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateIPRange validates r, an item of a list at fldPath such as the
+// virtualServerIPRanges of a FoundationLoadBalancerConfig. The lists of
+// IPRanges are atomic, so an IPRange has no previous value to check a
+// transition against.
+func ValidateIPRange(r *v1alpha1.IPRange, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	addressPath := fldPath.Child("startingAddress")
+	if r.StartingAddress == "" {
+		allErrs = append(allErrs, field.Required(addressPath, ""))
+	} else {
+		allErrs = append(allErrs, validateString(addressPath, r.StartingAddress, 3, 39, nil)...)
+		if !isIP(r.StartingAddress) {
+			allErrs = append(allErrs, field.Invalid(addressPath, r.StartingAddress, "startingAddress must be a valid IPv4 or IPv6 address"))
+		}
+	}
+	countPath := fldPath.Child("addressCount")
+	if r.AddressCount == 0 {
+		allErrs = append(allErrs, field.Required(countPath, ""))
+	} else if r.AddressCount < 1 {
+		allErrs = append(allErrs, field.Invalid(countPath, r.AddressCount, "should be greater than or equal to 1"),
+			field.Invalid(fldPath, r.AddressCount, "addressCount must be at least 1"))
+	}
+	return allErrs
+}
+
+// isIP mirrors the isIP function of the Kubernetes CEL library, which rejects
+// zones and IPv4-mapped IPv6 addresses.
+func isIP(s string) bool {
+	addr, err := netip.ParseAddr(s)
+	return err == nil && addr.Zone() == "" && !addr.Is4In6()
+}
+
+// ipFamily returns 4 or 6, as ip(s).family() of the Kubernetes CEL library
+// does, or 0 if isIP(s) is false.
+func ipFamily(s string) int {
+	if !isIP(s) {
+		return 0
+	}
+	if netip.MustParseAddr(s).Is4() {
+		return 4
+	}
+	return 6
+}
+
+// isIPv4CIDR mirrors isCIDR(s) && cidr(s).ip().family() == 4 of the Kubernetes
+// CEL library. The library rejects IPv4-mapped IPv6 prefixes, which are not
+// IPv4 prefixes either.
+func isIPv4CIDR(s string) bool {
+	prefix, err := netip.ParsePrefix(s)
+	return err == nil && prefix.Addr().Is4()
+}
@@ -0,0 +1,396 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package validation
+
+import (
+	"math/bits"
+	"slices"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var networkProviders = []v1alpha1.NetworkProvider{
+	v1alpha1.NetworkProviderVSphereDistributed,
+	v1alpha1.NetworkProviderNSXTier1,
+	v1alpha1.NetworkProviderVPC,
+}
+
+// ValidateNamespaceNetworkConfiguration validates nnc, and the transition from
+// old if old is not nil.
+func ValidateNamespaceNetworkConfiguration(old, nnc *v1alpha1.NamespaceNetworkConfiguration) field.ErrorList {
+	allErrs := validateObjectMeta(&nnc.ObjectMeta, false)
+	if len(nnc.Name) > 63 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "name"), nnc.Name,
+			"name must be 63 characters or fewer to be usable as a Kubernetes label value"))
+	}
+
+	// The spec is optional, and its transition rules only run when both the
+	// old and the new object have one.
+	if isSet(nnc.Spec) {
+		var oldSpec *v1alpha1.NamespaceNetworkSpec
+		if old != nil && isSet(old.Spec) {
+			oldSpec = &old.Spec
+		}
+		allErrs = append(allErrs, validateNamespaceNetworkSpec(oldSpec, &nnc.Spec, field.NewPath("spec"))...)
+	}
+
+	if nnc.Status != nil {
+		allErrs = append(allErrs, validateNamespaceNetworkStatus(nnc.Status, field.NewPath("status"))...)
+	}
+	return allErrs
+}
+
+func validateNamespaceNetworkSpec(old, spec *v1alpha1.NamespaceNetworkSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.Type == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), ""))
+	} else {
+		allErrs = append(allErrs, validateEnum(fldPath.Child("type"), spec.Type, networkProviders...)...)
+	}
+
+	if spec.Type == v1alpha1.NetworkProviderVSphereDistributed && len(spec.VSphereDistributedConfig.Networks) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("vsphereDistributedConfig"), spec.VSphereDistributedConfig,
+			"vsphereDistributedConfig.networks must contain at least one entry when type is vsphere-distributed"))
+	}
+	if spec.Type == v1alpha1.NetworkProviderVPC && spec.VPCConfig.VPC == "" && !isSet(spec.VPCConfig.AutoCreateConfig) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("vpcConfig"), spec.VPCConfig,
+			"vpcConfig must have either vpc (pre-created VPC mode) or autoCreateConfig (auto-create VPC mode) set when type is vpc"))
+	}
+	if spec.Type != v1alpha1.NetworkProviderVSphereDistributed && isSet(spec.VSphereDistributedConfig) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("vsphereDistributedConfig"), spec.VSphereDistributedConfig,
+			"vsphereDistributedConfig must not be populated when type is not vsphere-distributed"))
+	}
+	if spec.Type != v1alpha1.NetworkProviderVPC && isSet(spec.VPCConfig) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("vpcConfig"), spec.VPCConfig,
+			"vpcConfig must not be populated when type is not vpc"))
+	}
+	if spec.Type != v1alpha1.NetworkProviderNSXTier1 && spec.NSXTier1Config != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nsxTier1Config"), spec.NSXTier1Config,
+			"nsxTier1Config must not be populated when type is not nsx-tier1"))
+	}
+	if old != nil && (old.NSXTier1Config != nil) != (spec.NSXTier1Config != nil) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nsxTier1Config"), spec.NSXTier1Config,
+			"the presence of nsxTier1Config is immutable once the resource is created (cannot transition between inherit and override modes post-creation)"))
+	}
+
+	var oldConfig *v1alpha1.NamespaceNetworkConfig
+	if old != nil {
+		oldConfig = &old.NamespaceNetworkConfig
+	}
+	return append(allErrs, validateNamespaceNetworkConfig(oldConfig, &spec.NamespaceNetworkConfig, fldPath)...)
+}
+
+// validateNamespaceNetworkConfig validates the provider configurations that
+// are set in config. It is shared by NamespaceNetworkConfiguration and the
+// system configurations of WorkloadNetworkConfiguration.
+func validateNamespaceNetworkConfig(old, config *v1alpha1.NamespaceNetworkConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if isSet(config.VSphereDistributedConfig) {
+		var oldVDS *v1alpha1.VSphereDistributedConfig
+		if old != nil && isSet(old.VSphereDistributedConfig) {
+			oldVDS = &old.VSphereDistributedConfig
+		}
+		allErrs = append(allErrs, validateVSphereDistributedConfig(oldVDS, &config.VSphereDistributedConfig, fldPath.Child("vsphereDistributedConfig"))...)
+	}
+	if isSet(config.VPCConfig) {
+		var oldVPC *v1alpha1.VPCConfig
+		if old != nil && isSet(old.VPCConfig) {
+			oldVPC = &old.VPCConfig
+		}
+		allErrs = append(allErrs, validateVPCConfig(oldVPC, &config.VPCConfig, fldPath.Child("vpcConfig"))...)
+	}
+	if config.NSXTier1Config != nil {
+		var oldTier1 *v1alpha1.NSXTier1Config
+		if old != nil {
+			oldTier1 = old.NSXTier1Config
+		}
+		allErrs = append(allErrs, validateNSXTier1Config(oldTier1, config.NSXTier1Config, fldPath.Child("nsxTier1Config"))...)
+	}
+	return allErrs
+}
+
+func validateVSphereDistributedConfig(old, config *v1alpha1.VSphereDistributedConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	networksPath := fldPath.Child("networks")
+	if len(config.Networks) == 0 {
+		allErrs = append(allErrs, field.Required(networksPath, ""))
+	}
+	allErrs = append(allErrs, validateMaxItems(networksPath, len(config.Networks), 32)...)
+	for i, network := range config.Networks {
+		namePath := networksPath.Index(i).Child("name")
+		if network.Name == "" {
+			allErrs = append(allErrs, field.Required(namePath, ""))
+			continue
+		}
+		allErrs = append(allErrs, validateString(namePath, network.Name, 1, 253, dns1123SubdomainRegexp)...)
+	}
+	allErrs = append(allErrs, validateUniqueKeys(networksPath, config.Networks, vsphereDistributedNetworkRefName)...)
+
+	defaultNetworkPath := fldPath.Child("defaultNetwork")
+	if config.DefaultNetwork == "" {
+		allErrs = append(allErrs, field.Required(defaultNetworkPath, ""))
+	} else {
+		allErrs = append(allErrs, validateString(defaultNetworkPath, config.DefaultNetwork, 1, 253, dns1123SubdomainRegexp)...)
+	}
+
+	if _, ok := correlate(config.Networks, vsphereDistributedNetworkRefName, v1alpha1.VSphereDistributedNetworkRef{Name: config.DefaultNetwork}); !ok {
+		allErrs = append(allErrs, field.Invalid(fldPath, config.DefaultNetwork,
+			"defaultNetwork must match the name of one of the entries in networks"))
+	}
+	if old != nil && old.DefaultNetwork != "" && config.DefaultNetwork != old.DefaultNetwork {
+		allErrs = append(allErrs, field.Invalid(fldPath, config.DefaultNetwork, "defaultNetwork is immutable once set"))
+	}
+	return allErrs
+}
+
+func vsphereDistributedNetworkRefName(ref v1alpha1.VSphereDistributedNetworkRef) string {
+	return ref.Name
+}
+
+func validateVPCConfig(old, config *v1alpha1.VPCConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if config.VPC == "" && !isSet(config.AutoCreateConfig) && len(config.SharedSubnets) == 0 && config.DefaultSubnetSize == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, config, "should have at least 1 properties"))
+	}
+	if config.VPC != "" {
+		allErrs = append(allErrs, validateString(fldPath.Child("vpc"), config.VPC, 1, 2048, nil)...)
+	}
+	if config.VPC != "" && isSet(config.AutoCreateConfig) {
+		allErrs = append(allErrs, field.Invalid(fldPath, config,
+			"vpc and autoCreateConfig are mutually exclusive; set vpc for pre-created VPC mode or autoCreateConfig for auto-create VPC mode"))
+	}
+	if old != nil && old.VPC != "" && config.VPC != old.VPC {
+		allErrs = append(allErrs, field.Invalid(fldPath, config.VPC, "vpc is immutable once set"))
+	}
+
+	if isSet(config.AutoCreateConfig) {
+		var oldAutoCreate *v1alpha1.AutoCreateVPCConfig
+		if old != nil && isSet(old.AutoCreateConfig) {
+			oldAutoCreate = &old.AutoCreateConfig
+		}
+		allErrs = append(allErrs, validateAutoCreateVPCConfig(oldAutoCreate, &config.AutoCreateConfig, fldPath.Child("autoCreateConfig"))...)
+	}
+
+	sharedSubnetsPath := fldPath.Child("sharedSubnets")
+	allErrs = append(allErrs, validateMaxItems(sharedSubnetsPath, len(config.SharedSubnets), 32)...)
+	allErrs = append(allErrs, validateUniqueKeys(sharedSubnetsPath, config.SharedSubnets, sharedSubnetName)...)
+	var podDefaults, vmDefaults int
+	for i := range config.SharedSubnets {
+		subnet := &config.SharedSubnets[i]
+		var oldSubnet *v1alpha1.SharedSubnet
+		if old != nil {
+			if s, ok := correlate(old.SharedSubnets, sharedSubnetName, *subnet); ok {
+				oldSubnet = &s
+			}
+		}
+		allErrs = append(allErrs, validateSharedSubnet(oldSubnet, subnet, sharedSubnetsPath.Index(i))...)
+		if subnet.PodDefault == v1alpha1.SharedSubnetDefaultTrue {
+			podDefaults++
+		}
+		if subnet.VMDefault == v1alpha1.SharedSubnetDefaultTrue {
+			vmDefaults++
+		}
+	}
+	if podDefaults > 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, podDefaults, "at most one sharedSubnet may have podDefault set to True"))
+	}
+	if vmDefaults > 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath, vmDefaults, "at most one sharedSubnet may have vmDefault set to True"))
+	}
+	if podDefaults > 0 && config.VPC == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath, config.VPC, "vpc must be set when any sharedSubnet has podDefault set to True"))
+	}
+	if vmDefaults > 0 && config.VPC == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath, config.VPC, "vpc must be set when any sharedSubnet has vmDefault set to True"))
+	}
+
+	if size := config.DefaultSubnetSize; size != 0 {
+		sizePath := fldPath.Child("defaultSubnetSize")
+		allErrs = append(allErrs, validateRange(sizePath, size, 1, 65536)...)
+		if size > 0 && bits.OnesCount32(uint32(size)) != 1 {
+			allErrs = append(allErrs, field.Invalid(sizePath, size,
+				"defaultSubnetSize must be a power of 2 (e.g. 1, 2, 4, 8, 16, 32, 64, 128, 256, 512, 1024, 2048, 4096, 8192, 16384, 32768, 65536)"))
+		}
+	}
+	return allErrs
+}
+
+func sharedSubnetName(subnet v1alpha1.SharedSubnet) string {
+	return subnet.Name
+}
+
+func validateSharedSubnet(old, subnet *v1alpha1.SharedSubnet, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if subnet.Path == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("path"), ""))
+	} else {
+		allErrs = append(allErrs, validateString(fldPath.Child("path"), subnet.Path, 1, 2048, nil)...)
+	}
+	if subnet.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	} else {
+		allErrs = append(allErrs, validateString(fldPath.Child("name"), subnet.Name, 1, 253, dns1123SubdomainRegexp)...)
+	}
+	if subnet.PodDefault != "" {
+		allErrs = append(allErrs, validateEnum(fldPath.Child("podDefault"), subnet.PodDefault,
+			v1alpha1.SharedSubnetDefaultTrue, v1alpha1.SharedSubnetDefaultFalse)...)
+	}
+	if subnet.VMDefault != "" {
+		allErrs = append(allErrs, validateEnum(fldPath.Child("vmDefault"), subnet.VMDefault,
+			v1alpha1.SharedSubnetDefaultTrue, v1alpha1.SharedSubnetDefaultFalse)...)
+	}
+	if old != nil && old.Path != "" && subnet.Path != old.Path {
+		allErrs = append(allErrs, field.Invalid(fldPath, subnet.Path, "path is immutable once set"))
+	}
+	if old != nil && old.Name != "" && subnet.Name != old.Name {
+		allErrs = append(allErrs, field.Invalid(fldPath, subnet.Name, "name is immutable once set"))
+	}
+	return allErrs
+}
+
+func validateAutoCreateVPCConfig(old, config *v1alpha1.AutoCreateVPCConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if config.NSXProject == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("nsxProject"), ""))
+	} else {
+		allErrs = append(allErrs, validateString(fldPath.Child("nsxProject"), config.NSXProject, 1, 2048, nil)...)
+	}
+	if config.VPCConnectivityProfile == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("vpcConnectivityProfile"), ""))
+	} else {
+		allErrs = append(allErrs, validateString(fldPath.Child("vpcConnectivityProfile"), config.VPCConnectivityProfile, 1, 2048, nil)...)
+	}
+	var oldPrivateCIDRs []string
+	if old != nil {
+		oldPrivateCIDRs = old.PrivateCIDRs
+	}
+	allErrs = append(allErrs, validateCIDRs(fldPath.Child("privateCIDRs"), oldPrivateCIDRs, config.PrivateCIDRs,
+		"privateCIDRs must contain only valid IPv4 CIDRs")...)
+
+	if old == nil {
+		return allErrs
+	}
+	if old.NSXProject != "" && config.NSXProject != old.NSXProject {
+		allErrs = append(allErrs, field.Invalid(fldPath, config.NSXProject, "nsxProject is immutable once set"))
+	}
+	if old.VPCConnectivityProfile != "" && config.VPCConnectivityProfile != old.VPCConnectivityProfile {
+		allErrs = append(allErrs, field.Invalid(fldPath, config.VPCConnectivityProfile, "vpcConnectivityProfile is immutable once set"))
+	}
+	return append(allErrs, appendOnly(fldPath, old.PrivateCIDRs, config.PrivateCIDRs,
+		"privateCIDRs is append-only; existing entries cannot be removed")...)
+}
+
+func validateNSXTier1Config(old, config *v1alpha1.NSXTier1Config, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	prev := old
+	if prev == nil {
+		prev = &v1alpha1.NSXTier1Config{}
+	}
+	allErrs = append(allErrs, validateCIDRs(fldPath.Child("namespaceCIDRs"), prev.NamespaceCIDRs, config.NamespaceCIDRs,
+		"namespaceCIDRs must contain only valid IPv4 CIDRs")...)
+	allErrs = append(allErrs, validateCIDRs(fldPath.Child("ingressCIDRs"), prev.IngressCIDRs, config.IngressCIDRs,
+		"ingressCIDRs must contain only valid IPv4 CIDRs")...)
+	allErrs = append(allErrs, validateCIDRs(fldPath.Child("egressCIDRs"), prev.EgressCIDRs, config.EgressCIDRs,
+		"egressCIDRs must contain only valid IPv4 CIDRs")...)
+	if config.Tier0Gateway != "" {
+		allErrs = append(allErrs, validateString(fldPath.Child("tier0Gateway"), config.Tier0Gateway, 1, 2048, nil)...)
+	}
+	if config.SubnetPrefixLength != 0 {
+		allErrs = append(allErrs, validateRange(fldPath.Child("subnetPrefixLength"), config.SubnetPrefixLength, 1, 29)...)
+	}
+	if config.RoutingMode != "" {
+		allErrs = append(allErrs, validateEnum(fldPath.Child("routingMode"), config.RoutingMode,
+			v1alpha1.NSXTier1RoutingModeNAT, v1alpha1.NSXTier1RoutingModeRouted)...)
+	}
+	if config.LoadBalancerSize != "" {
+		allErrs = append(allErrs, validateEnum(fldPath.Child("loadBalancerSize"), config.LoadBalancerSize,
+			v1alpha1.NSXLoadBalancerSizeSmall, v1alpha1.NSXLoadBalancerSizeMedium, v1alpha1.NSXLoadBalancerSizeLarge)...)
+	}
+
+	hasTier0Gateway := config.Tier0Gateway != ""
+	hasNamespaceCIDRs := len(config.NamespaceCIDRs) > 0
+	hasIngressCIDRs := len(config.IngressCIDRs) > 0
+	hasEgressCIDRs := len(config.EgressCIDRs) > 0
+	if config.RoutingMode == v1alpha1.NSXTier1RoutingModeRouted && hasEgressCIDRs {
+		allErrs = append(allErrs, field.Invalid(fldPath, config.EgressCIDRs, "egressCIDRs must not be set when routingMode is Routed"))
+	}
+	if (hasTier0Gateway || hasIngressCIDRs || hasEgressCIDRs) && !hasNamespaceCIDRs {
+		allErrs = append(allErrs, field.Invalid(fldPath, config.NamespaceCIDRs,
+			"namespaceCIDRs must be set when tier0Gateway, ingressCIDRs, or egressCIDRs are specified"))
+	}
+	if (hasTier0Gateway || hasNamespaceCIDRs || hasEgressCIDRs) && !hasIngressCIDRs {
+		allErrs = append(allErrs, field.Invalid(fldPath, config.IngressCIDRs,
+			"ingressCIDRs must be set when tier0Gateway, namespaceCIDRs, or egressCIDRs are specified"))
+	}
+	if config.RoutingMode != v1alpha1.NSXTier1RoutingModeRouted && (hasTier0Gateway || hasNamespaceCIDRs || hasIngressCIDRs) && !hasEgressCIDRs {
+		allErrs = append(allErrs, field.Invalid(fldPath, config.EgressCIDRs,
+			"egressCIDRs must be set when routingMode is NAT and tier0Gateway, namespaceCIDRs, or ingressCIDRs are specified"))
+	}
+
+	if old == nil {
+		return allErrs
+	}
+	if old.Tier0Gateway != "" && config.Tier0Gateway != old.Tier0Gateway {
+		allErrs = append(allErrs, field.Invalid(fldPath, config.Tier0Gateway, "tier0Gateway is immutable once set"))
+	}
+	if old.RoutingMode != "" && config.RoutingMode != old.RoutingMode {
+		allErrs = append(allErrs, field.Invalid(fldPath, config.RoutingMode, "routingMode is immutable once set"))
+	}
+	if old.LoadBalancerSize != "" && config.LoadBalancerSize != old.LoadBalancerSize {
+		allErrs = append(allErrs, field.Invalid(fldPath, config.LoadBalancerSize, "loadBalancerSize is immutable once set"))
+	}
+	allErrs = append(allErrs, appendOnly(fldPath, old.NamespaceCIDRs, config.NamespaceCIDRs,
+		"namespaceCIDRs is append-only; existing entries cannot be removed")...)
+	allErrs = append(allErrs, appendOnly(fldPath, old.IngressCIDRs, config.IngressCIDRs,
+		"ingressCIDRs is append-only; existing entries cannot be removed")...)
+	allErrs = append(allErrs, appendOnly(fldPath, old.EgressCIDRs, config.EgressCIDRs,
+		"egressCIDRs is append-only; existing entries cannot be removed")...)
+	if old.SubnetPrefixLength != 0 && config.SubnetPrefixLength != old.SubnetPrefixLength {
+		allErrs = append(allErrs, field.Invalid(fldPath, config.SubnetPrefixLength, "subnetPrefixLength is immutable once set"))
+	}
+	return allErrs
+}
+
+// validateCIDRs checks a list of IPv4 CIDRs of the NSX configurations. The
+// entries of the previous list, old, are not checked again, so that the entries
+// admitted by the pattern the rules replaced can be kept.
+func validateCIDRs(fldPath *field.Path, old, cidrs []string, message string) field.ErrorList {
+	allErrs := validateMaxItems(fldPath, len(cidrs), 16)
+	for i, cidr := range cidrs {
+		allErrs = append(allErrs, validateString(fldPath.Index(i), cidr, -1, 64, nil)...)
+		if !isIPv4CIDR(cidr) && !slices.Contains(old, cidr) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), cidr, message))
+		}
+	}
+	return allErrs
+}
+
+func validateNamespaceNetworkStatus(status *v1alpha1.NamespaceNetworkStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := validateConditions(fldPath.Child("conditions"), status.Conditions, 32)
+
+	associatedPath := fldPath.Child("associatedNamespaces")
+	allErrs = append(allErrs, validateMaxItems(associatedPath, len(status.AssociatedNamespaces), 2048)...)
+	allErrs = append(allErrs, validateUniqueKeys(associatedPath, status.AssociatedNamespaces,
+		func(a v1alpha1.NamespaceNetworkAssociation) string { return a.Name })...)
+	for i, association := range status.AssociatedNamespaces {
+		idxPath := associatedPath.Index(i)
+		if association.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), ""))
+		} else {
+			allErrs = append(allErrs, validateString(idxPath.Child("name"), association.Name, 1, 63, dns1123LabelRegexp)...)
+		}
+		if association.Status == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("status"), ""))
+		} else {
+			allErrs = append(allErrs, validateEnum(idxPath.Child("status"), association.Status,
+				v1alpha1.NamespaceNetworkReconciling, v1alpha1.NamespaceNetworkReconciled)...)
+		}
+		if association.Message != "" {
+			allErrs = append(allErrs, validateString(idxPath.Child("message"), association.Message, 1, 2048, nil)...)
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package validation
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateNetworkSettings validates ns. NetworkSettings has no transition
+// rules, so old is accepted for symmetry with the other kinds and not used.
+func ValidateNetworkSettings(old, ns *v1alpha1.NetworkSettings) field.ErrorList {
+	allErrs := validateObjectMeta(&ns.ObjectMeta, true)
+	if ns.Provider == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("provider"), ""))
+	} else {
+		allErrs = append(allErrs, validateEnum(field.NewPath("provider"), ns.Provider, networkProviders...)...)
+	}
+	if ns.LegacyProvider != "" {
+		legacyPath := field.NewPath("legacyProvider")
+		allErrs = append(allErrs, validateEnum(legacyPath, ns.LegacyProvider, networkProviders...)...)
+		if ns.LegacyProvider != v1alpha1.NetworkProviderVSphereDistributed && ns.LegacyProvider != v1alpha1.NetworkProviderNSXTier1 {
+			allErrs = append(allErrs, field.Invalid(legacyPath, ns.LegacyProvider, "legacyProvider must be vsphere-distributed or nsx-tier1"))
+		}
+		if ns.LegacyProvider == ns.Provider {
+			allErrs = append(allErrs, field.Invalid(legacyPath, ns.LegacyProvider, "legacyProvider must differ from provider"))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Package validation checks netoperator.vmware.com/v1alpha1 objects against
+// the OpenAPI schema and CEL rules of their CRDs without an API server. It lets
+// tools such as GitOps pipelines reject a manifest before it reaches a
+// cluster:
+//
+//	if errs := validation.ValidateNamespaceNetworkConfiguration(nil, nnc); len(errs) > 0 {
+//		return errs.ToAggregate()
+//	}
+//
+// Every Validate function takes the old and the new object. The old object is
+// nil on create, and the transition rules, which compare a field with its
+// previous value, are only checked on update. CEL failures are reported with
+// the message of the rule.
+//
+// The objects are checked as the typed client sends them, after the CRD
+// defaults would be applied. Details that the Go types cannot represent, such
+// as an empty string for a field with omitempty, are not checked. The status
+// is checked as given, although the API server ignores it on create and keeps
+// the previous one on update for the kinds with a status subresource.
+//
+// ValidateVSphereDistributedNetworkAddresses goes beyond the CRD: it checks
+// that the gateways, address ranges and IPPools of a VSphereDistributedNetwork
+// are consistent with each other. Call it in addition to
+// ValidateVSphereDistributedNetwork.
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	dns1123SubdomainPattern = `^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	dns1123LabelPattern     = `^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+)
+
+var (
+	dns1123SubdomainRegexp = regexp.MustCompile(dns1123SubdomainPattern)
+	dns1123LabelRegexp     = regexp.MustCompile(dns1123LabelPattern)
+)
+
+// validateObjectMeta checks the metadata of an object as the API server does
+// for every custom resource.
+func validateObjectMeta(meta *metav1.ObjectMeta, namespaced bool) field.ErrorList {
+	return apivalidation.ValidateObjectMeta(meta, namespaced, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))
+}
+
+// isSet reports whether a field with omitempty or omitzero is sent.
+func isSet[T any](v T) bool {
+	return !reflect.ValueOf(&v).Elem().IsZero()
+}
+
+func validateEnum[T ~string](fldPath *field.Path, v T, values ...T) field.ErrorList {
+	if slices.Contains(values, v) {
+		return nil
+	}
+	supported := make([]string, len(values))
+	for i := range values {
+		supported[i] = string(values[i])
+	}
+	return field.ErrorList{field.NotSupported(fldPath, v, supported)}
+}
+
+// validateString checks the MinLength, MaxLength and Pattern of a string. A
+// negative length or a nil pattern is not checked.
+func validateString(fldPath *field.Path, s string, minLength, maxLength int, pattern *regexp.Regexp) field.ErrorList {
+	var allErrs field.ErrorList
+	if minLength >= 0 && len(s) < minLength {
+		allErrs = append(allErrs, field.Invalid(fldPath, s, fmt.Sprintf("should be at least %d chars long", minLength)))
+	}
+	if maxLength >= 0 && len(s) > maxLength {
+		allErrs = append(allErrs, field.TooLong(fldPath, s, maxLength))
+	}
+	if pattern != nil && !pattern.MatchString(s) {
+		allErrs = append(allErrs, field.Invalid(fldPath, s, fmt.Sprintf("should match '%s'", pattern)))
+	}
+	return allErrs
+}
+
+// validateRange checks the Minimum and Maximum of a number.
+func validateRange[T ~int32 | ~int64 | ~uint32](fldPath *field.Path, v, minimum, maximum T) field.ErrorList {
+	switch {
+	case v < minimum:
+		return field.ErrorList{field.Invalid(fldPath, v, fmt.Sprintf("should be greater than or equal to %d", minimum))}
+	case v > maximum:
+		return field.ErrorList{field.Invalid(fldPath, v, fmt.Sprintf("should be less than or equal to %d", maximum))}
+	}
+	return nil
+}
+
+func validateMaxItems(fldPath *field.Path, n, maxItems int) field.ErrorList {
+	if n > maxItems {
+		return field.ErrorList{field.TooMany(fldPath, n, maxItems)}
+	}
+	return nil
+}
+
+// validateUniqueKeys checks that the items of a list of type map or set have
+// distinct keys.
+func validateUniqueKeys[T any, K comparable](fldPath *field.Path, items []T, key func(T) K) field.ErrorList {
+	var allErrs field.ErrorList
+	seen := make(map[K]bool, len(items))
+	for i := range items {
+		k := key(items[i])
+		if seen[k] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), k))
+		}
+		seen[k] = true
+	}
+	return allErrs
+}
+
+// correlate returns the item of old with the same key as item, the way the
+// API server pairs the items of a list of type map for the transition rules.
+func correlate[T any, K comparable](old []T, key func(T) K, item T) (T, bool) {
+	for i := range old {
+		if key(old[i]) == key(item) {
+			return old[i], true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// appendOnly checks that every item of old is still in items.
+func appendOnly(fldPath *field.Path, old, items []string, message string) field.ErrorList {
+	for _, item := range old {
+		if !slices.Contains(items, item) {
+			return field.ErrorList{field.Invalid(fldPath, items, message)}
+		}
+	}
+	return nil
+}
+
+// validateConditions checks a list of metav1.Condition of type map keyed by
+// type.
+func validateConditions(fldPath *field.Path, conditions []metav1.Condition, maxItems int) field.ErrorList {
+	allErrs := metav1validation.ValidateConditions(conditions, fldPath)
+	if maxItems >= 0 {
+		allErrs = append(allErrs, validateMaxItems(fldPath, len(conditions), maxItems)...)
+	}
+	return allErrs
+}
@@ -0,0 +1,614 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package validation_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// check fails t unless errs is empty when want is empty, or has an error
+// whose text contains want otherwise.
+func check(t *testing.T, name string, errs field.ErrorList, want string) {
+	t.Helper()
+	if want == "" {
+		if len(errs) > 0 {
+			t.Errorf("%s: unexpected errors: %v", name, errs)
+		}
+		return
+	}
+	for _, err := range errs {
+		if strings.Contains(err.Error(), want) {
+			return
+		}
+	}
+	t.Errorf("%s: expected an error containing %q, got %v", name, want, errs)
+}
+
+func nsxTier1NNC(config *v1alpha1.NSXTier1Config) *v1alpha1.NamespaceNetworkConfiguration {
+	return &v1alpha1.NamespaceNetworkConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "nnc"},
+		Spec: v1alpha1.NamespaceNetworkSpec{
+			Type:                   v1alpha1.NetworkProviderNSXTier1,
+			NamespaceNetworkConfig: v1alpha1.NamespaceNetworkConfig{NSXTier1Config: config},
+		},
+	}
+}
+
+func fullNSXTier1Config() *v1alpha1.NSXTier1Config {
+	return &v1alpha1.NSXTier1Config{
+		NamespaceCIDRs: []string{"10.0.0.0/16"},
+		IngressCIDRs:   []string{"10.1.0.0/24"},
+		EgressCIDRs:    []string{"10.2.0.0/24"},
+		Tier0Gateway:   "/infra/tier-0s/t0",
+	}
+}
+
+func TestValidateNamespaceNetworkConfiguration(t *testing.T) {
+	vds := func(networks []string, defaultNetwork string) *v1alpha1.NamespaceNetworkConfiguration {
+		nnc := &v1alpha1.NamespaceNetworkConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "nnc"},
+			Spec:       v1alpha1.NamespaceNetworkSpec{Type: v1alpha1.NetworkProviderVSphereDistributed},
+		}
+		for _, name := range networks {
+			nnc.Spec.VSphereDistributedConfig.Networks = append(nnc.Spec.VSphereDistributedConfig.Networks,
+				v1alpha1.VSphereDistributedNetworkRef{Name: name})
+		}
+		nnc.Spec.VSphereDistributedConfig.DefaultNetwork = defaultNetwork
+		return nnc
+	}
+	vpc := func(config v1alpha1.VPCConfig) *v1alpha1.NamespaceNetworkConfiguration {
+		return &v1alpha1.NamespaceNetworkConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "nnc"},
+			Spec: v1alpha1.NamespaceNetworkSpec{
+				Type:                   v1alpha1.NetworkProviderVPC,
+				NamespaceNetworkConfig: v1alpha1.NamespaceNetworkConfig{VPCConfig: config},
+			},
+		}
+	}
+	routed := fullNSXTier1Config()
+	routed.RoutingMode = v1alpha1.NSXTier1RoutingModeRouted
+	longName := nsxTier1NNC(nil)
+	longName.Name = strings.Repeat("a", 64)
+
+	tests := []struct {
+		name    string
+		nnc     *v1alpha1.NamespaceNetworkConfiguration
+		wantErr string
+	}{
+		{"vds", vds([]string{"a", "b"}, "b"), ""},
+		{"vds default not in networks", vds([]string{"a"}, "b"), "defaultNetwork must match"},
+		{"vds duplicate networks", vds([]string{"a", "a"}, "a"), "Duplicate value"},
+		{"vds without networks", vds(nil, ""), "vsphereDistributedConfig.networks must contain at least one entry"},
+		{"vpc", vpc(v1alpha1.VPCConfig{VPC: "/orgs/default/projects/p/vpcs/v"}), ""},
+		{"vpc without vpc", vpc(v1alpha1.VPCConfig{DefaultSubnetSize: 16}), "vpcConfig must have either vpc"},
+		{"vpc subnet size", vpc(v1alpha1.VPCConfig{VPC: "v", DefaultSubnetSize: 24}), "defaultSubnetSize must be a power of 2"},
+		{"vpc exclusive", vpc(v1alpha1.VPCConfig{VPC: "v", AutoCreateConfig: v1alpha1.AutoCreateVPCConfig{NSXProject: "p", VPCConnectivityProfile: "c"}}), "mutually exclusive"},
+		{"vpc auto-create", vpc(v1alpha1.VPCConfig{AutoCreateConfig: v1alpha1.AutoCreateVPCConfig{NSXProject: "p", VPCConnectivityProfile: "c", PrivateCIDRs: []string{"172.16.0.0/16"}}}), ""},
+		{"vpc auto-create profile", vpc(v1alpha1.VPCConfig{AutoCreateConfig: v1alpha1.AutoCreateVPCConfig{NSXProject: "p"}}), "spec.vpcConfig.autoCreateConfig.vpcConnectivityProfile: Required value"},
+		{"vpc pod defaults", vpc(v1alpha1.VPCConfig{AutoCreateConfig: v1alpha1.AutoCreateVPCConfig{NSXProject: "p", VPCConnectivityProfile: "c"}, SharedSubnets: []v1alpha1.SharedSubnet{{Name: "s", Path: "/s", PodDefault: v1alpha1.SharedSubnetDefaultTrue}}}), "vpc must be set when any sharedSubnet has podDefault set to True"},
+		{"nsx-tier1 inherit", nsxTier1NNC(nil), ""},
+		{"nsx-tier1 override", nsxTier1NNC(fullNSXTier1Config()), ""},
+		{"nsx-tier1 partial override", nsxTier1NNC(&v1alpha1.NSXTier1Config{SubnetPrefixLength: 28}), ""},
+		{"nsx-tier1 prefix length", nsxTier1NNC(&v1alpha1.NSXTier1Config{SubnetPrefixLength: 30}), "should be less than or equal to 29"},
+		{"nsx-tier1 egress without namespace", nsxTier1NNC(&v1alpha1.NSXTier1Config{EgressCIDRs: []string{"10.2.0.0/24"}}), "namespaceCIDRs must be set"},
+		{"nsx-tier1 routed egress", nsxTier1NNC(routed), "egressCIDRs must not be set when routingMode is Routed"},
+		{"nsx-tier1 cidr", nsxTier1NNC(&v1alpha1.NSXTier1Config{NamespaceCIDRs: []string{"10.0.0.0"}}), "spec.nsxTier1Config.namespaceCIDRs[0]"},
+		{"nsx-tier1 cidr out of range", nsxTier1NNC(&v1alpha1.NSXTier1Config{NamespaceCIDRs: []string{"999.1.1.1/99"}}), "namespaceCIDRs must contain only valid IPv4 CIDRs"},
+		{"nsx-tier1 cidr leading zero", nsxTier1NNC(&v1alpha1.NSXTier1Config{NamespaceCIDRs: []string{"010.0.0.0/16"}}), "namespaceCIDRs must contain only valid IPv4 CIDRs"},
+		{"nsx-tier1 ipv6 cidr", nsxTier1NNC(&v1alpha1.NSXTier1Config{NamespaceCIDRs: []string{"fd00::/64"}}), "namespaceCIDRs must contain only valid IPv4 CIDRs"},
+		{"vpc auto-create cidr", vpc(v1alpha1.VPCConfig{AutoCreateConfig: v1alpha1.AutoCreateVPCConfig{NSXProject: "p", VPCConnectivityProfile: "c", PrivateCIDRs: []string{"172.16.0.0/33"}}}), "privateCIDRs must contain only valid IPv4 CIDRs"},
+		{"config of another type", func() *v1alpha1.NamespaceNetworkConfiguration {
+			nnc := vds([]string{"a"}, "a")
+			nnc.Spec.NSXTier1Config = &v1alpha1.NSXTier1Config{}
+			return nnc
+		}(), "nsxTier1Config must not be populated when type is not nsx-tier1"},
+		{"no spec", &v1alpha1.NamespaceNetworkConfiguration{ObjectMeta: metav1.ObjectMeta{Name: "nnc"}}, ""},
+		{"missing type", func() *v1alpha1.NamespaceNetworkConfiguration {
+			nnc := vds([]string{"a"}, "a")
+			nnc.Spec.Type = ""
+			return nnc
+		}(), "spec.type: Required value"},
+		{"long name", longName, "name must be 63 characters or fewer"},
+	}
+	for _, tt := range tests {
+		check(t, tt.name, validation.ValidateNamespaceNetworkConfiguration(nil, tt.nnc), tt.wantErr)
+	}
+}
+
+func TestValidateNamespaceNetworkConfigurationUpdate(t *testing.T) {
+	old := nsxTier1NNC(fullNSXTier1Config())
+	old.Spec.NSXTier1Config.RoutingMode = v1alpha1.NSXTier1RoutingModeNAT
+
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.NamespaceNetworkConfiguration)
+		wantErr string
+	}{
+		{"append CIDR", func(nnc *v1alpha1.NamespaceNetworkConfiguration) {
+			nnc.Spec.NSXTier1Config.NamespaceCIDRs = append(nnc.Spec.NSXTier1Config.NamespaceCIDRs, "10.3.0.0/16")
+		}, ""},
+		{"append invalid CIDR", func(nnc *v1alpha1.NamespaceNetworkConfiguration) {
+			nnc.Spec.NSXTier1Config.EgressCIDRs = append(nnc.Spec.NSXTier1Config.EgressCIDRs, "999.1.1.1/99")
+		}, "egressCIDRs must contain only valid IPv4 CIDRs"},
+		{"remove CIDR", func(nnc *v1alpha1.NamespaceNetworkConfiguration) {
+			nnc.Spec.NSXTier1Config.IngressCIDRs = []string{"10.4.0.0/24"}
+		}, "ingressCIDRs is append-only"},
+		{"change tier0Gateway", func(nnc *v1alpha1.NamespaceNetworkConfiguration) {
+			nnc.Spec.NSXTier1Config.Tier0Gateway = "/infra/tier-0s/other"
+		}, "tier0Gateway is immutable once set"},
+		{"change routingMode", func(nnc *v1alpha1.NamespaceNetworkConfiguration) {
+			nnc.Spec.NSXTier1Config.RoutingMode = v1alpha1.NSXTier1RoutingModeRouted
+			nnc.Spec.NSXTier1Config.EgressCIDRs = nil
+		}, "routingMode is immutable once set"},
+		{"set loadBalancerSize", func(nnc *v1alpha1.NamespaceNetworkConfiguration) {
+			nnc.Spec.NSXTier1Config.LoadBalancerSize = v1alpha1.NSXLoadBalancerSizeLarge
+		}, ""},
+		{"remove nsxTier1Config", func(nnc *v1alpha1.NamespaceNetworkConfiguration) {
+			nnc.Spec.NSXTier1Config = nil
+		}, "the presence of nsxTier1Config is immutable"},
+	}
+	for _, tt := range tests {
+		nnc := old.DeepCopy()
+		tt.update(nnc)
+		check(t, tt.name, validation.ValidateNamespaceNetworkConfiguration(old, nnc), tt.wantErr)
+	}
+
+	// The transition rules are not checked on create.
+	if errs := validation.ValidateNamespaceNetworkConfiguration(nil, nsxTier1NNC(&v1alpha1.NSXTier1Config{SubnetPrefixLength: 24})); len(errs) > 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+
+	// A CIDR stored before isCIDR replaced the pattern can be kept.
+	legacy := nsxTier1NNC(fullNSXTier1Config())
+	legacy.Spec.NSXTier1Config.NamespaceCIDRs = []string{"010.0.0.0/16"}
+	nnc := legacy.DeepCopy()
+	nnc.Spec.NSXTier1Config.NamespaceCIDRs = append(nnc.Spec.NSXTier1Config.NamespaceCIDRs, "10.3.0.0/16")
+	check(t, "keep legacy CIDR", validation.ValidateNamespaceNetworkConfiguration(legacy, nnc), "")
+}
+
+func TestValidateNamespaceNetworkConfigurationSharedSubnets(t *testing.T) {
+	old := &v1alpha1.NamespaceNetworkConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "nnc"},
+		Spec: v1alpha1.NamespaceNetworkSpec{
+			Type: v1alpha1.NetworkProviderVPC,
+			NamespaceNetworkConfig: v1alpha1.NamespaceNetworkConfig{VPCConfig: v1alpha1.VPCConfig{
+				VPC:           "v",
+				SharedSubnets: []v1alpha1.SharedSubnet{{Name: "a", Path: "/a"}, {Name: "b", Path: "/b"}},
+			}},
+		},
+	}
+	check(t, "valid", validation.ValidateNamespaceNetworkConfiguration(nil, old), "")
+
+	// Shared subnets are paired with their previous value by name.
+	nnc := old.DeepCopy()
+	nnc.Spec.VPCConfig.SharedSubnets = []v1alpha1.SharedSubnet{{Name: "b", Path: "/b"}, {Name: "c", Path: "/a"}}
+	check(t, "reorder", validation.ValidateNamespaceNetworkConfiguration(old, nnc), "")
+	nnc.Spec.VPCConfig.SharedSubnets[0].Path = "/c"
+	check(t, "change path", validation.ValidateNamespaceNetworkConfiguration(old, nnc), "spec.vpcConfig.sharedSubnets[0]: Invalid value: \"/c\": path is immutable once set")
+
+	nnc = old.DeepCopy()
+	nnc.Spec.VPCConfig.SharedSubnets[0].VMDefault = v1alpha1.SharedSubnetDefaultTrue
+	nnc.Spec.VPCConfig.SharedSubnets[1].VMDefault = v1alpha1.SharedSubnetDefaultTrue
+	check(t, "two VM defaults", validation.ValidateNamespaceNetworkConfiguration(nil, nnc), "at most one sharedSubnet may have vmDefault set to True")
+}
+
+func TestValidateNamespaceNetworkConfigurationStatus(t *testing.T) {
+	nnc := nsxTier1NNC(nil)
+	nnc.Status = &v1alpha1.NamespaceNetworkStatus{
+		Conditions: []metav1.Condition{{
+			Type:               v1alpha1.NamespaceNetworkConditionReady,
+			Status:             metav1.ConditionTrue,
+			Reason:             v1alpha1.NamespaceNetworkReasonReconciled,
+			LastTransitionTime: metav1.Now(),
+		}},
+		AssociatedNamespaces: []v1alpha1.NamespaceNetworkAssociation{
+			{Name: "ns-1", Status: v1alpha1.NamespaceNetworkReconciled},
+		},
+	}
+	check(t, "valid", validation.ValidateNamespaceNetworkConfiguration(nil, nnc), "")
+
+	nnc.Status.AssociatedNamespaces = append(nnc.Status.AssociatedNamespaces,
+		v1alpha1.NamespaceNetworkAssociation{Name: "ns-1", Status: "Unknown"})
+	errs := validation.ValidateNamespaceNetworkConfiguration(nil, nnc)
+	check(t, "duplicate", errs, "status.associatedNamespaces[1]: Duplicate value")
+	check(t, "status", errs, "status.associatedNamespaces[1].status: Unsupported value")
+}
+
+func TestValidateWorkloadNetworkConfiguration(t *testing.T) {
+	valid := func() *v1alpha1.WorkloadNetworkConfiguration {
+		return &v1alpha1.WorkloadNetworkConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: v1alpha1.WorkloadNetworkConfigurationName},
+			Spec: v1alpha1.WorkloadNetworkConfigurationSpec{
+				Providers: []v1alpha1.NetworkProviderEntry{
+					{Type: v1alpha1.NetworkProviderNSXTier1, SystemConfiguration: &v1alpha1.NamespaceNetworkConfig{NSXTier1Config: fullNSXTier1Config()}},
+					{Type: v1alpha1.NetworkProviderVPC, SystemConfiguration: &v1alpha1.NamespaceNetworkConfig{VPCConfig: v1alpha1.VPCConfig{VPC: "v"}}},
+				},
+				ActiveSystemProvider: v1alpha1.NetworkProviderNSXTier1,
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.WorkloadNetworkConfiguration)
+		wantErr string
+	}{
+		{"valid", func(*v1alpha1.WorkloadNetworkConfiguration) {}, ""},
+		{"name", func(wnc *v1alpha1.WorkloadNetworkConfiguration) {
+			wnc.Name = "other"
+		}, "WorkloadNetworkConfiguration must be named 'default'"},
+		{"active provider", func(wnc *v1alpha1.WorkloadNetworkConfiguration) {
+			wnc.Spec.ActiveSystemProvider = v1alpha1.NetworkProviderVSphereDistributed
+		}, "activeSystemProvider must reference a provider type declared in providers"},
+		{"duplicate provider", func(wnc *v1alpha1.WorkloadNetworkConfiguration) {
+			wnc.Spec.Providers[1].Type = v1alpha1.NetworkProviderNSXTier1
+		}, "spec.providers[1]: Duplicate value"},
+		{"missing config", func(wnc *v1alpha1.WorkloadNetworkConfiguration) {
+			wnc.Spec.Providers[1].SystemConfiguration = &v1alpha1.NamespaceNetworkConfig{}
+		}, "systemConfiguration.vpcConfig must be set when type is vpc"},
+		{"config of another type", func(wnc *v1alpha1.WorkloadNetworkConfiguration) {
+			wnc.Spec.Providers[1].SystemConfiguration.NSXTier1Config = &v1alpha1.NSXTier1Config{}
+		}, "systemConfiguration.nsxTier1Config may only be set when type is nsx-tier1"},
+		{"nested rule", func(wnc *v1alpha1.WorkloadNetworkConfiguration) {
+			wnc.Spec.Providers[0].SystemConfiguration.NSXTier1Config.IngressCIDRs = nil
+		}, "spec.providers[0].systemConfiguration.nsxTier1Config: Invalid value"},
+		{"no spec", func(wnc *v1alpha1.WorkloadNetworkConfiguration) {
+			wnc.Spec = v1alpha1.WorkloadNetworkConfigurationSpec{}
+		}, "spec: Required value"},
+	}
+	for _, tt := range tests {
+		wnc := valid()
+		tt.update(wnc)
+		check(t, tt.name, validation.ValidateWorkloadNetworkConfiguration(nil, wnc), tt.wantErr)
+	}
+
+	// Providers are paired with their previous value by type.
+	old := valid()
+	wnc := valid()
+	wnc.Spec.Providers[0], wnc.Spec.Providers[1] = wnc.Spec.Providers[1], wnc.Spec.Providers[0]
+	wnc.Spec.Providers[1].SystemConfiguration.NSXTier1Config.EgressCIDRs = []string{"10.5.0.0/24"}
+	check(t, "update", validation.ValidateWorkloadNetworkConfiguration(old, wnc),
+		"spec.providers[1].systemConfiguration.nsxTier1Config: Invalid value: []string{\"10.5.0.0/24\"}: egressCIDRs is append-only")
+}
+
+func TestValidateVSphereDistributedNetwork(t *testing.T) {
+	valid := func() *v1alpha1.VSphereDistributedNetwork {
+		return &v1alpha1.VSphereDistributedNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "vds"},
+			Spec: v1alpha1.VSphereDistributedNetworkSpec{
+				PortGroupID:      "dvportgroup-1",
+				IPAssignmentMode: v1alpha1.IPAssignmentModeStaticPool,
+				Gateway:          "10.0.0.1",
+				SubnetMask:       "255.255.255.0",
+				AddressRanges:    []v1alpha1.VSphereDistributedNetworkIPRange{{Address: "10.0.0.10", Count: 10}},
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.VSphereDistributedNetwork)
+		wantErr string
+	}{
+		{"valid", func(*v1alpha1.VSphereDistributedNetwork) {}, ""},
+		{"unset mode", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.IPAssignmentMode = ""
+		}, ""},
+		{"missing gateway", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.Gateway = ""
+		}, "Gateway is required when IpAssignmentMode is staticpool or unset"},
+		{"dhcp with pools", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.IPAssignmentMode = v1alpha1.IPAssignmentModeDHCP
+			vds.Spec.Gateway, vds.Spec.SubnetMask, vds.Spec.AddressRanges = "", "", nil
+			vds.Spec.IPPools = []v1alpha1.IPPoolReference{{Name: "pool"}}
+		}, "IPPools must be empty when IpAssignmentMode is dhcp or none"},
+		{"gateway", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.Gateway = "10.0.0.256"
+		}, "spec.gateway: Invalid value"},
+		{"gateway out of range", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.Gateway = "999.0.0.1"
+		}, "Gateway must be empty or a valid IPv4 address"},
+		{"ipv6 gateway in gateway", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.Gateway = "fd00::1"
+		}, "Gateway must be empty or a valid IPv4 address"},
+		{"subnet mask", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.SubnetMask = "255.255.255.256"
+		}, "SubnetMask must be empty or a valid IPv4 address"},
+		{"address", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.AddressRanges[0].Address = "fd00::g"
+		}, "Address must be a valid IPv4 or IPv6 address"},
+		{"address out of range", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.AddressRanges[0].Address = "999.0.0.10"
+		}, "Address must be a valid IPv4 or IPv6 address"},
+		{"address ipv4-mapped", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.AddressRanges[0].Address = "::ffff:10.0.0.10"
+		}, "Address must be a valid IPv4 or IPv6 address"},
+		{"address zone", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.AddressRanges[0].Address = "fe80::1%eth0"
+		}, "Address must be a valid IPv4 or IPv6 address"},
+		{"count", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.AddressRanges[0].Count = 0
+		}, "spec.addressRanges[0].count: Required value"},
+		{"name", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Name = "VDS"
+		}, "metadata.name must be a lowercase RFC 1123 DNS subdomain"},
+		{"ipv6 static", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			prefix := int32(64)
+			vds.Spec.IPv6AssignmentMode = v1alpha1.IPAssignmentModeStaticPool
+			vds.Spec.IPv6Gateway, vds.Spec.IPv6Prefix = "fd00::1", &prefix
+			vds.Spec.AddressRanges = append(vds.Spec.AddressRanges, v1alpha1.VSphereDistributedNetworkIPRange{Address: "fd00::100", Count: 256})
+		}, ""},
+		{"ipv6 mode", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.IPv6AssignmentMode = "slaac"
+		}, `spec.ipv6AssignmentMode: Invalid value: "slaac": IPv6AssignmentMode must be dhcp, staticpool or none`},
+		{"ipv6 gateway", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			prefix := int32(64)
+			vds.Spec.IPv6AssignmentMode = v1alpha1.IPAssignmentModeStaticPool
+			vds.Spec.IPv6Gateway, vds.Spec.IPv6Prefix = "10.0.0.1", &prefix
+		}, `spec.ipv6Gateway: Invalid value: "10.0.0.1": IPv6Gateway must be a valid IPv6 address`},
+		{"ipv6 gateway zone", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			prefix := int32(64)
+			vds.Spec.IPv6AssignmentMode = v1alpha1.IPAssignmentModeStaticPool
+			vds.Spec.IPv6Gateway, vds.Spec.IPv6Prefix = "fe80::1%eth0", &prefix
+		}, "IPv6Gateway must be a valid IPv6 address"},
+		{"ipv6 static without prefix", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.IPv6AssignmentMode = v1alpha1.IPAssignmentModeStaticPool
+			vds.Spec.IPv6Gateway = "fd00::1"
+		}, "IPv6Prefix is required when IPv6AssignmentMode is staticpool"},
+		{"ipv6 static without gateway", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			prefix := int32(64)
+			vds.Spec.IPv6AssignmentMode = v1alpha1.IPAssignmentModeStaticPool
+			vds.Spec.IPv6Prefix = &prefix
+		}, "IPv6Gateway is required when IPv6AssignmentMode is staticpool"},
+		{"ipv6 dhcp with prefix", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			prefix := int32(64)
+			vds.Spec.IPv6AssignmentMode = v1alpha1.IPAssignmentModeDHCP
+			vds.Spec.IPv6Prefix = &prefix
+		}, "IPv6Prefix must be unset when IPv6AssignmentMode is dhcp, none or unset"},
+		{"ipv6 unset with gateway", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.IPv6Gateway = "fd00::1"
+		}, "IPv6Gateway must be empty when IPv6AssignmentMode is dhcp, none or unset"},
+	}
+	for _, tt := range tests {
+		vds := valid()
+		tt.update(vds)
+		check(t, tt.name, validation.ValidateVSphereDistributedNetwork(nil, vds), tt.wantErr)
+	}
+
+	old := valid()
+	vds := valid()
+	vds.Spec.IPAssignmentMode = v1alpha1.IPAssignmentModeNone
+	vds.Spec.Gateway, vds.Spec.SubnetMask, vds.Spec.AddressRanges = "", "", nil
+	check(t, "immutable mode", validation.ValidateVSphereDistributedNetwork(old, vds), "ipAssignmentMode is immutable")
+
+	// A gateway stored before isIP replaced the patterns is kept as long as it
+	// does not change. An address range keeps any address that the patterns
+	// accepted, since its list cannot be compared to the stored one.
+	legacy := valid()
+	legacy.Spec.Gateway = "010.0.0.1"
+	legacy.Spec.AddressRanges[0].Address = "010.0.0.10"
+	vds = legacy.DeepCopy()
+	vds.Spec.IPPools = []v1alpha1.IPPoolReference{{Name: "pool"}}
+	check(t, "keep legacy addresses", validation.ValidateVSphereDistributedNetwork(legacy, vds), "")
+	vds = legacy.DeepCopy()
+	vds.Spec.Gateway = "010.0.0.2"
+	check(t, "change legacy gateway", validation.ValidateVSphereDistributedNetwork(legacy, vds), "Gateway must be empty or a valid IPv4 address")
+	vds = legacy.DeepCopy()
+	vds.Spec.AddressRanges = append(vds.Spec.AddressRanges, v1alpha1.VSphereDistributedNetworkIPRange{Address: "10.0.0.100", Count: 4})
+	check(t, "append to legacy address ranges", validation.ValidateVSphereDistributedNetwork(legacy, vds), "")
+	vds = legacy.DeepCopy()
+	vds.Spec.AddressRanges = append(vds.Spec.AddressRanges, v1alpha1.VSphereDistributedNetworkIPRange{Address: "999.0.0.100", Count: 4})
+	check(t, "append invalid address", validation.ValidateVSphereDistributedNetwork(legacy, vds), `spec.addressRanges[1].address: Invalid value: "999.0.0.100"`)
+
+	// IPv6 fields stored before the rules on ipv6AssignmentMode are kept as
+	// long as neither they nor the mode change.
+	prefix := int32(64)
+	legacy = valid()
+	legacy.Spec.IPv6Gateway, legacy.Spec.IPv6Prefix = "fd00::1", &prefix
+	vds = legacy.DeepCopy()
+	vds.Spec.IPPools = []v1alpha1.IPPoolReference{{Name: "pool"}}
+	check(t, "keep legacy ipv6 fields", validation.ValidateVSphereDistributedNetwork(legacy, vds), "")
+	vds = legacy.DeepCopy()
+	vds.Spec.IPv6Gateway = "fd00::2"
+	check(t, "change legacy ipv6 gateway", validation.ValidateVSphereDistributedNetwork(legacy, vds), "IPv6Gateway must be empty when IPv6AssignmentMode is dhcp, none or unset")
+	vds = legacy.DeepCopy()
+	vds.Spec.IPv6AssignmentMode = v1alpha1.IPAssignmentModeStaticPool
+	check(t, "set legacy ipv6 mode", validation.ValidateVSphereDistributedNetwork(legacy, vds), "")
+	legacy = valid()
+	legacy.Spec.IPv6AssignmentMode = "slaac"
+	vds = legacy.DeepCopy()
+	vds.Spec.IPPools = []v1alpha1.IPPoolReference{{Name: "pool"}}
+	check(t, "keep legacy ipv6 mode", validation.ValidateVSphereDistributedNetwork(legacy, vds), "")
+}
+
+func TestValidateVSphereDistributedNetworkAddresses(t *testing.T) {
+	prefix := int32(64)
+	valid := func() *v1alpha1.VSphereDistributedNetwork {
+		return &v1alpha1.VSphereDistributedNetwork{
+			ObjectMeta: metav1.ObjectMeta{Name: "vds"},
+			Spec: v1alpha1.VSphereDistributedNetworkSpec{
+				PortGroupID:        "dvportgroup-1",
+				IPAssignmentMode:   v1alpha1.IPAssignmentModeStaticPool,
+				IPv6AssignmentMode: v1alpha1.IPAssignmentModeStaticPool,
+				Gateway:            "10.0.0.1",
+				SubnetMask:         "255.255.255.0",
+				IPv6Gateway:        "fd00::1",
+				IPv6Prefix:         &prefix,
+				AddressRanges: []v1alpha1.VSphereDistributedNetworkIPRange{
+					{Address: "10.0.0.10", Count: 10},
+					{Address: "10.0.0.100", Count: 100},
+					{Address: "fd00::100", Count: 256},
+				},
+				IPPools: []v1alpha1.IPPoolReference{{Name: "pool-a"}, {Name: "pool-b"}, {Name: "unknown"}},
+			},
+		}
+	}
+	pools := []v1alpha1.IPPool{
+		{ObjectMeta: metav1.ObjectMeta{Name: "pool-a"}, Spec: v1alpha1.IPPoolSpec{StartingAddress: "10.0.0.10", AddressCount: 10}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "pool-b"}, Spec: v1alpha1.IPPoolSpec{StartingAddress: "10.0.0.100", AddressCount: 100}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "outside"}, Spec: v1alpha1.IPPoolSpec{StartingAddress: "10.0.1.0", AddressCount: 10}},
+	}
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.VSphereDistributedNetwork)
+		wantErr string
+	}{
+		{"valid", func(*v1alpha1.VSphereDistributedNetwork) {}, ""},
+		{"dhcp", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.IPAssignmentMode, vds.Spec.IPv6AssignmentMode = v1alpha1.IPAssignmentModeDHCP, v1alpha1.IPAssignmentModeDHCP
+			vds.Spec.Gateway, vds.Spec.SubnetMask, vds.Spec.IPv6Gateway, vds.Spec.IPv6Prefix = "", "", "", nil
+			vds.Spec.AddressRanges, vds.Spec.IPPools = nil, nil
+		}, ""},
+		{"non-contiguous mask", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.SubnetMask = "255.0.255.0"
+		}, `spec.subnetMask: Invalid value: "255.0.255.0": must be a contiguous netmask`},
+		{"network address gateway", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.Gateway = "10.0.0.0"
+		}, `spec.gateway: Invalid value: "10.0.0.0": must be a host address of subnet 10.0.0.0/24, not its network or broadcast address`},
+		{"broadcast address gateway", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.Gateway = "10.0.0.255"
+		}, `spec.gateway: Invalid value: "10.0.0.255": must be a host address`},
+		{"range outside subnet", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.AddressRanges[1].Count = 200
+		}, "spec.addressRanges[1]: Invalid value: v1alpha1.VSphereDistributedNetworkIPRange{Address:\"10.0.0.100\", Count:200}: range 10.0.0.100-10.0.1.43 is not within subnet 10.0.0.0/24"},
+		{"range with gateway", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.AddressRanges[0].Address = "10.0.0.1"
+		}, "spec.addressRanges[0]: Invalid value: v1alpha1.VSphereDistributedNetworkIPRange{Address:\"10.0.0.1\", Count:10}: range 10.0.0.1-10.0.0.10 includes the gateway 10.0.0.1"},
+		{"overlapping ranges", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.AddressRanges[1].Address = "10.0.0.15"
+		}, "range 10.0.0.15-10.0.0.114 overlaps range 10.0.0.10-10.0.0.19 of spec.addressRanges[0]"},
+		{"ipv6 range outside prefix", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.AddressRanges[2].Address = "fd00:0:0:1::100"
+		}, "spec.addressRanges[2]: Invalid value: v1alpha1.VSphereDistributedNetworkIPRange{Address:\"fd00:0:0:1::100\", Count:256}: range fd00:0:0:1::100-fd00:0:0:1::1ff is not within subnet fd00::/64"},
+		{"ipv6 range with gateway", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.IPv6Gateway = "fd00::180"
+		}, "spec.addressRanges[2]: Invalid value: v1alpha1.VSphereDistributedNetworkIPRange{Address:\"fd00::100\", Count:256}: range fd00::100-fd00::1ff includes the gateway fd00::180"},
+		{"ipv6 range without prefix", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.IPv6Prefix = nil
+			vds.Spec.AddressRanges[2].Address = "fd01::100"
+		}, ""},
+		{"pool outside subnet", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.IPPools[2].Name = "outside"
+		}, `spec.ipPools[2]: Invalid value: "outside": range 10.0.1.0-10.0.1.9 is not within subnet 10.0.0.0/24`},
+		{"overlapping pools", func(vds *v1alpha1.VSphereDistributedNetwork) {
+			vds.Spec.IPPools[1].Name = "pool-a"
+		}, `spec.ipPools[1]: Invalid value: "pool-a": range 10.0.0.10-10.0.0.19 overlaps range 10.0.0.10-10.0.0.19 of spec.ipPools[0]`},
+	}
+	for _, tt := range tests {
+		vds := valid()
+		tt.update(vds)
+		check(t, tt.name, validation.ValidateVSphereDistributedNetworkAddresses(vds, pools), tt.wantErr)
+	}
+}
+
+func TestValidateFoundationLoadBalancerConfig(t *testing.T) {
+	valid := func() *v1alpha1.FoundationLoadBalancerConfig {
+		return &v1alpha1.FoundationLoadBalancerConfig{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "flb"},
+			Spec: v1alpha1.FoundationLoadBalancerConfigSpec{
+				DeploymentSpec: v1alpha1.FoundationLoadBalancerDeploymentSpec{
+					Size:                          v1alpha1.FoundationLoadBalancerSizeSmall,
+					AvailabilityMode:              v1alpha1.FoundationAvailabilityModeActivePassive,
+					ActivePassiveAvailabilityMode: &v1alpha1.ActivePassiveAvailabilityMode{Replicas: 2},
+				},
+				VirtualIPNetwork: v1alpha1.NetworkReference{Kind: "Network", Name: "vip"},
+				NetworkSpec: v1alpha1.FoundationLoadBalancerNetworkConfigSpec{
+					VirtualServerIPRanges: []v1alpha1.IPRange{{StartingAddress: "10.0.0.1", AddressCount: 4}},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name    string
+		update  func(*v1alpha1.FoundationLoadBalancerConfig)
+		wantErr string
+	}{
+		{"valid", func(*v1alpha1.FoundationLoadBalancerConfig) {}, ""},
+		{"size", func(flb *v1alpha1.FoundationLoadBalancerConfig) {
+			flb.Spec.DeploymentSpec.Size = ""
+		}, "spec.deploymentSpec.size: Unsupported value"},
+		{"replicas", func(flb *v1alpha1.FoundationLoadBalancerConfig) {
+			flb.Spec.DeploymentSpec.ActivePassiveAvailabilityMode.Replicas = 3
+		}, "should be less than or equal to 2"},
+		{"both specs", func(flb *v1alpha1.FoundationLoadBalancerConfig) {
+			flb.Spec.DeploymentSpec.SingleNodeAvailabilityMode = &v1alpha1.SingleNodeAvailabilityMode{Replicas: 1}
+		}, "singleNodeSpec and activePassiveSpec are mutually exclusive"},
+		{"pools and ranges", func(flb *v1alpha1.FoundationLoadBalancerConfig) {
+			flb.Spec.NetworkSpec.VirtualServerIPPools = []v1alpha1.IPPoolReference{{Name: "pool"}}
+		}, "virtualServerIPPools and virtualServerIPRanges are mutually exclusive"},
+		{"no pools nor ranges", func(flb *v1alpha1.FoundationLoadBalancerConfig) {
+			flb.Spec.NetworkSpec.VirtualServerIPRanges = nil
+		}, "at least one of virtualServerIPPools or virtualServerIPRanges must be non-empty"},
+		{"starting address", func(flb *v1alpha1.FoundationLoadBalancerConfig) {
+			flb.Spec.NetworkSpec.VirtualServerIPRanges[0].StartingAddress = "::ffff:10.0.0.1"
+		}, "startingAddress must be a valid IPv4 or IPv6 address"},
+		{"duplicate subnet", func(flb *v1alpha1.FoundationLoadBalancerConfig) {
+			flb.Spec.NetworkSpec.VirtualServerSubnets = []string{"10.0.0.0/24", "10.0.0.0/24"}
+		}, "spec.networkSpec.virtualServerSubnets[1]: Duplicate value"},
+		{"no namespace", func(flb *v1alpha1.FoundationLoadBalancerConfig) {
+			flb.Namespace = ""
+		}, "metadata.namespace: Required value"},
+	}
+	for _, tt := range tests {
+		flb := valid()
+		tt.update(flb)
+		check(t, tt.name, validation.ValidateFoundationLoadBalancerConfig(nil, flb), tt.wantErr)
+	}
+
+	old := valid()
+	flb := valid()
+	flb.Spec.DeploymentSpec.AvailabilityMode = v1alpha1.FoundationAvailabilityModeSingleNode
+	flb.Spec.DeploymentSpec.ActivePassiveAvailabilityMode = nil
+	flb.Spec.NetworkSpec.VirtualServerIPRanges[0].StartingAddress = "10.0.1.1"
+	errs := validation.ValidateFoundationLoadBalancerConfig(old, flb)
+	check(t, "downgrade", errs, "cannot downgrade from active-passive to single-node")
+	check(t, "remove range", errs, "entries may not be removed from virtualServerIPRanges")
+}
+
+func TestValidateNetworkSettings(t *testing.T) {
+	tests := []struct {
+		name             string
+		provider, legacy v1alpha1.NetworkProvider
+		wantErr          string
+	}{
+		{"provider", v1alpha1.NetworkProviderVPC, "", ""},
+		{"transition", v1alpha1.NetworkProviderVPC, v1alpha1.NetworkProviderNSXTier1, ""},
+		{"same provider", v1alpha1.NetworkProviderNSXTier1, v1alpha1.NetworkProviderNSXTier1, "legacyProvider must differ from provider"},
+		{"legacy vpc", v1alpha1.NetworkProviderNSXTier1, v1alpha1.NetworkProviderVPC, "legacyProvider must be vsphere-distributed or nsx-tier1"},
+		{"missing provider", "", "", "provider: Required value"},
+		{"unknown provider", "nsx", "", "provider: Unsupported value"},
+	}
+	for _, tt := range tests {
+		ns := &v1alpha1.NetworkSettings{
+			ObjectMeta:     metav1.ObjectMeta{Namespace: "ns", Name: "settings"},
+			Provider:       tt.provider,
+			LegacyProvider: tt.legacy,
+		}
+		check(t, tt.name, validation.ValidateNetworkSettings(nil, ns), tt.wantErr)
+	}
+}
+
+func TestValidateIPRange(t *testing.T) {
+	tests := []struct {
+		address string
+		count   int64
+		wantErr string
+	}{
+		{"10.0.0.1", 1, ""},
+		{"fd00::1", 1 << 40, ""},
+		{"10.0.0.01", 1, "startingAddress must be a valid IPv4 or IPv6 address"},
+		{"fe80::1%eth0", 1, "startingAddress must be a valid IPv4 or IPv6 address"},
+		{"10.0.0.1", -1, "addressCount must be at least 1"},
+		{"", 1, "ranges[0].startingAddress: Required value"},
+	}
+	for _, tt := range tests {
+		r := &v1alpha1.IPRange{StartingAddress: tt.address, AddressCount: tt.count}
+		check(t, tt.address, validation.ValidateIPRange(r, field.NewPath("ranges").Index(0)), tt.wantErr)
+	}
+}
@@ -0,0 +1,339 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package validation
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"regexp"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/iprange"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// The patterns that addressRanges addresses had to match before isIP. An
+// address that matches one of them stays valid.
+var (
+	legacyIPv4AddressRegexp = regexp.MustCompile(`^((25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)$`)
+	legacyIPv6AddressRegexp = regexp.MustCompile(`^(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:))$`)
+)
+
+// ValidateVSphereDistributedNetwork validates vds, and the transition from old
+// if old is not nil.
+func ValidateVSphereDistributedNetwork(old, vds *v1alpha1.VSphereDistributedNetwork) field.ErrorList {
+	allErrs := validateObjectMeta(&vds.ObjectMeta, false)
+	if len(vds.Name) > 253 || !dns1123SubdomainRegexp.MatchString(vds.Name) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "name"), vds.Name,
+			"metadata.name must be a lowercase RFC 1123 DNS subdomain (alphanumeric or '-' or '.', each segment starting/ending with alphanumeric; max 253 characters)"))
+	}
+
+	var oldSpec *v1alpha1.VSphereDistributedNetworkSpec
+	if old != nil {
+		oldSpec = &old.Spec
+	}
+	allErrs = append(allErrs, validateVSphereDistributedNetworkSpec(oldSpec, &vds.Spec, field.NewPath("spec"))...)
+	return append(allErrs, validateVSphereDistributedNetworkStatus(&vds.Status, field.NewPath("status"))...)
+}
+
+func validateVSphereDistributedNetworkSpec(old, spec *v1alpha1.VSphereDistributedNetworkSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	// The rules on addresses let a value that has not changed keep passing,
+	// and on create nothing has a previous value.
+	prev := old
+	if prev == nil {
+		prev = &v1alpha1.VSphereDistributedNetworkSpec{}
+	}
+
+	modePath := fldPath.Child("ipAssignmentMode")
+	if spec.IPAssignmentMode != "" {
+		allErrs = append(allErrs, validateEnum(modePath, spec.IPAssignmentMode,
+			v1alpha1.IPAssignmentModeDHCP, v1alpha1.IPAssignmentModeStaticPool, v1alpha1.IPAssignmentModeNone)...)
+		if old != nil && old.IPAssignmentMode != "" && spec.IPAssignmentMode != old.IPAssignmentMode {
+			allErrs = append(allErrs, field.Invalid(modePath, spec.IPAssignmentMode, "ipAssignmentMode is immutable"))
+		}
+	}
+
+	if spec.IPv6AssignmentMode != "" && spec.IPv6AssignmentMode != prev.IPv6AssignmentMode {
+		switch spec.IPv6AssignmentMode {
+		case v1alpha1.IPAssignmentModeDHCP, v1alpha1.IPAssignmentModeStaticPool, v1alpha1.IPAssignmentModeNone:
+		default:
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ipv6AssignmentMode"), spec.IPv6AssignmentMode,
+				"IPv6AssignmentMode must be dhcp, staticpool or none"))
+		}
+	}
+	ipv6GatewayPath := fldPath.Child("ipv6Gateway")
+	allErrs = append(allErrs, validateString(ipv6GatewayPath, spec.IPv6Gateway, -1, 45, nil)...)
+	allErrs = append(allErrs, validateIPOrEmpty(ipv6GatewayPath, prev.IPv6Gateway, spec.IPv6Gateway, 6,
+		"IPv6Gateway must be a valid IPv6 address")...)
+
+	ipPoolsPath := fldPath.Child("ipPools")
+	allErrs = append(allErrs, validateUniqueKeys(ipPoolsPath, spec.IPPools, ipPoolReferenceName)...)
+	for i, ref := range spec.IPPools {
+		allErrs = append(allErrs, validateString(ipPoolsPath.Index(i).Child("name"), ref.Name, -1, 253, nil)...)
+	}
+	gatewayPath := fldPath.Child("gateway")
+	allErrs = append(allErrs, validateString(gatewayPath, spec.Gateway, -1, 15, nil)...)
+	allErrs = append(allErrs, validateIPOrEmpty(gatewayPath, prev.Gateway, spec.Gateway, 4,
+		"Gateway must be empty or a valid IPv4 address")...)
+	subnetMaskPath := fldPath.Child("subnetMask")
+	allErrs = append(allErrs, validateString(subnetMaskPath, spec.SubnetMask, -1, 15, nil)...)
+	allErrs = append(allErrs, validateIPOrEmpty(subnetMaskPath, prev.SubnetMask, spec.SubnetMask, 4,
+		"SubnetMask must be empty or a valid IPv4 address")...)
+	if spec.IPv6Prefix != nil {
+		allErrs = append(allErrs, validateRange(fldPath.Child("ipv6Prefix"), *spec.IPv6Prefix, 0, 128)...)
+	}
+
+	rangesPath := fldPath.Child("addressRanges")
+	allErrs = append(allErrs, validateMaxItems(rangesPath, len(spec.AddressRanges), 1024)...)
+	for i, r := range spec.AddressRanges {
+		idxPath := rangesPath.Index(i)
+		if r.Address == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("address"), ""))
+		} else {
+			allErrs = append(allErrs, validateString(idxPath.Child("address"), r.Address, 2, 45, nil)...)
+			if !isIP(r.Address) && !legacyIPv4AddressRegexp.MatchString(r.Address) && !legacyIPv6AddressRegexp.MatchString(r.Address) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("address"), r.Address, "Address must be a valid IPv4 or IPv6 address"))
+			}
+		}
+		if r.Count == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("count"), ""))
+		} else if r.Count < 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("count"), r.Count, "should be greater than or equal to 1"))
+		}
+	}
+
+	switch spec.IPAssignmentMode {
+	case v1alpha1.IPAssignmentModeDHCP, v1alpha1.IPAssignmentModeNone:
+		if spec.Gateway != "" {
+			allErrs = append(allErrs, field.Invalid(fldPath, spec.Gateway, "Gateway must be empty when IpAssignmentMode is dhcp or none"))
+		}
+		if spec.SubnetMask != "" {
+			allErrs = append(allErrs, field.Invalid(fldPath, spec.SubnetMask, "SubnetMask must be empty when IpAssignmentMode is dhcp or none"))
+		}
+		if len(spec.AddressRanges) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath, spec.AddressRanges, "AddressRanges must be empty when IpAssignmentMode is dhcp or none"))
+		}
+		if len(spec.IPPools) > 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath, spec.IPPools, "IPPools must be empty when IpAssignmentMode is dhcp or none"))
+		}
+	case "", v1alpha1.IPAssignmentModeStaticPool:
+		if spec.Gateway == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath, spec.Gateway, "Gateway is required when IpAssignmentMode is staticpool or unset"))
+		}
+		if spec.SubnetMask == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath, spec.SubnetMask, "SubnetMask is required when IpAssignmentMode is staticpool or unset"))
+		}
+	}
+
+	// Unlike ipAssignmentMode, an unset ipv6AssignmentMode means none. An
+	// object stored before these rules were added keeps passing them until
+	// the mode or the field checked changes.
+	checkIPv6Gateway := old == nil || spec.IPv6AssignmentMode != old.IPv6AssignmentMode || spec.IPv6Gateway != old.IPv6Gateway
+	checkIPv6Prefix := old == nil || spec.IPv6AssignmentMode != old.IPv6AssignmentMode || !equalPtr(spec.IPv6Prefix, old.IPv6Prefix)
+	switch spec.IPv6AssignmentMode {
+	case "", v1alpha1.IPAssignmentModeDHCP, v1alpha1.IPAssignmentModeNone:
+		if checkIPv6Gateway && spec.IPv6Gateway != "" {
+			allErrs = append(allErrs, field.Invalid(fldPath, spec.IPv6Gateway, "IPv6Gateway must be empty when IPv6AssignmentMode is dhcp, none or unset"))
+		}
+		if checkIPv6Prefix && spec.IPv6Prefix != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, *spec.IPv6Prefix, "IPv6Prefix must be unset when IPv6AssignmentMode is dhcp, none or unset"))
+		}
+	case v1alpha1.IPAssignmentModeStaticPool:
+		if checkIPv6Gateway && spec.IPv6Gateway == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath, spec.IPv6Gateway, "IPv6Gateway is required when IPv6AssignmentMode is staticpool"))
+		}
+		if checkIPv6Prefix && spec.IPv6Prefix == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, spec.IPv6Prefix, "IPv6Prefix is required when IPv6AssignmentMode is staticpool"))
+		}
+	}
+	return allErrs
+}
+
+// equalPtr reports whether a and b are both nil or point to equal values.
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// validateIPOrEmpty checks that s is empty or an IP address of the given
+// family, unless s is unchanged from old.
+func validateIPOrEmpty(fldPath *field.Path, old, s string, family int, message string) field.ErrorList {
+	if s == "" || s == old || ipFamily(s) == family {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, s, message)}
+}
+
+func ipPoolReferenceName(ref v1alpha1.IPPoolReference) string {
+	return ref.Name
+}
+
+func validateVSphereDistributedNetworkStatus(status *v1alpha1.VSphereDistributedNetworkStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := validateUniqueKeys(fldPath.Child("conditions"), status.Conditions,
+		func(c v1alpha1.VSphereDistributedNetworkCondition) v1alpha1.VSphereDistributedNetworkConditionType {
+			return c.Type
+		})
+	if status.DefaultPortConfig == nil {
+		return allErrs
+	}
+
+	portConfigPath := fldPath.Child("defaultPortConfig")
+	if vlan := status.DefaultPortConfig.Vlan; vlan != nil {
+		vlanPath := portConfigPath.Child("vlan")
+		allErrs = append(allErrs, validateEnum(vlanPath.Child("type"), vlan.Type,
+			v1alpha1.VLANTypeStandard, v1alpha1.VLANTypeTrunk, v1alpha1.VLANTypePrivate)...)
+		if vlan.VlanID != nil {
+			allErrs = append(allErrs, validateRange(vlanPath.Child("vlanID"), *vlan.VlanID, 0, 4094)...)
+		}
+		for i, r := range vlan.TrunkRange {
+			allErrs = append(allErrs, validateRange(vlanPath.Child("trunkRange").Index(i).Child("start"), r.Start, 0, 4094)...)
+			allErrs = append(allErrs, validateRange(vlanPath.Child("trunkRange").Index(i).Child("end"), r.End, 0, 4094)...)
+		}
+	}
+	if policy := status.DefaultPortConfig.MacManagementPolicy; policy != nil && policy.MacLearningPolicy != nil {
+		learningPath := portConfigPath.Child("macManagementPolicy", "macLearningPolicy")
+		if limit := policy.MacLearningPolicy.Limit; limit != nil {
+			allErrs = append(allErrs, validateRange(learningPath.Child("limit"), *limit, 0, 4096)...)
+		}
+		if limitPolicy := policy.MacLearningPolicy.LimitPolicy; limitPolicy != nil {
+			allErrs = append(allErrs, validateEnum(learningPath.Child("limitPolicy"), *limitPolicy,
+				v1alpha1.MacLimitPolicyAllow, v1alpha1.MacLimitPolicyDrop)...)
+		}
+	}
+	return allErrs
+}
+
+// ValidateVSphereDistributedNetworkAddresses checks the addresses of vds
+// against each other, which the CRD schema does not do:
+//
+//   - subnetMask must be a contiguous netmask, and gateway must be a host
+//     address, neither the network nor the broadcast address, of the subnet
+//     they imply
+//   - every addressRanges entry, and the spec of every IPPool in ipPools, must
+//     be within the IPv4 or IPv6 subnet of its family and must not include the
+//     gateway of that family
+//   - addressRanges entries must not overlap each other, and neither must the
+//     IPPools
+//
+// pools holds the IPPools that ipPools may reference; a reference to an IPPool
+// that is not in pools is not checked. A range of a family without a subnet,
+// and fields that do not parse, which ValidateVSphereDistributedNetwork
+// reports, are not checked either.
+func ValidateVSphereDistributedNetworkAddresses(vds *v1alpha1.VSphereDistributedNetwork, pools []v1alpha1.IPPool) field.ErrorList {
+	spec := &vds.Spec
+	fldPath := field.NewPath("spec")
+	var allErrs field.ErrorList
+
+	// The subnets keep the address of the gateway; see netip.Prefix.Masked.
+	var subnet4, subnet6 netip.Prefix
+	if gateway, err := netip.ParseAddr(spec.Gateway); err == nil && gateway.Is4() && spec.SubnetMask != "" {
+		var errs field.ErrorList
+		subnet4, errs = ipv4Subnet(gateway, spec.SubnetMask, fldPath)
+		allErrs = append(allErrs, errs...)
+	}
+	if gateway, err := netip.ParseAddr(spec.IPv6Gateway); err == nil && gateway.Is6() && gateway.Zone() == "" && spec.IPv6Prefix != nil {
+		subnet6 = netip.PrefixFrom(gateway, int(*spec.IPv6Prefix))
+	}
+
+	checkRange := func(idxPath *field.Path, value interface{}, r iprange.Range) field.ErrorList {
+		subnet := subnet6
+		if r.Is4() {
+			subnet = subnet4
+		}
+		if !subnet.IsValid() {
+			return nil
+		}
+		gateway := subnet.Addr()
+		var errs field.ErrorList
+		if !iprange.FromPrefix(subnet.Masked()).ContainsRange(r) {
+			errs = append(errs, field.Invalid(idxPath, value, fmt.Sprintf("range %s is not within subnet %s", r, subnet.Masked())))
+		}
+		if r.Contains(gateway) {
+			errs = append(errs, field.Invalid(idxPath, value, fmt.Sprintf("range %s includes the gateway %s", r, gateway)))
+		}
+		return errs
+	}
+
+	rangesPath := fldPath.Child("addressRanges")
+	var ranges []indexedRange
+	for i := range spec.AddressRanges {
+		r, err := spec.AddressRanges[i].Range()
+		if err != nil {
+			continue
+		}
+		idxPath := rangesPath.Index(i)
+		allErrs = append(allErrs, checkRange(idxPath, spec.AddressRanges[i], r)...)
+		allErrs = append(allErrs, checkOverlap(idxPath, spec.AddressRanges[i], r, ranges, rangesPath)...)
+		ranges = append(ranges, indexedRange{index: i, r: r})
+	}
+
+	poolSpecs := make(map[string]*v1alpha1.IPPoolSpec, len(pools))
+	for i := range pools {
+		poolSpecs[pools[i].Name] = &pools[i].Spec
+	}
+	poolsPath := fldPath.Child("ipPools")
+	var poolRanges []indexedRange
+	for i, ref := range spec.IPPools {
+		poolSpec, ok := poolSpecs[ref.Name]
+		if !ok {
+			continue
+		}
+		r, err := poolSpec.Range()
+		if err != nil {
+			continue
+		}
+		idxPath := poolsPath.Index(i)
+		allErrs = append(allErrs, checkRange(idxPath, ref.Name, r)...)
+		allErrs = append(allErrs, checkOverlap(idxPath, ref.Name, r, poolRanges, poolsPath)...)
+		poolRanges = append(poolRanges, indexedRange{index: i, r: r})
+	}
+	return allErrs
+}
+
+// ipv4Subnet returns the subnet of gateway with the netmask mask, and an error
+// if mask is not a contiguous netmask or gateway is not a host address of the
+// subnet.
+func ipv4Subnet(gateway netip.Addr, mask string, fldPath *field.Path) (netip.Prefix, field.ErrorList) {
+	maskAddr, err := netip.ParseAddr(mask)
+	if err != nil || !maskAddr.Is4() {
+		return netip.Prefix{}, nil
+	}
+	maskBytes := maskAddr.As4()
+	ones, bits := net.IPMask(maskBytes[:]).Size()
+	if bits == 0 {
+		return netip.Prefix{}, field.ErrorList{field.Invalid(fldPath.Child("subnetMask"), mask, "must be a contiguous netmask")}
+	}
+	subnet := netip.PrefixFrom(gateway, ones)
+	// /31 and /32 subnets have no network or broadcast address.
+	if ones < 31 {
+		r := iprange.FromPrefix(subnet.Masked())
+		if gateway == r.First || gateway == r.Last {
+			return subnet, field.ErrorList{field.Invalid(fldPath.Child("gateway"), gateway.String(),
+				fmt.Sprintf("must be a host address of subnet %s, not its network or broadcast address", subnet.Masked()))}
+		}
+	}
+	return subnet, nil
+}
+
+type indexedRange struct {
+	index int
+	r     iprange.Range
+}
+
+// checkOverlap returns an error for every range of others that r overlaps.
+func checkOverlap(idxPath *field.Path, value interface{}, r iprange.Range, others []indexedRange, listPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, other := range others {
+		if r.Overlaps(other.r) {
+			allErrs = append(allErrs, field.Invalid(idxPath, value,
+				fmt.Sprintf("range %s overlaps range %s of %s", r, other.r, listPath.Index(other.index))))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package validation
+
+import (
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateWorkloadNetworkConfiguration validates wnc, and the transition from
+// old if old is not nil.
+func ValidateWorkloadNetworkConfiguration(old, wnc *v1alpha1.WorkloadNetworkConfiguration) field.ErrorList {
+	allErrs := validateObjectMeta(&wnc.ObjectMeta, false)
+	if wnc.Name != v1alpha1.WorkloadNetworkConfigurationName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "name"), wnc.Name,
+			"WorkloadNetworkConfiguration must be named 'default'"))
+	}
+
+	specPath := field.NewPath("spec")
+	if !isSet(wnc.Spec) {
+		allErrs = append(allErrs, field.Required(specPath, ""))
+	} else {
+		var oldSpec *v1alpha1.WorkloadNetworkConfigurationSpec
+		if old != nil && isSet(old.Spec) {
+			oldSpec = &old.Spec
+		}
+		allErrs = append(allErrs, validateWorkloadNetworkConfigurationSpec(oldSpec, &wnc.Spec, specPath)...)
+	}
+
+	if wnc.Status != nil {
+		allErrs = append(allErrs, validateConditions(field.NewPath("status", "conditions"), wnc.Status.Conditions, 8)...)
+	}
+	return allErrs
+}
+
+func validateWorkloadNetworkConfigurationSpec(old, spec *v1alpha1.WorkloadNetworkConfigurationSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	providersPath := fldPath.Child("providers")
+	if len(spec.Providers) == 0 {
+		allErrs = append(allErrs, field.Required(providersPath, ""))
+	}
+	allErrs = append(allErrs, validateMaxItems(providersPath, len(spec.Providers), 3)...)
+	allErrs = append(allErrs, validateUniqueKeys(providersPath, spec.Providers, networkProviderEntryType)...)
+	for i := range spec.Providers {
+		entry := &spec.Providers[i]
+		var oldEntry *v1alpha1.NetworkProviderEntry
+		if old != nil {
+			if e, ok := correlate(old.Providers, networkProviderEntryType, *entry); ok {
+				oldEntry = &e
+			}
+		}
+		allErrs = append(allErrs, validateNetworkProviderEntry(oldEntry, entry, providersPath.Index(i))...)
+	}
+
+	activePath := fldPath.Child("activeSystemProvider")
+	if spec.ActiveSystemProvider == "" {
+		allErrs = append(allErrs, field.Required(activePath, ""))
+	} else {
+		allErrs = append(allErrs, validateEnum(activePath, spec.ActiveSystemProvider, networkProviders...)...)
+	}
+	if _, ok := correlate(spec.Providers, networkProviderEntryType, v1alpha1.NetworkProviderEntry{Type: spec.ActiveSystemProvider}); !ok {
+		allErrs = append(allErrs, field.Invalid(fldPath, spec.ActiveSystemProvider,
+			"activeSystemProvider must reference a provider type declared in providers"))
+	}
+	return allErrs
+}
+
+func networkProviderEntryType(entry v1alpha1.NetworkProviderEntry) v1alpha1.NetworkProvider {
+	return entry.Type
+}
+
+func validateNetworkProviderEntry(old, entry *v1alpha1.NetworkProviderEntry, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if entry.Type == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), ""))
+	} else {
+		allErrs = append(allErrs, validateEnum(fldPath.Child("type"), entry.Type, networkProviders...)...)
+	}
+	configPath := fldPath.Child("systemConfiguration")
+	if entry.SystemConfiguration == nil {
+		return append(allErrs, field.Required(configPath, ""))
+	}
+
+	config := entry.SystemConfiguration
+	if entry.Type == v1alpha1.NetworkProviderVSphereDistributed && !isSet(config.VSphereDistributedConfig) {
+		allErrs = append(allErrs, field.Invalid(fldPath, entry.Type,
+			"systemConfiguration.vsphereDistributedConfig must be set when type is vsphere-distributed"))
+	}
+	if entry.Type != v1alpha1.NetworkProviderVSphereDistributed && isSet(config.VSphereDistributedConfig) {
+		allErrs = append(allErrs, field.Invalid(fldPath, entry.Type,
+			"systemConfiguration.vsphereDistributedConfig may only be set when type is vsphere-distributed"))
+	}
+	if entry.Type == v1alpha1.NetworkProviderVPC && !isSet(config.VPCConfig) {
+		allErrs = append(allErrs, field.Invalid(fldPath, entry.Type,
+			"systemConfiguration.vpcConfig must be set when type is vpc"))
+	}
+	if entry.Type != v1alpha1.NetworkProviderVPC && isSet(config.VPCConfig) {
+		allErrs = append(allErrs, field.Invalid(fldPath, entry.Type,
+			"systemConfiguration.vpcConfig may only be set when type is vpc"))
+	}
+	if entry.Type != v1alpha1.NetworkProviderNSXTier1 && config.NSXTier1Config != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, entry.Type,
+			"systemConfiguration.nsxTier1Config may only be set when type is nsx-tier1"))
+	}
+
+	var oldConfig *v1alpha1.NamespaceNetworkConfig
+	if old != nil {
+		oldConfig = old.SystemConfiguration
+	}
+	return append(allErrs, validateNamespaceNetworkConfig(oldConfig, config, configPath)...)
+}
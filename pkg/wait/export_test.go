@@ -0,0 +1,18 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package wait
+
+import (
+	"testing"
+	"time"
+)
+
+// SetRestartBackoff sets the initial backoff between restarts of a watch for
+// the duration of the test.
+func SetRestartBackoff(t *testing.T, d time.Duration) {
+	old := restartBackoff
+	restartBackoff.Duration = d
+	t.Cleanup(func() { restartBackoff = old })
+}
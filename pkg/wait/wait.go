@@ -0,0 +1,221 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Package wait blocks until net-operator has realized a NetworkInterface or an
+// IPAddressAllocation. The functions watch the object rather than poll it, and
+// return as soon as its Ready condition is True or its Failure condition
+// appears:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+//	defer cancel()
+//	ipConfigs, err := wait.WaitForNetworkInterfaceReady(ctx, c, client.ObjectKeyFromObject(nif))
+//	if errors.Is(err, v1alpha1.ErrCannotAllocIP) {
+//		// The IPPool of the network is exhausted.
+//	}
+//
+// The object does not have to exist yet when waiting starts. The functions
+// return a NotFound error if it is deleted while waiting, and the error of ctx
+// if it is done first. A watch the server ends is restarted with a backoff,
+// unless it ends with an error that restarting cannot fix, such as Forbidden,
+// which is returned.
+//
+// The package is a separate Go module, so that the API types module does not
+// depend on controller-runtime and client-go.
+package wait
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/conditions"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilwait "k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// restartBackoff is the backoff between restarts of a watch, the one the
+// client-go reflector uses. It is reset once a watch has not been restarted
+// for restartBackoffReset.
+var restartBackoff = utilwait.Backoff{
+	Duration: 800 * time.Millisecond,
+	Factor:   2,
+	Jitter:   1,
+	Steps:    math.MaxInt32,
+	Cap:      30 * time.Second,
+}
+
+const restartBackoffReset = 2 * time.Minute
+
+// FailureError is returned when the object waited for has a Failure condition
+// with status True. It wraps the error for the reason of the condition, such as
+// v1alpha1.ErrCannotAllocIP, so errors.Is tells the failures apart.
+type FailureError struct {
+	// Kind is the kind of the object, such as NetworkInterface.
+	Kind string
+	// Key identifies the object.
+	Key client.ObjectKey
+	// Reason and Message are those of the Failure condition.
+	Reason  string
+	Message string
+
+	// err is the error returned by v1alpha1.ErrorFromConditions.
+	err error
+}
+
+func newFailureError(kind string, key client.ObjectKey, err error) *FailureError {
+	failure := &FailureError{Kind: kind, Key: key, err: err}
+	var conditionErr *v1alpha1.ConditionError
+	if errors.As(err, &conditionErr) {
+		failure.Reason = conditionErr.Reason
+		failure.Message = conditionErr.Message
+	}
+	return failure
+}
+
+func (e *FailureError) Error() string {
+	msg := fmt.Sprintf("%s %s failed", e.Kind, e.Key)
+	if e.Reason != "" {
+		msg += ": " + e.Reason
+	}
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Unwrap returns the error for the Failure condition, a
+// *v1alpha1.ConditionError.
+func (e *FailureError) Unwrap() error {
+	return e.err
+}
+
+// WaitForNetworkInterfaceReady waits until the NetworkInterface identified by
+// key is Ready and returns its IPConfigs. It returns a *FailureError if the
+// NetworkInterface has a Failure condition.
+func WaitForNetworkInterfaceReady(ctx context.Context, c client.WithWatch, key client.ObjectKey) ([]v1alpha1.IPConfig, error) {
+	var ipConfigs []v1alpha1.IPConfig
+	err := waitFor(ctx, c, key, v1alpha1.SchemeGroupVersion.WithResource("networkinterfaces").GroupResource(), &v1alpha1.NetworkInterface{}, &v1alpha1.NetworkInterfaceList{},
+		func(nif *v1alpha1.NetworkInterface) (bool, error) {
+			if err := v1alpha1.ErrorFromConditions(&nif.Status); err != nil {
+				return false, newFailureError("NetworkInterface", key, err)
+			}
+			if !conditions.IsTrue(nif.Status.Conditions, v1alpha1.NetworkInterfaceReady) {
+				return false, nil
+			}
+			ipConfigs = nif.Status.IPConfigs
+			return true, nil
+		})
+	return ipConfigs, err
+}
+
+// WaitForIPAddressAllocation waits until the IPAddressAllocation identified by
+// key is Ready and returns its IPAddress. It returns a *FailureError if the
+// IPAddressAllocation has a Failure condition.
+func WaitForIPAddressAllocation(ctx context.Context, c client.WithWatch, key client.ObjectKey) (string, error) {
+	var ipAddress string
+	err := waitFor(ctx, c, key, v1alpha1.SchemeGroupVersion.WithResource("ipaddressallocations").GroupResource(), &v1alpha1.IPAddressAllocation{}, &v1alpha1.IPAddressAllocationList{},
+		func(alloc *v1alpha1.IPAddressAllocation) (bool, error) {
+			if err := v1alpha1.ErrorFromConditions(&alloc.Status); err != nil {
+				return false, newFailureError("IPAddressAllocation", key, err)
+			}
+			if !conditions.IsTrue(alloc.Status.Conditions, v1alpha1.IPAddressAllocationReady) {
+				return false, nil
+			}
+			ipAddress = alloc.Status.IPAddress
+			return true, nil
+		})
+	return ipAddress, err
+}
+
+// waitFor watches the object identified by key until done returns true or an
+// error. resource, obj and list are the resource and empty objects of its
+// kind. The watch is started before the object is read, so that no update is
+// missed, and is restarted with a backoff if the server closes it or ends it
+// with a retriable error.
+func waitFor[T client.Object](ctx context.Context, c client.WithWatch, key client.ObjectKey, resource schema.GroupResource, obj T, list client.ObjectList, done func(T) (bool, error)) error {
+	check := func(o T) (bool, error) {
+		if o.GetNamespace() != key.Namespace || o.GetName() != key.Name {
+			return false, nil
+		}
+		return done(o)
+	}
+
+	backoff := restartBackoff.DelayWithReset(clock.RealClock{}, restartBackoffReset)
+	for {
+		w, err := c.Watch(ctx, list,
+			client.InNamespace(key.Namespace),
+			client.MatchingFields{"metadata.name": key.Name})
+		if err != nil {
+			return err
+		}
+
+		finished, err := func() (bool, error) {
+			defer w.Stop()
+
+			current := obj.DeepCopyObject().(T)
+			if err := c.Get(ctx, key, current); err == nil {
+				if ok, err := check(current); ok || err != nil {
+					return true, err
+				}
+			} else if !apierrors.IsNotFound(err) {
+				return true, err
+			}
+
+			for {
+				select {
+				case <-ctx.Done():
+					return true, fmt.Errorf("waiting for %s: %w", key, ctx.Err())
+				case event, ok := <-w.ResultChan():
+					if !ok {
+						// The server closed the watch, start a new one.
+						return false, nil
+					}
+					switch event.Type {
+					case watch.Added, watch.Modified:
+						if o, isT := event.Object.(T); isT {
+							if ok, err := check(o); ok || err != nil {
+								return true, err
+							}
+						}
+					case watch.Deleted:
+						if o, isT := event.Object.(T); isT && o.GetNamespace() == key.Namespace && o.GetName() == key.Name {
+							return true, apierrors.NewNotFound(resource, key.Name)
+						}
+					case watch.Error:
+						if err := apierrors.FromObject(event.Object); !isRetriable(err) {
+							return true, err
+						}
+						// The watch ended with a retriable error, start a new one.
+						return false, nil
+					}
+				}
+			}
+		}()
+		if finished {
+			return err
+		}
+
+		t := time.NewTimer(backoff())
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return fmt.Errorf("waiting for %s: %w", key, ctx.Err())
+		case <-t.C:
+		}
+	}
+}
+
+// isRetriable reports whether a watch that ended with err can be restarted.
+func isRetriable(err error) bool {
+	return apierrors.IsResourceExpired(err) || apierrors.IsGone(err) ||
+		apierrors.IsTimeout(err) || apierrors.IsServerTimeout(err) ||
+		apierrors.IsTooManyRequests(err) || apierrors.IsInternalError(err)
+}
@@ -0,0 +1,261 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package wait_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/wait"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var key = client.ObjectKey{Namespace: "ns", Name: "obj"}
+
+// newClient returns a fake client holding objs and a channel that receives a
+// value every time a watch is started.
+func newClient(t *testing.T, objs ...client.Object) (client.WithWatch, <-chan struct{}) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("add to scheme: %v", err)
+	}
+	watching := make(chan struct{}, 10)
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			Watch: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+				w, err := c.Watch(ctx, list, opts...)
+				watching <- struct{}{}
+				return w, err
+			},
+		}).
+		Build()
+	return c, watching
+}
+
+func newContext(t *testing.T) context.Context {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+	return ctx
+}
+
+func networkInterface(conditions ...v1alpha1.NetworkInterfaceCondition) *v1alpha1.NetworkInterface {
+	return &v1alpha1.NetworkInterface{
+		ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+		Status: v1alpha1.NetworkInterfaceStatus{
+			Conditions: conditions,
+			IPConfigs:  []v1alpha1.IPConfig{{IP: "192.168.1.10", IPFamily: corev1.IPv4Protocol}},
+		},
+	}
+}
+
+func TestWaitForNetworkInterfaceReady_AlreadyReady(t *testing.T) {
+	c, _ := newClient(t, networkInterface(v1alpha1.NetworkInterfaceCondition{Type: v1alpha1.NetworkInterfaceReady, Status: corev1.ConditionTrue}))
+	ipConfigs, err := wait.WaitForNetworkInterfaceReady(newContext(t), c, key)
+	if err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+	if len(ipConfigs) != 1 || ipConfigs[0].IP != "192.168.1.10" {
+		t.Errorf("unexpected IPConfigs %+v", ipConfigs)
+	}
+}
+
+func TestWaitForNetworkInterfaceReady_BecomesReady(t *testing.T) {
+	c, watching := newClient(t)
+	ctx := newContext(t)
+	go func() {
+		<-watching
+		nif := networkInterface()
+		if err := c.Create(ctx, nif); err != nil {
+			t.Errorf("create: %v", err)
+			return
+		}
+		nif.Status.Conditions = []v1alpha1.NetworkInterfaceCondition{{Type: v1alpha1.NetworkInterfaceReady, Status: corev1.ConditionTrue}}
+		if err := c.Update(ctx, nif); err != nil {
+			t.Errorf("update: %v", err)
+		}
+	}()
+
+	ipConfigs, err := wait.WaitForNetworkInterfaceReady(ctx, c, key)
+	if err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+	if len(ipConfigs) != 1 {
+		t.Errorf("unexpected IPConfigs %+v", ipConfigs)
+	}
+}
+
+func TestWaitForNetworkInterfaceReady_Failure(t *testing.T) {
+	nif := networkInterface()
+	c, watching := newClient(t, nif)
+	ctx := newContext(t)
+	go func() {
+		<-watching
+		nif.Status.Conditions = []v1alpha1.NetworkInterfaceCondition{{
+			Type:    v1alpha1.NetworkInterfaceFailure,
+			Status:  corev1.ConditionTrue,
+			Reason:  v1alpha1.NetworkInterfaceFailureReasonCannotAllocIP,
+			Message: "pool exhausted",
+		}}
+		if err := c.Update(ctx, nif); err != nil {
+			t.Errorf("update: %v", err)
+		}
+	}()
+
+	_, err := wait.WaitForNetworkInterfaceReady(ctx, c, key)
+	failure := &wait.FailureError{}
+	if !errors.As(err, &failure) {
+		t.Fatalf("expected a FailureError, got %v", err)
+	}
+	if failure.Kind != "NetworkInterface" || failure.Key != key || failure.Reason != "CannotAllocIP" || failure.Message != "pool exhausted" {
+		t.Errorf("unexpected failure %+v", failure)
+	}
+	if !errors.Is(err, v1alpha1.ErrCannotAllocIP) {
+		t.Errorf("expected the error to be ErrCannotAllocIP, got %v", err)
+	}
+}
+
+func TestWaitForNetworkInterfaceReady_Deleted(t *testing.T) {
+	nif := networkInterface()
+	c, watching := newClient(t, nif)
+	ctx := newContext(t)
+	go func() {
+		<-watching
+		if err := c.Delete(ctx, nif); err != nil {
+			t.Errorf("delete: %v", err)
+		}
+	}()
+
+	if _, err := wait.WaitForNetworkInterfaceReady(ctx, c, key); !apierrors.IsNotFound(err) {
+		t.Errorf("expected a NotFound error, got %v", err)
+	}
+}
+
+func TestWaitForNetworkInterfaceReady_ContextDone(t *testing.T) {
+	c, _ := newClient(t, networkInterface())
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := wait.WaitForNetworkInterfaceReady(ctx, c, key); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the context error, got %v", err)
+	}
+}
+
+func TestWaitForIPAddressAllocation(t *testing.T) {
+	alloc := &v1alpha1.IPAddressAllocation{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name}}
+	other := &v1alpha1.IPAddressAllocation{ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: "other"}}
+	c, watching := newClient(t, alloc, other)
+	ctx := newContext(t)
+	go func() {
+		<-watching
+		// An update of another allocation must not end the wait.
+		other.Status.IPAddress = "10.0.0.9"
+		other.Status.Conditions = []v1alpha1.IPAddressAllocationCondition{{Type: v1alpha1.IPAddressAllocationReady, Status: corev1.ConditionTrue}}
+		if err := c.Update(ctx, other); err != nil {
+			t.Errorf("update: %v", err)
+		}
+		alloc.Status.IPAddress = "10.0.0.10"
+		alloc.Status.Conditions = []v1alpha1.IPAddressAllocationCondition{{Type: v1alpha1.IPAddressAllocationReady, Status: corev1.ConditionTrue}}
+		if err := c.Update(ctx, alloc); err != nil {
+			t.Errorf("update: %v", err)
+		}
+	}()
+
+	ip, err := wait.WaitForIPAddressAllocation(ctx, c, key)
+	if err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+	if ip != "10.0.0.10" {
+		t.Errorf("expected IP 10.0.0.10, got %q", ip)
+	}
+}
+
+func TestWaitForIPAddressAllocation_Failure(t *testing.T) {
+	alloc := &v1alpha1.IPAddressAllocation{
+		ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+		Status: v1alpha1.IPAddressAllocationStatus{Conditions: []v1alpha1.IPAddressAllocationCondition{{
+			Type:   v1alpha1.IPAddressAllocationFail,
+			Status: corev1.ConditionTrue,
+			Reason: v1alpha1.IPAddressAllocationConditionInvalidRequestedIP,
+		}}},
+	}
+	c, _ := newClient(t, alloc)
+
+	_, err := wait.WaitForIPAddressAllocation(newContext(t), c, key)
+	failure := &wait.FailureError{}
+	if !errors.As(err, &failure) || failure.Reason != "InvalidRequestedIP" {
+		t.Errorf("expected an InvalidRequestedIP FailureError, got %v", err)
+	}
+	if !errors.Is(err, v1alpha1.ErrInvalidRequestedIP) {
+		t.Errorf("expected the error to be ErrInvalidRequestedIP, got %v", err)
+	}
+}
+
+// newClientWithWatchErrors returns a fake client holding objs whose first
+// watches end with an error event for each of errs in turn, and a channel
+// that receives a value every time a watch is started.
+func newClientWithWatchErrors(t *testing.T, errs []*apierrors.StatusError, objs ...client.Object) (client.WithWatch, <-chan struct{}) {
+	t.Helper()
+	wait.SetRestartBackoff(t, time.Millisecond)
+	c, watching := newClient(t, objs...)
+	started := make(chan struct{}, 10)
+	return interceptor.NewClient(c, interceptor.Funcs{
+		Watch: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) (watch.Interface, error) {
+			w, err := c.Watch(ctx, list, opts...)
+			<-watching
+			if err == nil && len(errs) > 0 {
+				w.Stop()
+				fake := watch.NewFakeWithChanSize(1, false)
+				fake.Error(&errs[0].ErrStatus)
+				errs = errs[1:]
+				w = fake
+			}
+			started <- struct{}{}
+			return w, err
+		},
+	}), started
+}
+
+func TestWaitForNetworkInterfaceReady_WatchExpired(t *testing.T) {
+	expired := apierrors.NewResourceExpired("too old resource version")
+	nif := networkInterface()
+	c, started := newClientWithWatchErrors(t, []*apierrors.StatusError{expired, expired}, nif)
+	ctx := newContext(t)
+	go func() {
+		for range 3 {
+			<-started
+		}
+		nif.Status.Conditions = []v1alpha1.NetworkInterfaceCondition{{Type: v1alpha1.NetworkInterfaceReady, Status: corev1.ConditionTrue}}
+		if err := c.Update(ctx, nif); err != nil {
+			t.Errorf("update: %v", err)
+		}
+	}()
+
+	if _, err := wait.WaitForNetworkInterfaceReady(ctx, c, key); err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+}
+
+func TestWaitForNetworkInterfaceReady_WatchForbidden(t *testing.T) {
+	forbidden := apierrors.NewForbidden(v1alpha1.SchemeGroupVersion.WithResource("networkinterfaces").GroupResource(), "", errors.New("denied"))
+	c, _ := newClientWithWatchErrors(t, []*apierrors.StatusError{forbidden}, networkInterface())
+
+	if _, err := wait.WaitForNetworkInterfaceReady(newContext(t), c, key); !apierrors.IsForbidden(err) {
+		t.Errorf("expected a Forbidden error, got %v", err)
+	}
+}
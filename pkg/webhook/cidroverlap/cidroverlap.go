@@ -0,0 +1,214 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Package cidroverlap answers the AdmissionReview requests the kube-apiserver
+// sends on the create or update of a NamespaceNetworkConfiguration or a
+// WorkloadNetworkConfiguration, and rejects the object if one of its CIDRs
+// overlaps a CIDR of another object of either kind. CEL rules only see the
+// object being admitted, so they cannot check this.
+//
+// The CIDRs checked are the namespaceCIDRs, ingressCIDRs and egressCIDRs of
+// nsxTier1Config and the privateCIDRs of vpcConfig.autoCreateConfig, both in
+// the spec of a NamespaceNetworkConfiguration and in the systemConfiguration of
+// every provider of a WorkloadNetworkConfiguration. Each rejection names the
+// overlapping field and object.
+//
+// The handler returned by NewHandler can be registered with any webhook server,
+// for example a controller-runtime manager:
+//
+//	handler, err := cidroverlap.NewHandler(mgr.GetClient())
+//	if err != nil {
+//		return err
+//	}
+//	mgr.GetWebhookServer().Register(cidroverlap.Path, handler)
+//
+// The ValidatingWebhookConfiguration in config/webhook points at the same path.
+//
+// On update only the CIDRs that the update adds to a field are checked, so that
+// objects that already overlap, for example because they were created before
+// the webhook was installed, can still be updated. A CIDR moved from one field
+// to another counts as added. Objects admitted at the same time are not
+// checked against each other.
+package cidroverlap
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"github.com/vmware-tanzu/net-operator-api/pkg/webhook/conversion"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// Path is the URL path the CIDR overlap webhook is served on.
+const Path = "/validate-cidr-overlap"
+
+// NewHandler returns an http.Handler that serves AdmissionReview requests for
+// NamespaceNetworkConfigurations and WorkloadNetworkConfigurations of any
+// served version. The objects of both kinds are listed from c at the v1alpha2
+// version, so c must have v1alpha2 in its scheme and be allowed to list them.
+// A client that reads from an informer cache avoids a list per request.
+func NewHandler(c client.Reader) (http.Handler, error) {
+	scheme, err := conversion.NewScheme()
+	if err != nil {
+		return nil, err
+	}
+	return &admission.Webhook{Handler: &validator{
+		client:  c,
+		scheme:  scheme,
+		decoder: admission.NewDecoder(scheme),
+	}}, nil
+}
+
+type validator struct {
+	client  client.Reader
+	scheme  *runtime.Scheme
+	decoder *admission.Decoder
+}
+
+func (v *validator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("")
+	}
+	entries, obj, err := v.entries(req.Kind, req.Object)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if req.Operation == admissionv1.Update {
+		oldEntries, _, err := v.entries(req.Kind, req.OldObject)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		entries = added(entries, oldEntries)
+	}
+	if len(entries) == 0 {
+		return admission.Allowed("")
+	}
+
+	idx, err := v.index(ctx)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if errs := idx.Check(entries); len(errs) > 0 {
+		gk := schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}
+		status := apierrors.NewInvalid(gk, objectName(req, obj), errs).Status()
+		return admission.Response{AdmissionResponse: admissionv1.AdmissionResponse{Result: &status}}
+	}
+	return admission.Allowed("")
+}
+
+// objectName returns the name of the object of req, obj, for an error. An
+// object created with generateName has no name yet, so its generateName is
+// used instead.
+func objectName(req admission.Request, obj metav1.Object) string {
+	switch {
+	case req.Name != "":
+		return req.Name
+	case obj.GetName() != "":
+		return obj.GetName()
+	}
+	return obj.GetGenerateName()
+}
+
+// entries decodes raw, an object of kind gvk, and returns its CIDRs and the
+// decoded object.
+func (v *validator) entries(gvk metav1.GroupVersionKind, raw runtime.RawExtension) ([]Entry, metav1.Object, error) {
+	switch gvk.Kind {
+	case KindNamespaceNetworkConfiguration:
+		nnc := &v1alpha2.NamespaceNetworkConfiguration{}
+		if err := v.decode(gvk, raw, nnc); err != nil {
+			return nil, nil, err
+		}
+		return NamespaceNetworkConfigurationEntries(nnc), nnc, nil
+	case KindWorkloadNetworkConfiguration:
+		wnc := &v1alpha2.WorkloadNetworkConfiguration{}
+		if err := v.decode(gvk, raw, wnc); err != nil {
+			return nil, nil, err
+		}
+		return WorkloadNetworkConfigurationEntries(wnc), wnc, nil
+	}
+	return nil, nil, fmt.Errorf("unsupported kind %q", gvk.Kind)
+}
+
+// decode decodes raw, an object of kind gvk, into hub, converting it if gvk is
+// not the hub version.
+func (v *validator) decode(gvk metav1.GroupVersionKind, raw runtime.RawExtension, hub ctrlconversion.Hub) error {
+	if gvk.Version == v1alpha2.SchemeGroupVersion.Version {
+		return v.decoder.DecodeRaw(raw, hub)
+	}
+	obj, err := v.scheme.New(schema.GroupVersionKind(gvk))
+	if err != nil {
+		return err
+	}
+	spoke, ok := obj.(ctrlconversion.Convertible)
+	if !ok {
+		return fmt.Errorf("%s is not convertible", gvk)
+	}
+	if err := v.decoder.DecodeRaw(raw, spoke); err != nil {
+		return err
+	}
+	return spoke.ConvertTo(hub)
+}
+
+// index returns the CIDRs of every NamespaceNetworkConfiguration and
+// WorkloadNetworkConfiguration.
+func (v *validator) index(ctx context.Context) (*Index, error) {
+	idx := &Index{}
+	nncs := &v1alpha2.NamespaceNetworkConfigurationList{}
+	if err := v.client.List(ctx, nncs); err != nil {
+		return nil, err
+	}
+	for i := range nncs.Items {
+		idx.Add(NamespaceNetworkConfigurationEntries(&nncs.Items[i])...)
+	}
+	wncs := &v1alpha2.WorkloadNetworkConfigurationList{}
+	if err := v.client.List(ctx, wncs); err != nil {
+		return nil, err
+	}
+	for i := range wncs.Items {
+		idx.Add(WorkloadNetworkConfigurationEntries(&wncs.Items[i])...)
+	}
+	return idx, nil
+}
+
+// entryKey identifies a CIDR in a list field of an object, regardless of its
+// index in the list.
+type entryKey struct {
+	field  string
+	prefix netip.Prefix
+}
+
+func keyOf(e Entry) entryKey {
+	field := e.Field.String()
+	if i := strings.LastIndex(field, "["); i > 0 && strings.HasSuffix(field, "]") {
+		field = field[:i]
+	}
+	return entryKey{field: field, prefix: e.Prefix}
+}
+
+// added returns the entries whose CIDR is not in the same field of old, so
+// that a CIDR moved to another field is checked again.
+func added(entries, old []Entry) []Entry {
+	existing := map[entryKey]bool{}
+	for _, e := range old {
+		existing[keyOf(e)] = true
+	}
+	var out []Entry
+	for _, e := range entries {
+		if !existing[keyOf(e)] {
+			out = append(out, e)
+		}
+	}
+	return out
+}
@@ -0,0 +1,317 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package cidroverlap_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"github.com/vmware-tanzu/net-operator-api/pkg/webhook/cidroverlap"
+	"github.com/vmware-tanzu/net-operator-api/pkg/webhook/conversion"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func nsxTier1NNC(name string, config *v1alpha2.NSXTier1Config) *v1alpha2.NamespaceNetworkConfiguration {
+	return &v1alpha2.NamespaceNetworkConfiguration{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha2.SchemeGroupVersion.String(), Kind: cidroverlap.KindNamespaceNetworkConfiguration},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha2.NamespaceNetworkSpec{
+			Type:                   v1alpha2.NetworkProviderNSXTier1,
+			NamespaceNetworkConfig: v1alpha2.NamespaceNetworkConfig{NSXTier1Config: config},
+		},
+	}
+}
+
+func defaultWNC() *v1alpha2.WorkloadNetworkConfiguration {
+	return &v1alpha2.WorkloadNetworkConfiguration{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha2.SchemeGroupVersion.String(), Kind: cidroverlap.KindWorkloadNetworkConfiguration},
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: v1alpha2.WorkloadNetworkConfigurationSpec{
+			ActiveSystemProvider: v1alpha2.NetworkProviderNSXTier1,
+			Providers: []v1alpha2.NetworkProviderEntry{
+				{
+					Type: v1alpha2.NetworkProviderNSXTier1,
+					SystemConfiguration: &v1alpha2.NamespaceNetworkConfig{
+						NSXTier1Config: &v1alpha2.NSXTier1Config{
+							NamespaceCIDRs: []string{"10.244.0.0/20"},
+							IngressCIDRs:   []string{"10.245.0.0/24"},
+							EgressCIDRs:    []string{"10.246.0.0/24"},
+						},
+					},
+				},
+				{
+					Type: v1alpha2.NetworkProviderVPC,
+					SystemConfiguration: &v1alpha2.NamespaceNetworkConfig{
+						VPCConfig: v1alpha2.VPCConfig{
+							AutoCreateConfig: v1alpha2.AutoCreateVPCConfig{
+								NSXProject:             "/orgs/default/projects/p",
+								VPCConnectivityProfile: "default",
+								PrivateCIDRs:           []string{"172.16.0.0/16"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newWebhook(t *testing.T, objs ...client.Object) *admission.Webhook {
+	t.Helper()
+	scheme, err := conversion.NewScheme()
+	if err != nil {
+		t.Fatalf("new scheme: %v", err)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	handler, err := cidroverlap.NewHandler(c)
+	if err != nil {
+		t.Fatalf("new handler: %v", err)
+	}
+	return handler.(*admission.Webhook)
+}
+
+func request(t *testing.T, op admissionv1.Operation, obj, old client.Object) admission.Request {
+	t.Helper()
+	raw := func(obj client.Object) runtime.RawExtension {
+		if obj == nil {
+			return runtime.RawExtension{}
+		}
+		data, err := json.Marshal(obj)
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+		return runtime.RawExtension{Raw: data}
+	}
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Operation: op,
+		Kind:      metav1.GroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind},
+		Name:      obj.GetName(),
+		Object:    raw(obj),
+		OldObject: raw(old),
+	}}
+}
+
+func TestEntries(t *testing.T) {
+	nnc := nsxTier1NNC("nnc", &v1alpha2.NSXTier1Config{
+		NamespaceCIDRs: []string{"10.0.0.0/16", "not-a-cidr"},
+		EgressCIDRs:    []string{"10.1.0.0/24"},
+	})
+	var got []string
+	for _, e := range cidroverlap.NamespaceNetworkConfigurationEntries(nnc) {
+		got = append(got, e.Field.String()+"="+e.Prefix.String())
+	}
+	want := []string{
+		"spec.nsxTier1Config.namespaceCIDRs[0]=10.0.0.0/16",
+		"spec.nsxTier1Config.egressCIDRs[0]=10.1.0.0/24",
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("NNC entries: got %v, want %v", got, want)
+	}
+
+	got = nil
+	for _, e := range cidroverlap.WorkloadNetworkConfigurationEntries(defaultWNC()) {
+		got = append(got, e.Field.String()+"="+e.Prefix.String())
+	}
+	want = []string{
+		"spec.providers[0].systemConfiguration.nsxTier1Config.namespaceCIDRs[0]=10.244.0.0/20",
+		"spec.providers[0].systemConfiguration.nsxTier1Config.ingressCIDRs[0]=10.245.0.0/24",
+		"spec.providers[0].systemConfiguration.nsxTier1Config.egressCIDRs[0]=10.246.0.0/24",
+		"spec.providers[1].systemConfiguration.vpcConfig.autoCreateConfig.privateCIDRs[0]=172.16.0.0/16",
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("WNC entries: got %v, want %v", got, want)
+	}
+}
+
+func TestIndexCheck(t *testing.T) {
+	idx := &cidroverlap.Index{}
+	idx.Add(cidroverlap.NamespaceNetworkConfigurationEntries(nsxTier1NNC("a", &v1alpha2.NSXTier1Config{
+		NamespaceCIDRs: []string{"10.0.0.0/16"},
+		IngressCIDRs:   []string{"10.1.0.0/24"},
+		EgressCIDRs:    []string{"10.2.0.0/24"},
+	}))...)
+
+	// The CIDRs of an object do not conflict with the object itself.
+	if errs := idx.Check(cidroverlap.NamespaceNetworkConfigurationEntries(nsxTier1NNC("a", &v1alpha2.NSXTier1Config{
+		NamespaceCIDRs: []string{"10.0.0.0/16", "10.1.0.0/25"},
+	}))); len(errs) != 0 {
+		t.Errorf("expected no overlap with the same object, got: %v", errs)
+	}
+
+	errs := idx.Check(cidroverlap.NamespaceNetworkConfigurationEntries(nsxTier1NNC("b", &v1alpha2.NSXTier1Config{
+		NamespaceCIDRs: []string{"10.3.0.0/16"},
+		EgressCIDRs:    []string{"10.1.0.128/25"},
+	})))
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 overlap, got: %v", errs)
+	}
+	want := `spec.nsxTier1Config.egressCIDRs[0]: Invalid value: "10.1.0.128/25": overlaps 10.1.0.0/24 in spec.nsxTier1Config.ingressCIDRs[0] of NamespaceNetworkConfiguration "a"`
+	if errs[0].Error() != want {
+		t.Errorf("got %q, want %q", errs[0].Error(), want)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	existing := nsxTier1NNC("existing", &v1alpha2.NSXTier1Config{
+		NamespaceCIDRs: []string{"10.0.0.0/16"},
+		IngressCIDRs:   []string{"10.1.0.0/24"},
+		EgressCIDRs:    []string{"10.2.0.0/24"},
+	})
+	wh := newWebhook(t, existing, defaultWNC())
+
+	tests := []struct {
+		name    string
+		op      admissionv1.Operation
+		obj     client.Object
+		old     client.Object
+		wantErr string
+	}{
+		{
+			name: "disjoint",
+			op:   admissionv1.Create,
+			obj: nsxTier1NNC("new", &v1alpha2.NSXTier1Config{
+				NamespaceCIDRs: []string{"10.10.0.0/16"},
+				IngressCIDRs:   []string{"10.11.0.0/24"},
+				EgressCIDRs:    []string{"10.12.0.0/24"},
+			}),
+		},
+		{
+			name: "namespace CIDRs overlap",
+			op:   admissionv1.Create,
+			obj: nsxTier1NNC("new", &v1alpha2.NSXTier1Config{
+				NamespaceCIDRs: []string{"10.0.128.0/17"},
+				IngressCIDRs:   []string{"10.11.0.0/24"},
+				EgressCIDRs:    []string{"10.12.0.0/24"},
+			}),
+			wantErr: `spec.nsxTier1Config.namespaceCIDRs[0]: Invalid value: "10.0.128.0/17": overlaps 10.0.0.0/16 in spec.nsxTier1Config.namespaceCIDRs[0] of NamespaceNetworkConfiguration "existing"`,
+		},
+		{
+			name: "ingress overlaps egress of another",
+			op:   admissionv1.Create,
+			obj: nsxTier1NNC("new", &v1alpha2.NSXTier1Config{
+				NamespaceCIDRs: []string{"10.10.0.0/16"},
+				IngressCIDRs:   []string{"10.2.0.0/24"},
+				EgressCIDRs:    []string{"10.12.0.0/24"},
+			}),
+			wantErr: `spec.nsxTier1Config.ingressCIDRs[0]: Invalid value: "10.2.0.0/24": overlaps 10.2.0.0/24 in spec.nsxTier1Config.egressCIDRs[0] of NamespaceNetworkConfiguration "existing"`,
+		},
+		{
+			name: "private CIDRs overlap the system configuration",
+			op:   admissionv1.Create,
+			obj: &v1alpha1.NamespaceNetworkConfiguration{
+				TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: cidroverlap.KindNamespaceNetworkConfiguration},
+				ObjectMeta: metav1.ObjectMeta{Name: "new"},
+				Spec: v1alpha1.NamespaceNetworkSpec{
+					Type: v1alpha1.NetworkProviderVPC,
+					NamespaceNetworkConfig: v1alpha1.NamespaceNetworkConfig{VPCConfig: v1alpha1.VPCConfig{
+						AutoCreateConfig: v1alpha1.AutoCreateVPCConfig{
+							NSXProject:             "/orgs/default/projects/p",
+							VPCConnectivityProfile: "default",
+							PrivateCIDRs:           []string{"172.16.4.0/24"},
+						},
+					}},
+				},
+			},
+			wantErr: `overlaps 172.16.0.0/16 in spec.providers[1].systemConfiguration.vpcConfig.autoCreateConfig.privateCIDRs[0] of WorkloadNetworkConfiguration "default"`,
+		},
+		{
+			name: "system configuration overlaps a namespace",
+			op:   admissionv1.Update,
+			obj: func() client.Object {
+				wnc := defaultWNC()
+				t1 := wnc.Spec.Providers[0].SystemConfiguration.NSXTier1Config
+				t1.NamespaceCIDRs = append(t1.NamespaceCIDRs, "10.0.0.0/8")
+				return wnc
+			}(),
+			old:     defaultWNC(),
+			wantErr: `spec.providers[0].systemConfiguration.nsxTier1Config.namespaceCIDRs[1]: Invalid value: "10.0.0.0/8": overlaps 10.0.0.0/16 in spec.nsxTier1Config.namespaceCIDRs[0] of NamespaceNetworkConfiguration "existing"`,
+		},
+		{
+			name: "update that adds no CIDR",
+			op:   admissionv1.Update,
+			obj: func() client.Object {
+				nnc := nsxTier1NNC("legacy", existing.Spec.NSXTier1Config.DeepCopy())
+				nnc.Labels = map[string]string{"updated": "true"}
+				return nnc
+			}(),
+			old: nsxTier1NNC("legacy", existing.Spec.NSXTier1Config.DeepCopy()),
+		},
+		{
+			name: "CIDR moved to another field",
+			op:   admissionv1.Update,
+			obj: nsxTier1NNC("legacy", &v1alpha2.NSXTier1Config{
+				NamespaceCIDRs: []string{"10.10.0.0/16"},
+				IngressCIDRs:   []string{"10.2.0.0/24"},
+				EgressCIDRs:    []string{"10.12.0.0/24"},
+			}),
+			old: nsxTier1NNC("legacy", &v1alpha2.NSXTier1Config{
+				NamespaceCIDRs: []string{"10.10.0.0/16"},
+				IngressCIDRs:   []string{"10.11.0.0/24"},
+				EgressCIDRs:    []string{"10.12.0.0/24", "10.2.0.0/24"},
+			}),
+			wantErr: `spec.nsxTier1Config.ingressCIDRs[0]: Invalid value: "10.2.0.0/24": overlaps 10.2.0.0/24 in spec.nsxTier1Config.egressCIDRs[0] of NamespaceNetworkConfiguration "existing"`,
+		},
+		{
+			name: "CIDR moved within a field",
+			op:   admissionv1.Update,
+			obj: nsxTier1NNC("legacy", &v1alpha2.NSXTier1Config{
+				NamespaceCIDRs: []string{"10.10.0.0/16"},
+				IngressCIDRs:   []string{"10.11.0.0/24"},
+				EgressCIDRs:    []string{"10.2.0.0/24", "10.12.0.0/24"},
+			}),
+			old: nsxTier1NNC("legacy", &v1alpha2.NSXTier1Config{
+				NamespaceCIDRs: []string{"10.10.0.0/16"},
+				IngressCIDRs:   []string{"10.11.0.0/24"},
+				EgressCIDRs:    []string{"10.12.0.0/24", "10.2.0.0/24"},
+			}),
+		},
+		{
+			name: "generated name",
+			op:   admissionv1.Create,
+			obj: func() client.Object {
+				nnc := nsxTier1NNC("", &v1alpha2.NSXTier1Config{
+					NamespaceCIDRs: []string{"10.0.128.0/17"},
+					IngressCIDRs:   []string{"10.11.0.0/24"},
+					EgressCIDRs:    []string{"10.12.0.0/24"},
+				})
+				nnc.GenerateName = "new-"
+				return nnc
+			}(),
+			wantErr: `NamespaceNetworkConfiguration.netoperator.vmware.com "new-" is invalid`,
+		},
+		{
+			name: "delete",
+			op:   admissionv1.Delete,
+			obj:  nsxTier1NNC("existing", existing.Spec.NSXTier1Config.DeepCopy()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := wh.Handle(context.Background(), request(t, tt.op, tt.obj, tt.old))
+			if tt.wantErr == "" {
+				if !resp.Allowed {
+					t.Fatalf("expected admission, got: %v", resp.Result)
+				}
+				return
+			}
+			if resp.Allowed {
+				t.Fatalf("expected rejection containing %q, got admission", tt.wantErr)
+			}
+			if resp.Result == nil || !strings.Contains(resp.Result.Message, tt.wantErr) {
+				t.Fatalf("expected rejection containing %q, got: %v", tt.wantErr, resp.Result)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package cidroverlap
+
+import (
+	"fmt"
+	"net/netip"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// KindNamespaceNetworkConfiguration is the kind of a NamespaceNetworkConfiguration.
+	KindNamespaceNetworkConfiguration = "NamespaceNetworkConfiguration"
+	// KindWorkloadNetworkConfiguration is the kind of a WorkloadNetworkConfiguration.
+	KindWorkloadNetworkConfiguration = "WorkloadNetworkConfiguration"
+)
+
+// Ref identifies an object that holds CIDRs. Both kinds are cluster scoped.
+type Ref struct {
+	Kind string
+	Name string
+}
+
+func (r Ref) String() string {
+	return fmt.Sprintf("%s %q", r.Kind, r.Name)
+}
+
+// Entry is a CIDR of an object.
+type Entry struct {
+	// Object is the object that holds the CIDR.
+	Object Ref
+	// Field is the path of the CIDR in the object.
+	Field *field.Path
+	// Prefix is the CIDR.
+	Prefix netip.Prefix
+}
+
+// NamespaceNetworkConfigurationEntries returns the CIDRs of nnc. CIDRs that
+// do not parse are left out; the CRD schema rejects them.
+func NamespaceNetworkConfigurationEntries(nnc *v1alpha2.NamespaceNetworkConfiguration) []Entry {
+	ref := Ref{Kind: KindNamespaceNetworkConfiguration, Name: nnc.Name}
+	return configEntries(ref, &nnc.Spec.NamespaceNetworkConfig, field.NewPath("spec"))
+}
+
+// WorkloadNetworkConfigurationEntries returns the CIDRs of the system
+// configuration of every provider of wnc. CIDRs that do not parse are left
+// out; the CRD schema rejects them.
+func WorkloadNetworkConfigurationEntries(wnc *v1alpha2.WorkloadNetworkConfiguration) []Entry {
+	ref := Ref{Kind: KindWorkloadNetworkConfiguration, Name: wnc.Name}
+	providersPath := field.NewPath("spec", "providers")
+	var entries []Entry
+	for i, provider := range wnc.Spec.Providers {
+		if provider.SystemConfiguration == nil {
+			continue
+		}
+		entries = append(entries, configEntries(ref, provider.SystemConfiguration, providersPath.Index(i).Child("systemConfiguration"))...)
+	}
+	return entries
+}
+
+func configEntries(ref Ref, config *v1alpha2.NamespaceNetworkConfig, fldPath *field.Path) []Entry {
+	var entries []Entry
+	if t1 := config.NSXTier1Config; t1 != nil {
+		t1Path := fldPath.Child("nsxTier1Config")
+		entries = appendEntries(entries, ref, t1.NamespaceCIDRs, t1Path.Child("namespaceCIDRs"))
+		entries = appendEntries(entries, ref, t1.IngressCIDRs, t1Path.Child("ingressCIDRs"))
+		entries = appendEntries(entries, ref, t1.EgressCIDRs, t1Path.Child("egressCIDRs"))
+	}
+	entries = appendEntries(entries, ref, config.VPCConfig.AutoCreateConfig.PrivateCIDRs,
+		fldPath.Child("vpcConfig", "autoCreateConfig", "privateCIDRs"))
+	return entries
+}
+
+func appendEntries(entries []Entry, ref Ref, cidrs []string, fldPath *field.Path) []Entry {
+	for i, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, Entry{Object: ref, Field: fldPath.Index(i), Prefix: prefix})
+	}
+	return entries
+}
+
+// Index holds the CIDRs of a set of objects. The zero Index is empty.
+type Index struct {
+	entries []Entry
+}
+
+// Add adds entries to the index.
+func (idx *Index) Add(entries ...Entry) {
+	idx.entries = append(idx.entries, entries...)
+}
+
+// Overlapping returns the entries of objects other than e.Object whose CIDR
+// overlaps that of e.
+func (idx *Index) Overlapping(e Entry) []Entry {
+	var out []Entry
+	for _, other := range idx.entries {
+		if other.Object != e.Object && other.Prefix.Overlaps(e.Prefix) {
+			out = append(out, other)
+		}
+	}
+	return out
+}
+
+// Check returns an error for every entry that overlaps an entry of another
+// object in the index. The error is reported on the field of the entry and
+// names the field and object it overlaps.
+func (idx *Index) Check(entries []Entry) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, e := range entries {
+		for _, other := range idx.Overlapping(e) {
+			allErrs = append(allErrs, field.Invalid(e.Field, e.Prefix.String(),
+				fmt.Sprintf("overlaps %s in %s of %s", other.Prefix, other.Field, other.Object)))
+		}
+	}
+	return allErrs
+}
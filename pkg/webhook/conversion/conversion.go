@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+// Package conversion answers the ConversionReview requests the kube-apiserver
+// sends for the netoperator.vmware.com CustomResourceDefinitions once more than
+// one version of a kind is served.
+//
+// The handler returned by NewHandler can be registered with any webhook server,
+// for example a controller-runtime manager:
+//
+//	handler, err := conversion.NewHandler()
+//	if err != nil {
+//		return err
+//	}
+//	mgr.GetWebhookServer().Register(conversion.Path, handler)
+//
+// The CRDs must point their spec.conversion.webhook.clientConfig at the same
+// path. The manifests rendered from config/crd do this for every kind.
+package conversion
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+)
+
+// Path is the URL path the conversion webhook is served on.
+const Path = "/convert"
+
+// SchemeBuilder adds every served version of the netoperator.vmware.com group
+// to a scheme. New API versions must be appended here to take part in
+// conversion.
+var SchemeBuilder = runtime.NewSchemeBuilder(
+	v1alpha1.AddToScheme,
+	v1alpha2.AddToScheme,
+)
+
+// AddToScheme adds every served version of the netoperator.vmware.com group to
+// the given scheme.
+var AddToScheme = SchemeBuilder.AddToScheme
+
+// NewScheme returns a scheme with every served version of the
+// netoperator.vmware.com group registered.
+func NewScheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	return scheme, nil
+}
+
+// NewHandler returns an http.Handler that serves ConversionReview requests for
+// every kind in the netoperator.vmware.com group.
+func NewHandler() (http.Handler, error) {
+	scheme, err := NewScheme()
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckConvertible(scheme); err != nil {
+		return nil, err
+	}
+	return ctrlconversion.NewWebhookHandler(scheme), nil
+}
+
+// CheckConvertible returns an error if any netoperator.vmware.com kind in the
+// scheme that is served at more than one version lacks a hub, or has a spoke
+// that does not implement conversion to and from that hub.
+func CheckConvertible(scheme *runtime.Scheme) error {
+	checked := map[string]bool{}
+	for gvk := range scheme.AllKnownTypes() {
+		if gvk.Group != v1alpha2.GroupName || checked[gvk.Kind] {
+			continue
+		}
+		checked[gvk.Kind] = true
+
+		obj, err := scheme.New(gvk)
+		if err != nil {
+			return err
+		}
+		// Skip the shared option and event types metav1.AddToGroupVersion
+		// registers alongside the kinds of each version.
+		if _, ok := obj.(metav1.Object); !ok && !meta.IsListType(obj) {
+			continue
+		}
+		if _, err := ctrlconversion.IsConvertible(scheme, obj); err != nil {
+			return fmt.Errorf("kind %s is not convertible: %w", schema.GroupKind{Group: gvk.Group, Kind: gvk.Kind}, err)
+		}
+	}
+	return nil
+}
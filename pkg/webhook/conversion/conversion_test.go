@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package conversion_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/api/v1alpha2"
+	"github.com/vmware-tanzu/net-operator-api/pkg/webhook/conversion"
+	apixv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestNewHandler_AllKindsConvertible(t *testing.T) {
+	if _, err := conversion.NewHandler(); err != nil {
+		t.Fatalf("expected every kind to be convertible, got: %v", err)
+	}
+}
+
+func TestNewScheme_RegistersAllVersions(t *testing.T) {
+	scheme, err := conversion.NewScheme()
+	if err != nil {
+		t.Fatalf("new scheme: %v", err)
+	}
+	for _, gv := range []schema.GroupVersion{v1alpha1.SchemeGroupVersion, v1alpha2.SchemeGroupVersion} {
+		if !scheme.IsVersionRegistered(gv) {
+			t.Errorf("expected %s to be registered", gv)
+		}
+	}
+}
+
+func TestHandler_ConvertsSpokeToHub(t *testing.T) {
+	handler, err := conversion.NewHandler()
+	if err != nil {
+		t.Fatalf("new handler: %v", err)
+	}
+
+	prefix := int32(24)
+	src := &v1alpha1.NetworkInterface{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       "NetworkInterface",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "nif", Namespace: "ns"},
+		Spec: v1alpha1.NetworkInterfaceSpec{
+			NetworkName:    "net",
+			IPFamilyPolicy: v1alpha1.NetworkInterfaceIPFamilyPolicyIPv4Only,
+		},
+		Status: v1alpha1.NetworkInterfaceStatus{
+			IPConfigs: []v1alpha1.IPConfig{
+				{IP: "10.0.0.10", IPFamily: "IPv4", Gateway: "10.0.0.1", Prefix: &prefix},
+			},
+		},
+	}
+	raw, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	review := &apixv1.ConversionReview{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apixv1.SchemeGroupVersion.String(),
+			Kind:       "ConversionReview",
+		},
+		Request: &apixv1.ConversionRequest{
+			UID:               types.UID("test-uid"),
+			DesiredAPIVersion: v1alpha2.SchemeGroupVersion.String(),
+			Objects:           []runtime.RawExtension{{Raw: raw}},
+		},
+	}
+	body, err := json.Marshal(review)
+	if err != nil {
+		t.Fatalf("marshal review: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, conversion.Path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	resp := &apixv1.ConversionReview{}
+	if err := json.Unmarshal(rec.Body.Bytes(), resp); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	if resp.Response == nil || resp.Response.Result.Status != metav1.StatusSuccess {
+		t.Fatalf("expected successful conversion, got: %+v", resp.Response)
+	}
+	if resp.Response.UID != review.Request.UID {
+		t.Errorf("expected response UID %q, got %q", review.Request.UID, resp.Response.UID)
+	}
+	if len(resp.Response.ConvertedObjects) != 1 {
+		t.Fatalf("expected 1 converted object, got %d", len(resp.Response.ConvertedObjects))
+	}
+
+	dst := &v1alpha2.NetworkInterface{}
+	if err := json.Unmarshal(resp.Response.ConvertedObjects[0].Raw, dst); err != nil {
+		t.Fatalf("unmarshal converted object: %v", err)
+	}
+	if dst.APIVersion != v1alpha2.SchemeGroupVersion.String() {
+		t.Errorf("expected apiVersion %q, got %q", v1alpha2.SchemeGroupVersion.String(), dst.APIVersion)
+	}
+	if dst.Spec.NetworkName != src.Spec.NetworkName {
+		t.Errorf("expected networkName %q, got %q", src.Spec.NetworkName, dst.Spec.NetworkName)
+	}
+	if len(dst.Status.IPConfigs) != 1 || dst.Status.IPConfigs[0].IP != "10.0.0.10" {
+		t.Errorf("expected ipConfigs to be preserved, got: %+v", dst.Status.IPConfigs)
+	}
+}
@@ -927,6 +927,40 @@ func TestNamespaceNetworkConfiguration_AutoCreatePrivateCIDRNotCIDR_Rejected(t *
 	}
 }
 
+func TestNamespaceNetworkConfiguration_AutoCreatePrivateCIDRMalformed_Rejected(t *testing.T) {
+	for i, cidr := range []string{"999.1.1.1/99", "10.0.0.0/33", "010.0.0.0/16", "10.0.0.0", "fd00::/64"} {
+		nnc := autoVpcNNC(fmt.Sprintf("test-vpc-auto-malformed-cidr-%d", i), testNSXProject, testVPCConnProfile)
+		nnc.Spec.VPCConfig.AutoCreateConfig.PrivateCIDRs = []string{testCIDR1, cidr}
+		err := k8sClient.Create(testCtx, nnc)
+		if err == nil || !strings.Contains(err.Error(), "privateCIDRs must contain only valid IPv4 CIDRs") {
+			_ = k8sClient.Delete(testCtx, nnc)
+			t.Errorf("%s: expected rejection, got: %v", cidr, err)
+		}
+	}
+}
+
+func TestNamespaceNetworkConfiguration_PrivateCIDRsAppendMalformed_Rejected(t *testing.T) {
+	nnc := autoVpcNNC("test-vpc-cidr-append-malformed", testNSXProject, testVPCConnProfile)
+	nnc.Spec.VPCConfig.AutoCreateConfig.PrivateCIDRs = []string{testCIDR1}
+	if err := k8sClient.Create(testCtx, nnc); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	defer func() { _ = k8sClient.Delete(testCtx, nnc) }()
+
+	fetched := &netv1alpha1.NamespaceNetworkConfiguration{}
+	if err := k8sClient.Get(testCtx, client.ObjectKey{Name: "test-vpc-cidr-append-malformed"}, fetched); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+
+	fetched.Spec.VPCConfig.AutoCreateConfig.PrivateCIDRs = append(
+		fetched.Spec.VPCConfig.AutoCreateConfig.PrivateCIDRs,
+		"999.1.1.1/99",
+	)
+	if err := k8sClient.Update(testCtx, fetched); err == nil || !strings.Contains(err.Error(), "privateCIDRs must contain only valid IPv4 CIDRs") {
+		t.Fatalf("expected rejection containing %q, got: %v", "privateCIDRs must contain only valid IPv4 CIDRs", err)
+	}
+}
+
 // -----------------------------------------------------------------------
 // VPC Config — updates and immutability
 // -----------------------------------------------------------------------
@@ -1528,6 +1562,44 @@ func TestNamespaceNetworkConfiguration_NSXTier1RoutedWithEgress_Rejected(t *test
 	}
 }
 
+func TestNamespaceNetworkConfiguration_NSXTier1MalformedCIDRs_Rejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*netv1alpha1.NSXTier1Config)
+		wantErr string
+	}{
+		{"test-t1-namespace-cidr-out-of-range", func(c *netv1alpha1.NSXTier1Config) {
+			c.NamespaceCIDRs = []string{"999.1.1.1/99"}
+		}, "namespaceCIDRs must contain only valid IPv4 CIDRs"},
+		{"test-t1-ingress-cidr-ipv6", func(c *netv1alpha1.NSXTier1Config) {
+			c.IngressCIDRs = []string{"fd00::/64"}
+		}, "ingressCIDRs must contain only valid IPv4 CIDRs"},
+		{"test-t1-egress-cidr-prefix-too-long", func(c *netv1alpha1.NSXTier1Config) {
+			c.EgressCIDRs = []string{"192.168.3.0/33"}
+		}, "egressCIDRs must contain only valid IPv4 CIDRs"},
+	}
+	for _, tt := range tests {
+		config := &netv1alpha1.NSXTier1Config{
+			NamespaceCIDRs: []string{testNamespaceCIDR},
+			IngressCIDRs:   []string{testIngressCIDR},
+			EgressCIDRs:    []string{testEgressCIDR},
+		}
+		tt.update(config)
+		nnc := &netv1alpha1.NamespaceNetworkConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: tt.name},
+			Spec: netv1alpha1.NamespaceNetworkSpec{
+				Type:                   netv1alpha1.NetworkProviderNSXTier1,
+				NamespaceNetworkConfig: netv1alpha1.NamespaceNetworkConfig{NSXTier1Config: config},
+			},
+		}
+		err := k8sClient.Create(testCtx, nnc)
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			_ = k8sClient.Delete(testCtx, nnc)
+			t.Errorf("%s: expected rejection containing %q, got: %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
 func TestNamespaceNetworkConfiguration_NSXTier1MissingNamespaceCIDR_Rejected(t *testing.T) {
 	nnc := &netv1alpha1.NamespaceNetworkConfiguration{
 		ObjectMeta: metav1.ObjectMeta{Name: "test-t1-missing-namespace-cidr"},
@@ -0,0 +1,214 @@
+// Copyright (c) 2026 Broadcom. All Rights Reserved.
+// Broadcom Confidential. The term "Broadcom" refers to Broadcom Inc.
+// and/or its subsidiaries.
+
+package cel_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	netv1alpha1 "github.com/vmware-tanzu/net-operator-api/api/v1alpha1"
+	"github.com/vmware-tanzu/net-operator-api/pkg/validation"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// parityClient runs every object that the tests create or update through
+// pkg/validation as well as the API server, and fails the call if the two do
+// not agree on whether the object is valid. A disagreement is returned as an
+// error that is neither admitted nor rejected, so that the test fails.
+//
+// Unstructured objects are converted to their typed kind. The ones that carry
+// wire details the typed validators cannot see, such as an explicit empty
+// string or an omitted field with a default, go to the API server only.
+type parityClient struct {
+	client.Client
+}
+
+func (c *parityClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	var errs field.ErrorList
+	typed, ok := c.typed(obj)
+	if ok {
+		// The API server ignores the status on create.
+		errs, ok = validate(nil, withStatus(typed, nil))
+	}
+	err := c.Client.Create(ctx, obj, opts...)
+	if !ok {
+		return err
+	}
+	return checkParity(errs, err)
+}
+
+func (c *parityClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	// The API server keeps the previous status on update.
+	errs, ok := c.validateUpdate(ctx, obj, func(obj, old client.Object) client.Object {
+		return withStatus(obj, old)
+	})
+	err := c.Client.Update(ctx, obj, opts...)
+	if !ok {
+		return err
+	}
+	return checkParity(errs, err)
+}
+
+func (c *parityClient) Status() client.SubResourceWriter {
+	return &parityStatusWriter{SubResourceWriter: c.Client.Status(), client: c}
+}
+
+// validateUpdate validates the update of obj from the stored object, old, to
+// prepare(obj, old), the object that the API server validates.
+func (c *parityClient) validateUpdate(ctx context.Context, obj client.Object, prepare func(obj, old client.Object) client.Object) (field.ErrorList, bool) {
+	typed, ok := c.typed(obj)
+	if !ok {
+		return nil, false
+	}
+	old := typed.DeepCopyObject().(client.Object)
+	if err := c.Client.Get(ctx, client.ObjectKeyFromObject(obj), old); err != nil {
+		return nil, false
+	}
+	return validate(old, prepare(typed, old))
+}
+
+// typed returns obj as its typed kind. It returns false for an unstructured
+// object whose content differs from what the typed object sends.
+func (c *parityClient) typed(obj client.Object) (client.Object, bool) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return obj, true
+	}
+	runtimeObj, err := c.Scheme().New(u.GroupVersionKind())
+	if err != nil {
+		return nil, false
+	}
+	typed, ok := runtimeObj.(client.Object)
+	if !ok {
+		return nil, false
+	}
+	if err := apiruntime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(u.Object, typed, true); err != nil {
+		return nil, false
+	}
+	if !equality.Semantic.DeepEqual(normalize(u.Object), normalize(typed)) {
+		return nil, false
+	}
+	return typed, true
+}
+
+// normalize returns obj as encoded to JSON and decoded back, without the null
+// values and without an empty status, which are not sent.
+func normalize(obj interface{}) interface{} {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	var out map[string]interface{}
+	if err := json.Unmarshal(data, &out); err != nil {
+		panic(err)
+	}
+	if status, ok := out["status"].(map[string]interface{}); ok && len(status) == 0 {
+		delete(out, "status")
+	}
+	return dropNulls(out)
+}
+
+func dropNulls(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			if item == nil {
+				delete(v, k)
+			} else {
+				v[k] = dropNulls(item)
+			}
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = dropNulls(v[i])
+		}
+	}
+	return v
+}
+
+type parityStatusWriter struct {
+	client.SubResourceWriter
+	client *parityClient
+}
+
+func (w *parityStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	// The API server only takes the status on an update of the status.
+	errs, ok := w.client.validateUpdate(ctx, obj, func(obj, old client.Object) client.Object {
+		return withStatus(old, obj)
+	})
+	err := w.SubResourceWriter.Update(ctx, obj, opts...)
+	if !ok {
+		return err
+	}
+	return checkParity(errs, err)
+}
+
+// withStatus returns a copy of obj with the status of from, or without a
+// status if from is nil.
+func withStatus(obj, from client.Object) client.Object {
+	content, err := apiruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		panic(err)
+	}
+	delete(content, "status")
+	if from != nil {
+		fromContent, err := apiruntime.DefaultUnstructuredConverter.ToUnstructured(from)
+		if err != nil {
+			panic(err)
+		}
+		if status, ok := fromContent["status"]; ok {
+			content["status"] = status
+		}
+	}
+	out := obj.DeepCopyObject().(client.Object)
+	if err := apiruntime.DefaultUnstructuredConverter.FromUnstructured(content, out); err != nil {
+		panic(err)
+	}
+	return out
+}
+
+// validate runs the pkg/validation function of the kind of obj. It returns
+// false if there is none.
+func validate(old, obj client.Object) (field.ErrorList, bool) {
+	switch obj := obj.(type) {
+	case *netv1alpha1.NamespaceNetworkConfiguration:
+		old, _ := old.(*netv1alpha1.NamespaceNetworkConfiguration)
+		return validation.ValidateNamespaceNetworkConfiguration(old, obj), true
+	case *netv1alpha1.WorkloadNetworkConfiguration:
+		old, _ := old.(*netv1alpha1.WorkloadNetworkConfiguration)
+		return validation.ValidateWorkloadNetworkConfiguration(old, obj), true
+	case *netv1alpha1.VSphereDistributedNetwork:
+		old, _ := old.(*netv1alpha1.VSphereDistributedNetwork)
+		return validation.ValidateVSphereDistributedNetwork(old, obj), true
+	case *netv1alpha1.FoundationLoadBalancerConfig:
+		old, _ := old.(*netv1alpha1.FoundationLoadBalancerConfig)
+		return validation.ValidateFoundationLoadBalancerConfig(old, obj), true
+	case *netv1alpha1.NetworkSettings:
+		old, _ := old.(*netv1alpha1.NetworkSettings)
+		return validation.ValidateNetworkSettings(old, obj), true
+	}
+	return nil, false
+}
+
+// checkParity returns err, the result of the API server, if errs, the result of
+// pkg/validation, agrees with it.
+func checkParity(errs field.ErrorList, err error) error {
+	switch {
+	case err != nil && !isRejected(err):
+		return err
+	case err == nil && len(errs) > 0:
+		return fmt.Errorf("pkg/validation rejected an object that the API server admitted: %v", errs.ToAggregate())
+	case err != nil && len(errs) == 0:
+		// The error of the API server is not wrapped, so that the test
+		// does not see a rejection.
+		return fmt.Errorf("pkg/validation admitted an object that the API server rejected: %v", err)
+	}
+	return err
+}
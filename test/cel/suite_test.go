@@ -56,10 +56,11 @@ func TestMain(m *testing.M) {
 		panic(err)
 	}
 
-	k8sClient, err = client.New(cfg, client.Options{Scheme: testScheme})
+	c, err := client.New(cfg, client.Options{Scheme: testScheme})
 	if err != nil {
 		panic(err)
 	}
+	k8sClient = &parityClient{Client: c}
 
 	code := m.Run()
 
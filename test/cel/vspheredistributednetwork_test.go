@@ -214,6 +214,143 @@ func TestVSphereDistributedNetwork_StaticPoolValid_Admitted(t *testing.T) {
 	defer func() { _ = k8sClient.Delete(testCtx, obj) }()
 }
 
+func TestVSphereDistributedNetwork_MalformedGatewayAndSubnetMask_Rejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(*netv1alpha1.VSphereDistributedNetwork)
+		wantErr string
+	}{
+		{"vds-gateway-out-of-range", func(obj *netv1alpha1.VSphereDistributedNetwork) {
+			obj.Spec.Gateway = "999.0.0.1"
+		}, "Gateway must be empty or a valid IPv4 address"},
+		{"vds-gateway-ipv6", func(obj *netv1alpha1.VSphereDistributedNetwork) {
+			obj.Spec.Gateway = "fd00::1"
+		}, "Gateway must be empty or a valid IPv4 address"},
+		{"vds-subnet-mask-out-of-range", func(obj *netv1alpha1.VSphereDistributedNetwork) {
+			obj.Spec.SubnetMask = "255.255.255.256"
+		}, "SubnetMask must be empty or a valid IPv4 address"},
+		{"vds-subnet-mask-cidr", func(obj *netv1alpha1.VSphereDistributedNetwork) {
+			obj.Spec.SubnetMask = "10.0.0.0/24"
+		}, "SubnetMask must be empty or a valid IPv4 address"},
+	}
+	for _, tt := range tests {
+		obj := validVDS(tt.name)
+		tt.update(obj)
+		err := k8sClient.Create(testCtx, obj)
+		if !isRejected(err) || !strings.Contains(err.Error(), tt.wantErr) {
+			_ = k8sClient.Delete(testCtx, obj)
+			t.Errorf("%s: expected rejection containing %q, got: %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
+
+// --- ipv6AssignmentMode: ipv6Gateway/ipv6Prefix follow the mode ---
+
+// validDualStackVDS returns validVDS with IPv6AssignmentModeStaticPool and the
+// ipv6Gateway/ipv6Prefix that mode requires.
+func validDualStackVDS(name string) *netv1alpha1.VSphereDistributedNetwork {
+	prefix := int32(64)
+	obj := validVDS(name)
+	obj.Spec.IPv6AssignmentMode = netv1alpha1.IPAssignmentModeStaticPool
+	obj.Spec.IPv6Gateway = "fd00::1"
+	obj.Spec.IPv6Prefix = &prefix
+	return obj
+}
+
+func TestVSphereDistributedNetwork_IPv6StaticPoolValid_Admitted(t *testing.T) {
+	obj := validDualStackVDS("vds-ipv6-static-valid")
+	obj.Spec.AddressRanges = []netv1alpha1.VSphereDistributedNetworkIPRange{
+		{Address: "10.0.0.10", Count: 4},
+		{Address: "fd00::100", Count: 256},
+	}
+	if err := k8sClient.Create(testCtx, obj); err != nil {
+		t.Fatalf("expected admission, got: %v", err)
+	}
+	defer func() { _ = k8sClient.Delete(testCtx, obj) }()
+}
+
+func TestVSphereDistributedNetwork_InvalidIPv6AssignmentMode_Rejected(t *testing.T) {
+	obj := validVDS("vds-bad-ipv6-mode")
+	obj.Spec.IPv6AssignmentMode = "slaac"
+	if err := k8sClient.Create(testCtx, obj); !isRejected(err) {
+		t.Fatalf("expected rejection for invalid ipv6AssignmentMode, got: %v", err)
+	}
+}
+
+func TestVSphereDistributedNetwork_IPv6GatewayNotIPv6_Rejected(t *testing.T) {
+	obj := validDualStackVDS("vds-ipv6-gateway-v4")
+	obj.Spec.IPv6Gateway = "10.0.0.1"
+	err := k8sClient.Create(testCtx, obj)
+	if !isRejected(err) || !strings.Contains(err.Error(), "IPv6Gateway must be a valid IPv6 address") {
+		t.Fatalf("expected rejection for an IPv4 ipv6Gateway, got: %v", err)
+	}
+}
+
+func TestVSphereDistributedNetwork_IPv6GatewayWithZone_Rejected(t *testing.T) {
+	obj := validDualStackVDS("vds-ipv6-gateway-zone")
+	obj.Spec.IPv6Gateway = "fe80::1%eth0"
+	err := k8sClient.Create(testCtx, obj)
+	if !isRejected(err) || !strings.Contains(err.Error(), "IPv6Gateway must be a valid IPv6 address") {
+		t.Fatalf("expected rejection for an ipv6Gateway with a zone, got: %v", err)
+	}
+}
+
+func TestVSphereDistributedNetwork_IPv6StaticPoolMissingGateway_Rejected(t *testing.T) {
+	obj := validDualStackVDS("vds-ipv6-static-no-gateway")
+	obj.Spec.IPv6Gateway = ""
+	err := k8sClient.Create(testCtx, obj)
+	if !isRejected(err) || !strings.Contains(err.Error(), "IPv6Gateway is required when IPv6AssignmentMode is staticpool") {
+		t.Fatalf("expected rejection for missing ipv6Gateway under staticpool mode, got: %v", err)
+	}
+}
+
+func TestVSphereDistributedNetwork_IPv6StaticPoolMissingPrefix_Rejected(t *testing.T) {
+	obj := validDualStackVDS("vds-ipv6-static-no-prefix")
+	obj.Spec.IPv6Prefix = nil
+	err := k8sClient.Create(testCtx, obj)
+	if !isRejected(err) || !strings.Contains(err.Error(), "IPv6Prefix is required when IPv6AssignmentMode is staticpool") {
+		t.Fatalf("expected rejection for missing ipv6Prefix under staticpool mode, got: %v", err)
+	}
+}
+
+func TestVSphereDistributedNetwork_IPv6DHCPWithGateway_Rejected(t *testing.T) {
+	obj := validDualStackVDS("vds-ipv6-dhcp-gateway")
+	obj.Spec.IPv6AssignmentMode = netv1alpha1.IPAssignmentModeDHCP
+	obj.Spec.IPv6Prefix = nil
+	err := k8sClient.Create(testCtx, obj)
+	if !isRejected(err) || !strings.Contains(err.Error(), "IPv6Gateway must be empty when IPv6AssignmentMode is dhcp, none or unset") {
+		t.Fatalf("expected rejection for ipv6Gateway set under dhcp mode, got: %v", err)
+	}
+}
+
+func TestVSphereDistributedNetwork_IPv6DHCPWithPrefix_Rejected(t *testing.T) {
+	obj := validDualStackVDS("vds-ipv6-dhcp-prefix")
+	obj.Spec.IPv6AssignmentMode = netv1alpha1.IPAssignmentModeDHCP
+	obj.Spec.IPv6Gateway = ""
+	err := k8sClient.Create(testCtx, obj)
+	if !isRejected(err) || !strings.Contains(err.Error(), "IPv6Prefix must be unset when IPv6AssignmentMode is dhcp, none or unset") {
+		t.Fatalf("expected rejection for ipv6Prefix set under dhcp mode, got: %v", err)
+	}
+}
+
+func TestVSphereDistributedNetwork_IPv6UnsetModeWithGateway_Rejected(t *testing.T) {
+	// ipv6AssignmentMode unset means none, so IPv6 settings are not allowed.
+	obj := validDualStackVDS("vds-ipv6-unset-gateway")
+	obj.Spec.IPv6AssignmentMode = ""
+	if err := k8sClient.Create(testCtx, obj); !isRejected(err) {
+		t.Fatalf("expected rejection for ipv6Gateway/ipv6Prefix with ipv6AssignmentMode unset, got: %v", err)
+	}
+}
+
+func TestVSphereDistributedNetwork_IPv6DHCPWithIPv4StaticPool_Admitted(t *testing.T) {
+	obj := validVDS("vds-ipv6-dhcp")
+	obj.Spec.IPv6AssignmentMode = netv1alpha1.IPAssignmentModeDHCP
+	if err := k8sClient.Create(testCtx, obj); err != nil {
+		t.Fatalf("expected admission, got: %v", err)
+	}
+	defer func() { _ = k8sClient.Delete(testCtx, obj) }()
+}
+
 // --- addressRanges field validation ---
 
 func TestVSphereDistributedNetwork_AddressRangeInvalidAddress_Rejected(t *testing.T) {
@@ -226,6 +363,46 @@ func TestVSphereDistributedNetwork_AddressRangeInvalidAddress_Rejected(t *testin
 	}
 }
 
+func TestVSphereDistributedNetwork_AddressRangeMalformedAddress_Rejected(t *testing.T) {
+	for i, address := range []string{"999.0.0.10", "10.0.0", "fe80::1%eth0", "::ffff:10.0.0.10"} {
+		obj := validVDS(fmt.Sprintf("vds-malformed-range-address-%d", i))
+		obj.Spec.AddressRanges = []netv1alpha1.VSphereDistributedNetworkIPRange{
+			{Address: address, Count: 4},
+		}
+		err := k8sClient.Create(testCtx, obj)
+		if !isRejected(err) || !strings.Contains(err.Error(), "Address must be a valid IPv4 or IPv6 address") {
+			_ = k8sClient.Delete(testCtx, obj)
+			t.Errorf("%s: expected rejection, got: %v", address, err)
+		}
+	}
+}
+
+// An address that the earlier patterns accepted, such as one with leading
+// zeros, stays valid, and so does a list that holds one when it is appended to.
+func TestVSphereDistributedNetwork_AddressRangeLegacyAddressAppend_Admitted(t *testing.T) {
+	obj := validVDS("vds-legacy-range-address")
+	obj.Spec.AddressRanges = []netv1alpha1.VSphereDistributedNetworkIPRange{
+		{Address: "010.0.0.10", Count: 4},
+	}
+	if err := k8sClient.Create(testCtx, obj); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	defer func() { _ = k8sClient.Delete(testCtx, obj) }()
+
+	obj.Spec.AddressRanges = append(obj.Spec.AddressRanges,
+		netv1alpha1.VSphereDistributedNetworkIPRange{Address: "10.0.0.20", Count: 4})
+	if err := k8sClient.Update(testCtx, obj); err != nil {
+		t.Fatalf("expected admission for a range appended to a legacy address, got: %v", err)
+	}
+
+	obj.Spec.AddressRanges = append(obj.Spec.AddressRanges,
+		netv1alpha1.VSphereDistributedNetworkIPRange{Address: "999.0.0.30", Count: 4})
+	err := k8sClient.Update(testCtx, obj)
+	if !isRejected(err) || !strings.Contains(err.Error(), "spec.addressRanges[2].address") {
+		t.Fatalf("expected rejection for spec.addressRanges[2].address, got: %v", err)
+	}
+}
+
 func TestVSphereDistributedNetwork_AddressRangeZeroCount_Rejected(t *testing.T) {
 	obj := validVDS("vds-zero-count")
 	obj.Spec.AddressRanges = []netv1alpha1.VSphereDistributedNetworkIPRange{
@@ -264,17 +441,26 @@ func TestVSphereDistributedNetwork_TooManyAddressRanges_Rejected(t *testing.T) {
 }
 
 // --- DefaultPortConfig / VlanSpec / MacManagementPolicy ---
+//
+// The status is a subresource, so the API server drops it on create and on
+// update of the main resource. Each test creates a valid object and then
+// updates its status.
 
 func TestVSphereDistributedNetwork_VlanInvalidType_Rejected(t *testing.T) {
 	obj := unstrVDS("vds-bad-vlan-type", map[string]interface{}{
 		"ipAssignmentMode": string(netv1alpha1.IPAssignmentModeNone),
 	})
+	if err := k8sClient.Create(testCtx, obj); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	defer func() { _ = k8sClient.Delete(testCtx, obj) }()
+
 	obj.Object["status"] = map[string]interface{}{
 		"defaultPortConfig": map[string]interface{}{
 			"vlan": map[string]interface{}{"type": "bogus-vlan-type"},
 		},
 	}
-	if err := k8sClient.Create(testCtx, obj); !isRejected(err) {
+	if err := k8sClient.Status().Update(testCtx, obj); !isRejected(err) {
 		t.Fatalf("expected rejection for invalid vlan type, got: %v", err)
 	}
 }
@@ -286,12 +472,8 @@ func TestVSphereDistributedNetwork_StatusValidVlanAndMacPolicy_Admitted(t *testi
 	}
 	defer func() { _ = k8sClient.Delete(testCtx, obj) }()
 
-	latest := &netv1alpha1.VSphereDistributedNetwork{}
-	if err := k8sClient.Get(testCtx, client.ObjectKeyFromObject(obj), latest); err != nil {
-		t.Fatalf("get: %v", err)
-	}
 	vlanID := int32(100)
-	latest.Status = netv1alpha1.VSphereDistributedNetworkStatus{
+	obj.Status = netv1alpha1.VSphereDistributedNetworkStatus{
 		DefaultPortConfig: &netv1alpha1.VSphereDistributedPortConfig{
 			Vlan: &netv1alpha1.VlanSpec{
 				Type:   netv1alpha1.VLANTypeStandard,
@@ -304,14 +486,27 @@ func TestVSphereDistributedNetwork_StatusValidVlanAndMacPolicy_Admitted(t *testi
 			},
 		},
 	}
-	if err := k8sClient.Update(testCtx, latest); err != nil {
+	if err := k8sClient.Status().Update(testCtx, obj); err != nil {
 		t.Fatalf("expected admission for valid status vlan/mac policy, got: %v", err)
 	}
+
+	latest := &netv1alpha1.VSphereDistributedNetwork{}
+	if err := k8sClient.Get(testCtx, client.ObjectKeyFromObject(obj), latest); err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if cfg := latest.Status.DefaultPortConfig; cfg == nil || cfg.Vlan == nil || cfg.Vlan.VlanID == nil || *cfg.Vlan.VlanID != vlanID {
+		t.Fatalf("expected the status to be stored, got: %+v", latest.Status)
+	}
 }
 
 func TestVSphereDistributedNetwork_VlanIDOutOfRange_Rejected(t *testing.T) {
-	vlanID := int32(4095)
 	obj := validVDS("vds-vlan-out-of-range")
+	if err := k8sClient.Create(testCtx, obj); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	defer func() { _ = k8sClient.Delete(testCtx, obj) }()
+
+	vlanID := int32(4095)
 	obj.Status = netv1alpha1.VSphereDistributedNetworkStatus{
 		DefaultPortConfig: &netv1alpha1.VSphereDistributedPortConfig{
 			Vlan: &netv1alpha1.VlanSpec{
@@ -320,14 +515,19 @@ func TestVSphereDistributedNetwork_VlanIDOutOfRange_Rejected(t *testing.T) {
 			},
 		},
 	}
-	if err := k8sClient.Create(testCtx, obj); !isRejected(err) {
+	if err := k8sClient.Status().Update(testCtx, obj); !isRejected(err) {
 		t.Fatalf("expected rejection for vlanID above maximum (4094), got: %v", err)
 	}
 }
 
 func TestVSphereDistributedNetwork_MacLearningPolicyInvalidLimitPolicy_Rejected(t *testing.T) {
-	badPolicy := netv1alpha1.MacLimitPolicyType("bogus-policy")
 	obj := validVDS("vds-bad-mac-limit-policy")
+	if err := k8sClient.Create(testCtx, obj); err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	defer func() { _ = k8sClient.Delete(testCtx, obj) }()
+
+	badPolicy := netv1alpha1.MacLimitPolicyType("bogus-policy")
 	obj.Status = netv1alpha1.VSphereDistributedNetworkStatus{
 		DefaultPortConfig: &netv1alpha1.VSphereDistributedPortConfig{
 			MacManagementPolicy: &netv1alpha1.MacManagementPolicy{
@@ -338,7 +538,7 @@ func TestVSphereDistributedNetwork_MacLearningPolicyInvalidLimitPolicy_Rejected(
 			},
 		},
 	}
-	if err := k8sClient.Create(testCtx, obj); !isRejected(err) {
+	if err := k8sClient.Status().Update(testCtx, obj); !isRejected(err) {
 		t.Fatalf("expected rejection for invalid macLearningPolicy.limitPolicy, got: %v", err)
 	}
 }